@@ -28,12 +28,37 @@ func main() {
 
 	log.Info("config", zap.Any("cfg", cfg))
 
+	// ------------------------------------------------------------
+	// 		INIT DB
+	// ------------------------------------------------------------
+	connPool, err := pgxpool.New(ctx, cfg.DBSource)
+	if err != nil {
+		log.Fatal("cannot connect to db")
+	}
+	store := repository.NewStore(connPool)
+
 	{
 		orderCreatedConsumer := handler.NewOrderCreatedConsumer(log, cfg)
 		go orderCreatedConsumer.ConsumeOrderCreated(ctx)
 	}
 	{
-		go NewServer(ctx, cfg, log)
+		customerMergedConsumer := handler.NewCustomerMergedConsumer(log, cfg, store)
+		go customerMergedConsumer.ConsumeCustomerMerged(ctx)
+	}
+	{
+		privacyRequestConsumer := handler.NewPrivacyRequestConsumer(log, cfg, store)
+		go privacyRequestConsumer.ConsumePrivacyRequest(ctx)
+	}
+	{
+		segmentUpdatedConsumer := handler.NewSegmentUpdatedConsumer(log, cfg, store)
+		go segmentUpdatedConsumer.ConsumeSegmentUpdated(ctx)
+	}
+	{
+		customerRekeyedConsumer := handler.NewCustomerRekeyedConsumer(log, cfg, store)
+		go customerRekeyedConsumer.ConsumeCustomerRekeyed(ctx)
+	}
+	{
+		go NewServer(ctx, cfg, log, store)
 	}
 	{
 		NewGatewayServer(ctx, cfg, log)
@@ -44,16 +69,8 @@ func NewServer(
 	ctx context.Context,
 	cfg config.Config,
 	log *zap.Logger,
+	store repository.Store,
 ) {
-	// ------------------------------------------------------------
-	// 		INIT DB
-	// ------------------------------------------------------------
-	connPool, err := pgxpool.New(ctx, cfg.DBSource)
-	if err != nil {
-		log.Fatal("cannot connect to db")
-	}
-	store := repository.NewStore(connPool)
-
 	// ------------------------------------------------------------
 	// 		START SERVER
 	// ------------------------------------------------------------
@@ -0,0 +1,47 @@
+-- name: MoveCustomerLoyaltyPoints :execrows
+-- customer_id is the uuid of the customer in product-customer-service.
+UPDATE loyalty_points
+SET customer_id = sqlc.arg('to_customer_id')
+WHERE customer_id = sqlc.arg('from_customer_id');
+
+-- name: MoveCustomerVouchers :execrows
+UPDATE customer_vouchers
+SET customer_id = sqlc.arg('to_customer_id')
+WHERE customer_id = sqlc.arg('from_customer_id');
+
+-- name: MoveCustomerUsageRecords :execrows
+UPDATE usage_records
+SET
+  customer_id = sqlc.arg('to_customer_id'),
+  updated_at  = NOW()
+WHERE customer_id = sqlc.arg('from_customer_id');
+
+-- name: ListCustomerLoyaltyPoints :many
+SELECT * FROM loyalty_points
+WHERE customer_id = $1
+ORDER BY created_at;
+
+-- name: ListCustomerVouchers :many
+SELECT * FROM customer_vouchers
+WHERE customer_id = $1
+ORDER BY id;
+
+-- name: ListCustomerUsageRecords :many
+SELECT * FROM usage_records
+WHERE customer_id = $1
+ORDER BY created_at;
+
+-- name: GrantCustomerVoucher :execrows
+-- Segment distribution, a customer is granted each voucher once.
+INSERT INTO customer_vouchers (customer_id, voucher_id, status)
+SELECT sqlc.arg('customer_id')::varchar, sqlc.arg('voucher_id')::int, 'UNUSED'
+WHERE NOT EXISTS (
+  SELECT 1 FROM customer_vouchers
+  WHERE customer_id = sqlc.arg('customer_id') AND voucher_id = sqlc.arg('voucher_id')
+);
+
+-- name: ListCustomersTotalPoints :many
+SELECT customer_id, SUM(points)::int AS total_points
+FROM loyalty_points
+WHERE customer_id = ANY(sqlc.arg('customer_ids')::varchar[])
+GROUP BY customer_id;
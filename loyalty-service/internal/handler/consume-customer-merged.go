@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/config"
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqConfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"go.uber.org/zap"
+)
+
+type ICustomerMergedConsumer interface {
+	ConsumeCustomerMerged(ctx context.Context)
+}
+
+// CustomerMergedConsumer moves loyalty points and vouchers of a merged
+// customer to the survivor
+type CustomerMergedConsumer struct {
+	logger *zap.Logger
+	cfg    config.Config
+	store  repository.Store
+}
+
+func NewCustomerMergedConsumer(
+	logger *zap.Logger,
+	cfg config.Config,
+	store repository.Store,
+) ICustomerMergedConsumer {
+	return &CustomerMergedConsumer{
+		logger: logger,
+		cfg:    cfg,
+		store:  store,
+	}
+}
+
+func (c *CustomerMergedConsumer) ConsumeCustomerMerged(ctx context.Context) {
+	logger := c.logger.With(zap.Any("func", "ConsumeCustomerMerged"))
+
+	config := mqConfig.RabbitMQConfig{
+		ConnStr:        c.cfg.MqConnStr,
+		ExchangeName:   consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:   "topic",
+		SubscribeKeys:  []string{consts.TOPIC_MERGE_CUSTOMER},
+		PublisherName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		SubscriberName: "",
+		QueueName:      consts.QUEUE_CUSTOMER_MERGED_LOYALTY,
+	}
+
+	subscriber, err := mq.NewSubscriber(config, logger)
+	if err != nil {
+		logger.Error("Error", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+	logger.Info("Init Subscriber successfully")
+
+	if err := subscriber.Consume(func(body []byte) error {
+		return c.handler(ctx, body)
+	}); err != nil {
+		logger.Error("Consumer error", zap.Error(err))
+	}
+}
+
+func (c *CustomerMergedConsumer) handler(ctx context.Context, body []byte) error {
+	var data events.CustomersMergedEvent
+	if err := mq.UnwrapEvent(body, &data); err != nil {
+		// a malformed message will never succeed, drop it
+		c.logger.Error("failed to unmarshal event", zap.Error(err))
+		return nil
+	}
+	// records of the merged customer not re-keyed yet are still on its phone
+	to := cmp.Or(data.SurvivorUuid, data.SurvivorPhone)
+	from := []string{cmp.Or(data.MergedUuid, data.MergedPhone), data.MergedPhone}
+	if from[0] == "" || to == "" || from[0] == to {
+		return nil
+	}
+
+	return c.store.ExecTx(ctx, func(q *repository.Queries) error {
+		records, err := moveCustomerRecords(ctx, q, from, to)
+		if err != nil {
+			return err
+		}
+		c.logger.Info("customer merged",
+			zap.Strings("from", from),
+			zap.String("to", to),
+			zap.Int64("records", records))
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/config"
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqConfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"go.uber.org/zap"
+)
+
+type ICustomerRekeyedConsumer interface {
+	ConsumeCustomerRekeyed(ctx context.Context)
+}
+
+// CustomerRekeyedConsumer moves loyalty points and vouchers still keyed by
+// an older identifier of a customer, e.g. the phone, to the customer uuid
+type CustomerRekeyedConsumer struct {
+	logger *zap.Logger
+	cfg    config.Config
+	store  repository.Store
+}
+
+func NewCustomerRekeyedConsumer(
+	logger *zap.Logger,
+	cfg config.Config,
+	store repository.Store,
+) ICustomerRekeyedConsumer {
+	return &CustomerRekeyedConsumer{
+		logger: logger,
+		cfg:    cfg,
+		store:  store,
+	}
+}
+
+func (c *CustomerRekeyedConsumer) ConsumeCustomerRekeyed(ctx context.Context) {
+	logger := c.logger.With(zap.Any("func", "ConsumeCustomerRekeyed"))
+
+	config := mqConfig.RabbitMQConfig{
+		ConnStr:        c.cfg.MqConnStr,
+		ExchangeName:   consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:   "topic",
+		SubscribeKeys:  []string{consts.TOPIC_REKEY_CUSTOMER},
+		PublisherName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		SubscriberName: "",
+		QueueName:      consts.QUEUE_CUSTOMER_REKEYED_LOYALTY,
+	}
+
+	subscriber, err := mq.NewSubscriber(config, logger)
+	if err != nil {
+		logger.Error("Error", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+	logger.Info("Init Subscriber successfully")
+
+	if err := subscriber.Consume(func(body []byte) error {
+		return c.handler(ctx, body)
+	}); err != nil {
+		logger.Error("Consumer error", zap.Error(err))
+	}
+}
+
+func (c *CustomerRekeyedConsumer) handler(ctx context.Context, body []byte) error {
+	var data events.CustomerRekeyedEvent
+	if err := mq.UnwrapEvent(body, &data); err != nil {
+		// a malformed message will never succeed, drop it
+		c.logger.Error("failed to unmarshal event", zap.Error(err))
+		return nil
+	}
+	if data.Uuid == "" || len(data.PreviousIds) == 0 {
+		return nil
+	}
+
+	return c.store.ExecTx(ctx, func(q *repository.Queries) error {
+		records, err := moveCustomerRecords(ctx, q, data.PreviousIds, data.Uuid)
+		if err != nil {
+			return err
+		}
+		if records > 0 {
+			c.logger.Info("customer rekeyed",
+				zap.Strings("from", data.PreviousIds),
+				zap.String("to", data.Uuid),
+				zap.Int64("records", records))
+		}
+		return nil
+	})
+}
+
+// moveCustomerRecords re-points the loyalty points, vouchers and usage
+// records of the from ids to the customer to, it returns the number of
+// records moved
+func moveCustomerRecords(ctx context.Context, q *repository.Queries, from []string, to string) (int64, error) {
+	var records int64
+	for _, id := range from {
+		if id == "" || id == to {
+			continue
+		}
+		arg := repository.MoveCustomerLoyaltyPointsParams{
+			ToCustomerID:   to,
+			FromCustomerID: id,
+		}
+		points, err := q.MoveCustomerLoyaltyPoints(ctx, arg)
+		if err != nil {
+			return 0, err
+		}
+		vouchers, err := q.MoveCustomerVouchers(ctx, repository.MoveCustomerVouchersParams(arg))
+		if err != nil {
+			return 0, err
+		}
+		usages, err := q.MoveCustomerUsageRecords(ctx, repository.MoveCustomerUsageRecordsParams(arg))
+		if err != nil {
+			return 0, err
+		}
+		records += points + vouchers + usages
+	}
+	return records, nil
+}
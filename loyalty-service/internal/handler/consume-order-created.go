@@ -73,7 +73,7 @@ func (c *OrderCreatedConsumer) ConsumeOrderCreated(ctx context.Context) {
 }
 
 func (c *OrderCreatedConsumer) handler(body []byte) error {
-	var data events.ProductEvent // TODO: Chờ publisher define event
+	var data events.EventEnvelope // TODO: Chờ publisher define event
 	_ = proto.Unmarshal(body, &data)
 	b, _ := protojson.Marshal(&data)
 
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/config"
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqConfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"go.uber.org/zap"
+)
+
+type IPrivacyRequestConsumer interface {
+	ConsumePrivacyRequest(ctx context.Context)
+}
+
+// PrivacyRequestConsumer exports or erases the loyalty data of a customer
+// and acknowledges the request to product-customer-service
+type PrivacyRequestConsumer struct {
+	logger *zap.Logger
+	cfg    config.Config
+	store  repository.Store
+}
+
+func NewPrivacyRequestConsumer(
+	logger *zap.Logger,
+	cfg config.Config,
+	store repository.Store,
+) IPrivacyRequestConsumer {
+	return &PrivacyRequestConsumer{
+		logger: logger,
+		cfg:    cfg,
+		store:  store,
+	}
+}
+
+func (c *PrivacyRequestConsumer) ConsumePrivacyRequest(ctx context.Context) {
+	logger := c.logger.With(zap.Any("func", "ConsumePrivacyRequest"))
+
+	config := mqConfig.RabbitMQConfig{
+		ConnStr:        c.cfg.MqConnStr,
+		ExchangeName:   consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:   "topic",
+		SubscribeKeys:  []string{consts.TOPIC_CUSTOMER_PRIVACY_REQUEST},
+		PublisherName:  consts.SERVICE_LOYALTY,
+		SubscriberName: "",
+		QueueName:      consts.QUEUE_PRIVACY_REQUEST_LOYALTY,
+	}
+
+	subscriber, err := mq.NewSubscriber(config, logger)
+	if err != nil {
+		logger.Error("Error", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+
+	publisher, err := mq.NewPublisher(config, logger)
+	if err != nil {
+		logger.Error("Error", zap.Error(err))
+		return
+	}
+	defer publisher.Close()
+	logger.Info("Init Subscriber successfully")
+
+	if err := subscriber.Consume(func(body []byte) error {
+		var data events.CustomerPrivacyRequestEvent
+		if err := mq.UnwrapEvent(body, &data); err != nil {
+			// a malformed message will never succeed, drop it
+			logger.Error("failed to unmarshal event", zap.Error(err))
+			return nil
+		}
+		ack := c.handler(ctx, &data)
+		return publisher.SendMessage(ack, consts.TOPIC_CUSTOMER_PRIVACY_ACK)
+	}); err != nil {
+		logger.Error("Consumer error", zap.Error(err))
+	}
+}
+
+func (c *PrivacyRequestConsumer) handler(ctx context.Context, data *events.CustomerPrivacyRequestEvent) *events.CustomerPrivacyAckEvent {
+	ack := &events.CustomerPrivacyAckEvent{
+		RequestId: data.RequestId,
+		Service:   consts.SERVICE_LOYALTY,
+	}
+
+	var err error
+	switch data.Kind {
+	case "export":
+		ack.Data, ack.Records, err = c.export(ctx, data.Uuid, data.Phone)
+	case "erase":
+		ack.Records, err = c.erase(ctx, data.Phone, cmp.Or(data.Uuid, data.Pseudonym))
+	default:
+		err = fmt.Errorf("unknown privacy request kind %s", data.Kind)
+	}
+	if err != nil {
+		c.logger.Error("failed to handle privacy request",
+			zap.Int32("request_id", data.RequestId),
+			zap.Error(err))
+		ack.Error = err.Error()
+		return ack
+	}
+
+	ack.Success = true
+	c.logger.Info("privacy request handled",
+		zap.Int32("request_id", data.RequestId),
+		zap.String("kind", data.Kind),
+		zap.Int32("records", ack.Records))
+	return ack
+}
+
+// export returns the loyalty history of the customer, records not re-keyed
+// yet are still on the phone
+func (c *PrivacyRequestConsumer) export(ctx context.Context, customerIDs ...string) ([]byte, int32, error) {
+	var points []repository.LoyaltyPoint
+	var vouchers []repository.CustomerVoucher
+	var usages []repository.UsageRecord
+	for _, id := range customerIDs {
+		if id == "" {
+			continue
+		}
+		p, err := c.store.ListCustomerLoyaltyPoints(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, err := c.store.ListCustomerVouchers(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		u, err := c.store.ListCustomerUsageRecords(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		points, vouchers, usages = append(points, p...), append(vouchers, v...), append(usages, u...)
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"loyalty_points":    points,
+		"customer_vouchers": vouchers,
+		"usage_records":     usages,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, int32(len(points) + len(vouchers) + len(usages)), nil
+}
+
+// erase moves the loyalty history still keyed by phone to the customer
+// uuid, the balances stay consistent with the anonymized orders
+func (c *PrivacyRequestConsumer) erase(ctx context.Context, phone, customerID string) (int32, error) {
+	if customerID == "" {
+		return 0, fmt.Errorf("customer uuid is required")
+	}
+
+	var records int64
+	err := c.store.ExecTx(ctx, func(q *repository.Queries) error {
+		var err error
+		records, err = moveCustomerRecords(ctx, q, []string{phone}, customerID)
+		return err
+	})
+	return int32(records), err
+}
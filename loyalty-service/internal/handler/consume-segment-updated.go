@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/config"
+	"github.com/linhhuynhcoding/jss-microservices/loyalty/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqConfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"go.uber.org/zap"
+)
+
+type ISegmentUpdatedConsumer interface {
+	ConsumeSegmentUpdated(ctx context.Context)
+}
+
+// SegmentUpdatedConsumer grants the voucher of a customer segment to the
+// customers entering it
+type SegmentUpdatedConsumer struct {
+	logger *zap.Logger
+	cfg    config.Config
+	store  repository.Store
+}
+
+func NewSegmentUpdatedConsumer(
+	logger *zap.Logger,
+	cfg config.Config,
+	store repository.Store,
+) ISegmentUpdatedConsumer {
+	return &SegmentUpdatedConsumer{
+		logger: logger,
+		cfg:    cfg,
+		store:  store,
+	}
+}
+
+func (c *SegmentUpdatedConsumer) ConsumeSegmentUpdated(ctx context.Context) {
+	logger := c.logger.With(zap.Any("func", "ConsumeSegmentUpdated"))
+
+	config := mqConfig.RabbitMQConfig{
+		ConnStr:        c.cfg.MqConnStr,
+		ExchangeName:   consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:   "topic",
+		SubscribeKeys:  []string{consts.TOPIC_SEGMENT_UPDATED},
+		PublisherName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		SubscriberName: "",
+		QueueName:      consts.QUEUE_SEGMENT_UPDATED_LOYALTY,
+	}
+
+	subscriber, err := mq.NewSubscriber(config, logger)
+	if err != nil {
+		logger.Error("Error", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+	logger.Info("Init Subscriber successfully")
+
+	if err := subscriber.Consume(func(body []byte) error {
+		return c.handler(ctx, body)
+	}); err != nil {
+		logger.Error("Consumer error", zap.Error(err))
+	}
+}
+
+func (c *SegmentUpdatedConsumer) handler(ctx context.Context, body []byte) error {
+	var data events.CustomerSegmentUpdatedEvent
+	if err := mq.UnwrapEvent(body, &data); err != nil {
+		// a malformed message will never succeed, drop it
+		c.logger.Error("failed to unmarshal event", zap.Error(err))
+		return nil
+	}
+	if data.VoucherId == 0 || len(data.AddedCustomers) == 0 {
+		return nil
+	}
+
+	// a redelivery grants nothing twice
+	var granted int64
+	err := c.store.ExecTx(ctx, func(q *repository.Queries) error {
+		for _, customerID := range data.AddedCustomers {
+			n, err := q.GrantCustomerVoucher(ctx, repository.GrantCustomerVoucherParams{
+				CustomerID: customerID,
+				VoucherID:  data.VoucherId,
+			})
+			if err != nil {
+				return err
+			}
+			granted += n
+		}
+		return nil
+	})
+	if err != nil {
+		c.logger.Error("failed to grant segment voucher",
+			zap.Int32("segment_id", data.SegmentId),
+			zap.Int32("voucher_id", data.VoucherId),
+			zap.Error(err))
+		return err
+	}
+	c.logger.Info("segment voucher granted",
+		zap.Int32("segment_id", data.SegmentId),
+		zap.Int32("voucher_id", data.VoucherId),
+		zap.Int64("customers", granted))
+	return nil
+}
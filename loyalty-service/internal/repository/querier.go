@@ -32,6 +32,16 @@ type Querier interface {
 	GetUsageRecordsByVoucherId(ctx context.Context, arg GetUsageRecordsByVoucherIdParams) ([]UsageRecord, error)
 	GetVoucher(ctx context.Context, id int32) (Voucher, error)
 	GetVoucherByCode(ctx context.Context, code string) (Voucher, error)
+	// Segment distribution, a customer is granted each voucher once.
+	GrantCustomerVoucher(ctx context.Context, arg GrantCustomerVoucherParams) (int64, error)
+	ListCustomerLoyaltyPoints(ctx context.Context, customerID string) ([]LoyaltyPoint, error)
+	ListCustomerUsageRecords(ctx context.Context, customerID string) ([]UsageRecord, error)
+	ListCustomerVouchers(ctx context.Context, customerID string) ([]CustomerVoucher, error)
+	ListCustomersTotalPoints(ctx context.Context, customerIds []string) ([]ListCustomersTotalPointsRow, error)
+	// customer_id is the uuid of the customer in product-customer-service.
+	MoveCustomerLoyaltyPoints(ctx context.Context, arg MoveCustomerLoyaltyPointsParams) (int64, error)
+	MoveCustomerUsageRecords(ctx context.Context, arg MoveCustomerUsageRecordsParams) (int64, error)
+	MoveCustomerVouchers(ctx context.Context, arg MoveCustomerVouchersParams) (int64, error)
 	UpdateCustomerVoucherStatus(ctx context.Context, arg UpdateCustomerVoucherStatusParams) (CustomerVoucher, error)
 	UpdateLoyaltyPoints(ctx context.Context, arg UpdateLoyaltyPointsParams) (LoyaltyPoint, error)
 	UpdateVoucher(ctx context.Context, arg UpdateVoucherParams) (Voucher, error)
@@ -289,3 +289,30 @@ func (s *Service) GetCustomerTotalPoints(ctx context.Context, req *api.GetCustom
 
 	return response, nil
 }
+
+// maxCustomersTotalPoints bounds the customer ids of one GetCustomersTotalPoints call
+const maxCustomersTotalPoints = 1000
+
+// GetCustomersTotalPoints returns the balance of many customers in one query,
+// customers without points are left out of the map
+func (s *Service) GetCustomersTotalPoints(ctx context.Context, req *api.GetCustomersTotalPointsRequest) (*api.GetCustomersTotalPointsResponse, error) {
+	s.logger.Info("Getting customers total points", zap.Int("customers", len(req.CustomerIds)))
+
+	if len(req.CustomerIds) > maxCustomersTotalPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d customer_ids per request", maxCustomersTotalPoints)
+	}
+
+	rows, err := s.queries.ListCustomersTotalPoints(ctx, req.CustomerIds)
+	if err != nil {
+		s.logger.Error("Failed to get customers total points", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get customers total points")
+	}
+
+	response := &api.GetCustomersTotalPointsResponse{
+		TotalPoints: make(map[string]int32, len(rows)),
+	}
+	for _, r := range rows {
+		response.TotalPoints[r.CustomerID] = r.TotalPoints
+	}
+	return response, nil
+}
@@ -9,8 +9,12 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/linhhuynhcoding/jss-microservices/market/config"
 	"github.com/linhhuynhcoding/jss-microservices/market/internal/handler"
+	"github.com/linhhuynhcoding/jss-microservices/market/internal/notifier"
 	"github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
 	"github.com/linhhuynhcoding/jss-microservices/market/internal/service"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
 	"github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
 	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
@@ -37,12 +41,27 @@ func main() {
 	}
 	store := repository.NewStore(connPool)
 
+	publisher, err := mq.NewPublisher(mqconfig.RabbitMQConfig{
+		ConnStr:       cfg.RabbitMQURL,
+		ExchangeName:  mqconsts.EXCHANGE_MARKET_SERVICE,
+		ExchangeType:  mqconsts.EXCHANGE_TYPE_TOPIC,
+		PublisherName: mqconsts.SERVICE_MARKET,
+	}, log)
+	if err != nil {
+		// events are dropped until the broker is reachable
+		log.Error("failed to init mq publisher", zap.Error(err))
+	}
+	priceNotifier := notifier.NewPriceNotifier(log, cfg, publisher)
+
 	{
-		goldPriceCrawler := handler.NewGoldPriceCrawler(ctx, log, cfg, store)
+		goldPriceCrawler, err := handler.NewGoldPriceCrawler(ctx, log, cfg, store, priceNotifier)
+		if err != nil {
+			log.Fatal("invalid gold price provider", zap.Error(err))
+		}
 		go RunCronJob(ctx, log, "0 */10 * * * *", goldPriceCrawler.Handle)
 	}
 	{
-		go NewServer(ctx, cfg, log, store)
+		go NewServer(ctx, cfg, log, store, priceNotifier, publisher)
 	}
 	{
 		NewGatewayServer(ctx, cfg, log)
@@ -54,6 +73,8 @@ func NewServer(
 	cfg config.Config,
 	log *zap.Logger,
 	store repository.Store,
+	priceNotifier *notifier.PriceNotifier,
+	publisher *mq.Publisher,
 ) {
 	// ------------------------------------------------------------
 	// 		START SERVER
@@ -64,7 +85,7 @@ func NewServer(
 	}
 
 	s := grpc.NewServer()
-	market.RegisterMarketServer(s, service.NewService(ctx, log, config.NewConfig(), store))
+	market.RegisterMarketServer(s, service.NewService(ctx, log, config.NewConfig(), store, priceNotifier, publisher))
 
 	log.Info("gRPC server listening on :50051")
 	if err := s.Serve(lis); err != nil {
@@ -1,8 +1,14 @@
 package config
 
 import (
+	"cmp"
 	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/linhhuynhcoding/jss-microservices/market/consts"
 	"github.com/spf13/viper"
 )
 
@@ -13,6 +19,49 @@ type Config struct {
 	GrpcPort int `mapstructure:"GRPC_PORT"`
 
 	RedisAddress string `mapstructure:"REDIS_ADDRESS"`
+	RabbitMQURL  string `mapstructure:"RABBITMQ_URL"`
+
+	AuthServiceUrl    string `mapstructure:"AUTH_SERVICE_URL"`
+	ProductServiceUrl string `mapstructure:"PRODUCT_SERVICE_URL"`
+
+	// smallest move of the buy or sell price, in dong, published as a
+	// gold price change
+	GoldPriceMinDelta float64 `mapstructure:"GOLD_PRICE_MIN_DELTA"`
+
+	// gold price sources by priority, a gold type is taken from the first
+	// provider returning it
+	GoldPriceProviders []ProviderConfig `mapstructure:"GOLD_PRICE_PROVIDERS"`
+	// gold id -> provider names by priority, overrides the order above
+	GoldPriceSources map[string][]string `mapstructure:"GOLD_PRICE_SOURCES"`
+}
+
+type ProviderConfig struct {
+	Name string `mapstructure:"NAME"`
+	// btmc_json, btmc_xml, sjc, doji or fixture
+	Kind string `mapstructure:"KIND"`
+	URL  string `mapstructure:"URL"`
+	// required by btmc_json, btmc_xml and doji, see APIKeyEnv. Kept out of
+	// the logged config.
+	APIKey  string        `mapstructure:"API_KEY" json:"-"`
+	Timeout time.Duration `mapstructure:"TIMEOUT"`
+	// multiplier applied to the prices, e.g. 1000 for thousands of dong
+	Scale float64 `mapstructure:"SCALE"`
+	// fixture only, JSON file of quotes
+	File string `mapstructure:"FILE"`
+	// sjc and doji only, provider gold type (lower case) -> gold id
+	GoldIDs map[string]int32 `mapstructure:"GOLD_IDS"`
+}
+
+// APIKeyEnv is the environment variable holding the API key of the
+// provider, BTMC_API_KEY for the provider named btmc. It overrides API_KEY.
+func (p ProviderConfig) APIKeyEnv() string {
+	name := strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return '_'
+		}
+		return unicode.ToUpper(r)
+	}, cmp.Or(p.Name, p.Kind))
+	return name + "_API_KEY"
 }
 
 func NewConfig() Config {
@@ -24,6 +73,13 @@ func NewConfig() Config {
 }
 
 func LoadDefaultConfig(cfg *Config) {
+	cfg.GoldPriceProviders = []ProviderConfig{
+		{
+			Name: consts.PROVIDER_BTMC_JSON,
+			Kind: consts.PROVIDER_BTMC_JSON,
+			URL:  consts.BTMC_URL,
+		},
+	}
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -42,5 +98,13 @@ func LoadConfig(path string) (config Config, err error) {
 	}
 
 	err = viper.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+	for i, p := range config.GoldPriceProviders {
+		if key := os.Getenv(p.APIKeyEnv()); key != "" {
+			config.GoldPriceProviders[i].APIKey = key
+		}
+	}
 	return
 }
@@ -1,2 +1,54 @@
 package consts
 
+import "time"
+
+// gold price providers
+const (
+	PROVIDER_BTMC_JSON string = "btmc_json"
+	PROVIDER_BTMC_XML  string = "btmc_xml"
+	PROVIDER_SJC       string = "sjc"
+	PROVIDER_DOJI      string = "doji"
+	PROVIDER_FIXTURE   string = "fixture"
+
+	// the same endpoint answers JSON or XML depending on the Accept header
+	BTMC_URL string = "http://api.btmc.vn/api/BTMCAPI/getpricebtmc"
+	SJC_URL  string = "https://sjc.com.vn/xml/tygiavang.xml"
+	DOJI_URL string = "http://giavang.doji.vn/api/giavang/"
+
+	DEFAULT_PROVIDER_TIMEOUT = 10 * time.Second
+)
+
+// gold price history
+const (
+	INTERVAL_DAY   string = "day"
+	INTERVAL_WEEK  string = "week"
+	INTERVAL_MONTH string = "month"
+
+	DEFAULT_HISTORY_CANDLES = 30
+	DEFAULT_MA_PERIOD       = 7
+	MAX_MA_PERIOD           = 200
+
+	// source of the prices entered through the API
+	TICK_SOURCE_MANUAL string = "manual"
+)
+
+const (
+	MACE_OF_GOLD_WEIGHT = 3.75 // gram
+)
+
+const (
+	ROLE_STAFF   = "STAFF"
+	ROLE_MANAGER = "MANAGER"
+	ROLE_ADMIN   = "ADMIN"
+)
+
+// what inventory does with a bought back item
+const (
+	BUYBACK_SCRAP  string = "scrap"
+	BUYBACK_RESALE string = "resale"
+
+	// product-service serial event of the sale of a piece
+	SERIAL_EVENT_SOLD string = "sold"
+	// product-service serial status once market.buyback_executed is consumed
+	SERIAL_BOUGHT_BACK string = "bought_back"
+)
@@ -0,0 +1,28 @@
+-- every price read by the crawler or entered by hand, rows are never
+-- updated nor deleted
+CREATE TABLE "gold_price_ticks" (
+  "id" BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "gold_id" INT NOT NULL,
+  "gold_type" varchar(50) NOT NULL,
+  "buy_price" decimal(15,2) NOT NULL,
+  "sell_price" decimal(15,2) NOT NULL,
+  "source" varchar(50) NOT NULL,
+  "recorded_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "gold_price_ticks" ("gold_id", "recorded_at");
+
+CREATE FUNCTION gold_price_ticks_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'gold_price_ticks is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER gold_price_ticks_append_only
+  BEFORE UPDATE OR DELETE ON "gold_price_ticks"
+  FOR EACH ROW EXECUTE FUNCTION gold_price_ticks_append_only();
+
+-- start the history with the prices stored so far
+INSERT INTO "gold_price_ticks" (gold_id, gold_type, buy_price, sell_price, source, recorded_at)
+SELECT gold_id, gold_type, buy_price, sell_price, 'import', COALESCE(date, now())
+FROM "gold_prices";
@@ -0,0 +1,27 @@
+-- jewelry bought back from customers, valued at the gold buy price of the
+-- day with the rate of a buyback policy
+CREATE TABLE "buybacks" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "gold_id" INT NOT NULL,
+  "gold_type" varchar(50) NOT NULL,
+  "weight" decimal(10,3) NOT NULL,
+  "buy_price" decimal(15,2) NOT NULL,
+  "policy_id" INT REFERENCES "buyback_policies" ("id") ON DELETE SET NULL,
+  "buyback_rate" decimal(5,2) NOT NULL,
+  "gold_value" decimal(15,2) NOT NULL,
+  "deductions" jsonb NOT NULL DEFAULT '[]',
+  "deduction_amount" decimal(15,2) NOT NULL DEFAULT 0,
+  "amount" decimal(15,2) NOT NULL,
+  "disposition" varchar(20) NOT NULL,
+  "order_id" INT,
+  "product_id" INT,
+  "serial_number" varchar(100),
+  "warranty_until" timestamp,
+  "customer_id" varchar(64) NOT NULL,
+  "staff_id" varchar(64) NOT NULL,
+  "note" text,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "buybacks" ("customer_id");
+CREATE INDEX ON "buybacks" ("serial_number");
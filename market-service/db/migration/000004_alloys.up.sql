@@ -0,0 +1,25 @@
+-- alloys priced off a crawled price row: the price of a mace of the alloy is
+-- the price of the row * purity / reference_purity, the purity of the metal
+-- quoted by the row
+CREATE TABLE "alloys" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "code" varchar(20) UNIQUE NOT NULL,
+  "name" varchar(100) NOT NULL,
+  "metal" varchar(20) NOT NULL,
+  "karat" INT NOT NULL DEFAULT 0,
+  "purity" decimal(5,4) NOT NULL,
+  "gold_id" INT,
+  "reference_purity" decimal(5,4) NOT NULL DEFAULT 0.9999
+);
+
+-- gold alloys follow the 999.9 jewelry price of BTMC (gold id 5), silver has
+-- no crawled price until it is mapped
+INSERT INTO "alloys" (code, name, metal, karat, purity, gold_id, reference_purity) VALUES
+  ('24K', 'Vàng 24K (9999)', 'gold', 24, 0.9999, 5, 0.9999),
+  ('18K', 'Vàng 18K (750)', 'gold', 18, 0.7500, 5, 0.9999),
+  ('14K', 'Vàng 14K (585)', 'gold', 14, 0.5850, 5, 0.9999),
+  ('10K', 'Vàng 10K (416)', 'gold', 10, 0.4160, 5, 0.9999),
+  ('18KW', 'Vàng trắng 18K (750)', 'white_gold', 18, 0.7500, 5, 0.9999),
+  ('S925', 'Bạc 925', 'silver', 0, 0.9250, NULL, 0.9990);
+
+ALTER TABLE "buybacks" ADD COLUMN "alloy_id" INT REFERENCES "alloys" ("id");
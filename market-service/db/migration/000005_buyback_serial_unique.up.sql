@@ -0,0 +1,3 @@
+-- A serial number is bought back at most once
+DROP INDEX IF EXISTS "buybacks_serial_number_idx";
+CREATE UNIQUE INDEX ON "buybacks" ("serial_number") WHERE "serial_number" IS NOT NULL;
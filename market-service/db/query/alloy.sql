@@ -0,0 +1,16 @@
+-- name: ListAlloys :many
+SELECT * FROM alloys
+ORDER BY metal, purity DESC, id;
+
+-- name: GetAlloy :one
+SELECT * FROM alloys
+WHERE id = $1;
+
+-- name: UpdateAlloy :one
+UPDATE alloys
+SET
+  name             = COALESCE(sqlc.narg('name'), name),
+  gold_id          = COALESCE(sqlc.narg('gold_id'), gold_id),
+  reference_purity = COALESCE(sqlc.narg('reference_purity'), reference_purity)
+WHERE id = sqlc.arg('id')
+RETURNING *;
@@ -0,0 +1,12 @@
+-- name: CreateBuyback :one
+INSERT INTO buybacks (
+  gold_id, gold_type, weight, buy_price, policy_id, buyback_rate, gold_value,
+  deductions, deduction_amount, amount, disposition, order_id, product_id,
+  serial_number, warranty_until, customer_id, staff_id, note, alloy_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+)
+RETURNING *;
+
+-- name: GetBuybackBySerial :one
+SELECT * FROM buybacks WHERE serial_number = $1;
@@ -1,6 +1,6 @@
 -- name: CreateGoldPrice :one
-INSERT INTO gold_prices (date, gold_type, buy_price, sell_price)
-VALUES ($1, $2, $3, $4)
+INSERT INTO gold_prices (date, gold_type, buy_price, sell_price, gold_id)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: GetGoldPrice :one
@@ -24,6 +24,12 @@ SELECT DISTINCT ON (gold_id) *
 FROM gold_prices
 ORDER BY gold_id, date DESC;
 
+-- name: GetLatestGoldPriceByGoldID :one
+SELECT * FROM gold_prices
+WHERE gold_id = $1
+ORDER BY date DESC NULLS LAST, id DESC
+LIMIT 1;
+
 -- name: UpdateGoldPrice :one
 UPDATE gold_prices
 SET date = $2, gold_type = $3, buy_price = $4, sell_price = $5
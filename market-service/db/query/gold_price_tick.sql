@@ -0,0 +1,25 @@
+-- name: CreateGoldPriceTick :one
+INSERT INTO gold_price_ticks (gold_id, gold_type, buy_price, sell_price, source, recorded_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListGoldPriceCandles :many
+-- OHLC of the ticks of a gold id by day, week or month, unit is a
+-- date_trunc field
+SELECT
+  date_trunc(sqlc.arg(unit)::text, recorded_at)::timestamp AS start_at,
+  (array_agg(buy_price ORDER BY recorded_at, id))[1]::decimal AS buy_open,
+  MAX(buy_price)::decimal AS buy_high,
+  MIN(buy_price)::decimal AS buy_low,
+  (array_agg(buy_price ORDER BY recorded_at DESC, id DESC))[1]::decimal AS buy_close,
+  (array_agg(sell_price ORDER BY recorded_at, id))[1]::decimal AS sell_open,
+  MAX(sell_price)::decimal AS sell_high,
+  MIN(sell_price)::decimal AS sell_low,
+  (array_agg(sell_price ORDER BY recorded_at DESC, id DESC))[1]::decimal AS sell_close,
+  COUNT(*) AS ticks
+FROM gold_price_ticks
+WHERE gold_id = sqlc.arg(gold_id)
+  AND recorded_at >= sqlc.arg(from_time)
+  AND recorded_at < sqlc.arg(to_time)
+GROUP BY start_at
+ORDER BY start_at;
@@ -6,7 +6,8 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.1
 	github.com/jackc/pgx/v5 v5.7.5
 	github.com/linhhuynhcoding/jss-microservices/jss-shared v0.0.0-20250901141604-8ee201cc0acb
-	github.com/linhhuynhcoding/jss-microservices/rpc v0.0.0-20250902043637-23d3102a8547
+	github.com/linhhuynhcoding/jss-microservices/mq v0.0.0-20261018213556-9ddc50504a37
+	github.com/linhhuynhcoding/jss-microservices/rpc v0.0.0-20261018215440-33198e18e549
 	github.com/robfig/cron/v3 v3.0.1
 	github.com/spf13/viper v1.20.1
 	go.uber.org/zap v1.27.0
@@ -21,6 +22,7 @@ require (
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
 	github.com/jackc/puddle/v2 v2.2.2 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
+	github.com/rabbitmq/amqp091-go v1.10.0 // indirect
 	github.com/sagikazarmark/locafero v0.7.0 // indirect
 	github.com/sourcegraph/conc v0.3.0 // indirect
 	github.com/spf13/afero v1.12.0 // indirect
@@ -0,0 +1,59 @@
+package auth_service
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	"github.com/linhhuynhcoding/jss-microservices/market/pkg/grpc"
+	"go.uber.org/zap"
+
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/auth"
+)
+
+type IAuthServiceClient interface {
+	Validate(ctx context.Context, token string) (bool, string, string, error)
+}
+
+type AuthServiceClient struct {
+	client api.AuthServiceClient
+	logger *zap.Logger
+	cfg    config.Config
+}
+
+func NewAuthServiceClient(
+	logger *zap.Logger,
+	cfg config.Config,
+) IAuthServiceClient {
+	return &AuthServiceClient{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (a *AuthServiceClient) Connect() error {
+	if a.client == nil {
+		conn, err := grpc.NewConnection(a.cfg.AuthServiceUrl)
+		if err != nil {
+			a.logger.Error("failed to connect to auth service", zap.Error(err))
+			return err
+		}
+		a.client = api.NewAuthServiceClient(conn)
+	}
+	return nil
+}
+
+// Validate returns whether the access token is valid, the user ID and the role
+func (a *AuthServiceClient) Validate(ctx context.Context, token string) (bool, string, string, error) {
+	log := a.logger.With(zap.String("func", "Validate"))
+	if err := a.Connect(); err != nil {
+		log.Error("failed to connect to auth service", zap.Error(err))
+		return false, "", "", err
+	}
+
+	resp, err := a.client.ValidateToken(ctx, &api.ValidateTokenRequest{AccessToken: token})
+	if err != nil {
+		log.Error("failed to validate token", zap.Error(err))
+		return false, "", "", err
+	}
+	return resp.GetIsValid(), resp.GetUserId(), resp.GetRole(), nil
+}
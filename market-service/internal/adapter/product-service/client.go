@@ -0,0 +1,77 @@
+package product_service
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	"github.com/linhhuynhcoding/jss-microservices/market/pkg/grpc"
+	"go.uber.org/zap"
+
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+)
+
+type IProductServiceClient interface {
+	GetCustomer(ctx context.Context, req *api.GetCustomerRequest) (*api.CustomerResponse, error)
+	GetSerialHistory(ctx context.Context, req *api.GetSerialHistoryRequest) (*api.GetSerialHistoryResponse, error)
+}
+
+type ProductServiceClient struct {
+	client api.ProductCustomerClient
+	logger *zap.Logger
+	cfg    config.Config
+}
+
+func NewProductServiceClient(
+	logger *zap.Logger,
+	cfg config.Config,
+) IProductServiceClient {
+	return &ProductServiceClient{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (p *ProductServiceClient) Connect() error {
+	if p.client == nil {
+		conn, err := grpc.NewConnection(p.cfg.ProductServiceUrl)
+		if err != nil {
+			p.logger.Error("failed to connect to product service", zap.Error(err))
+			return err
+		}
+		p.client = api.NewProductCustomerClient(conn)
+	}
+	return nil
+}
+
+// GetCustomer finds a customer by phone or uuid
+func (p *ProductServiceClient) GetCustomer(ctx context.Context, req *api.GetCustomerRequest) (*api.CustomerResponse, error) {
+	log := p.logger.With(zap.String("func", "GetCustomer"))
+	if err := p.Connect(); err != nil {
+		log.Error("failed to connect to product service", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := p.client.GetCustomer(ctx, req)
+	if err != nil {
+		log.Error("failed to get customer", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetSerialHistory returns a serialized piece with its product and the sale
+// it went out with
+func (p *ProductServiceClient) GetSerialHistory(ctx context.Context, req *api.GetSerialHistoryRequest) (*api.GetSerialHistoryResponse, error) {
+	log := p.logger.With(zap.String("func", "GetSerialHistory"))
+	if err := p.Connect(); err != nil {
+		log.Error("failed to connect to product service", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := p.client.GetSerialHistory(ctx, req)
+	if err != nil {
+		log.Error("failed to get serial history", zap.Error(err))
+		return nil, err
+	}
+	return resp, nil
+}
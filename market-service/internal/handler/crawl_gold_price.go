@@ -2,16 +2,17 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
+	"slices"
 	"strconv"
-	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
 	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	"github.com/linhhuynhcoding/jss-microservices/market/internal/notifier"
+	"github.com/linhhuynhcoding/jss-microservices/market/internal/provider"
 	"github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
 	"go.uber.org/zap"
 )
@@ -21,106 +22,130 @@ type IGoldPriceCrawler interface {
 }
 
 type GoldPriceCrawler struct {
-	logger  *zap.Logger
-	cfg     config.Config
-	queries repository.Store
+	logger   *zap.Logger
+	cfg      config.Config
+	queries  repository.Store
+	notifier *notifier.PriceNotifier
+
+	// by priority
+	providers []provider.PriceProvider
 }
 
-func NewGoldPriceCrawler(ctx context.Context, logger *zap.Logger, cfg config.Config, store repository.Store) IGoldPriceCrawler {
-	return &GoldPriceCrawler{
-		logger:  logger,
-		cfg:     cfg,
-		queries: store,
+// NewGoldPriceCrawler fails on a misconfigured provider, e.g. a missing API
+// key, rather than crawling without it
+func NewGoldPriceCrawler(ctx context.Context, logger *zap.Logger, cfg config.Config, store repository.Store, priceNotifier *notifier.PriceNotifier) (IGoldPriceCrawler, error) {
+	providers := make([]provider.PriceProvider, 0, len(cfg.GoldPriceProviders))
+	for _, pc := range cfg.GoldPriceProviders {
+		p, err := provider.New(pc)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, p)
 	}
-}
 
-// --- Struct để parse JSON từ API ---
-type BTMCResponse struct {
-	DataList struct {
-		Data []map[string]string `json:"Data"`
-	} `json:"DataList"`
+	return &GoldPriceCrawler{
+		logger:    logger,
+		cfg:       cfg,
+		queries:   store,
+		notifier:  priceNotifier,
+		providers: providers,
+	}, nil
 }
 
 func (c *GoldPriceCrawler) Handle(ctx context.Context) error {
 	logger := c.logger.With(zap.String("func", "GoldPriceCrawler"))
 
-	// 1. Call API
-	resp, err := http.Get("http://api.btmc.vn/api/BTMCAPI/getpricebtmc?key=3kd8ub1llcg9t45hnoh8hmn7t5kc2v")
-	if err != nil {
-		logger.Error("Failed to call API", zap.Error(err))
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Error("Failed to read response body", zap.Error(err))
-		return err
+	// 1. Call every provider, a failing one falls back to the next
+	quotes := make(map[string]map[int32]provider.Quote, len(c.providers))
+	var goldIDs []int32
+	for _, p := range c.providers {
+		res, err := p.Fetch(ctx)
+		if err != nil {
+			logger.Warn("Gold price provider failed", zap.String("provider", p.Name()), zap.Error(err))
+			continue
+		}
+		byID := make(map[int32]provider.Quote, len(res))
+		for _, q := range res {
+			byID[q.GoldID] = q
+			if !slices.Contains(goldIDs, q.GoldID) {
+				goldIDs = append(goldIDs, q.GoldID)
+			}
+		}
+		quotes[p.Name()] = byID
 	}
-	logger.Info("Response body", zap.ByteString("body", body))
-
-	// 2. Parse JSON
-	var data BTMCResponse
-	if err := json.Unmarshal(body, &data); err != nil {
-		logger.Error("Failed to parse JSON", zap.Error(err))
-		return err
+	if len(quotes) == 0 {
+		logger.Error("No gold price provider available")
+		return fmt.Errorf("no gold price provider available")
 	}
+	slices.Sort(goldIDs)
 
-	// 3. Insert/update data
-	for _, item := range data.DataList.Data {
-		goldID := 1
-		goldType := item["@n_1"]
-		if goldType == "" {
-			// fallback: các row có thể dùng n_2, n_3...
-			for k, v := range item {
-				if len(k) > 2 && k[:2] == "@n" {
-					goldID, err = strconv.Atoi(k[3:])
-					if err != nil {
-						logger.Error("Failed to parse goldID", zap.Error(err))
-						break
-					}
-					goldType = v
-					break
-				}
-			}
+	// 2. Insert the quote of the provider with the highest priority
+	for _, goldID := range goldIDs {
+		source, q, ok := c.pick(quotes, goldID)
+		if !ok {
+			continue
 		}
 
-		var pb, ps, d string
-		for k, v := range item {
-			switch {
-			case len(k) > 3 && k[:3] == "@pb":
-				pb = v
-			case len(k) > 3 && k[:3] == "@ps":
-				ps = v
-			case len(k) > 2 && k[:2] == "@d":
-				d = v
-			}
+		// the latest price before this one, to publish the change. Without
+		// it the change is unknown and nothing is published.
+		var previous *repository.GoldPrice
+		notify := true
+		latest, err := c.queries.GetLatestGoldPriceByGoldID(ctx, q.GoldID)
+		switch {
+		case err == nil:
+			previous = &latest
+		case !errors.Is(err, pgx.ErrNoRows):
+			logger.Error("Failed to get latest gold price", zap.Int32("goldID", q.GoldID), zap.Error(err))
+			notify = false
 		}
 
-		var buyPrice, sellPrice float64
-		fmt.Sscanf(pb, "%f", &buyPrice)
-		fmt.Sscanf(ps, "%f", &sellPrice)
-
-		date, err := time.Parse("02/01/2006 15:04", d)
+		g, err := c.queries.UpsertPrice(ctx, repository.UpsertPriceParams{
+			Date:      pgtype.Timestamp{Valid: true, Time: q.Date},
+			GoldID:    q.GoldID,
+			GoldType:  q.GoldType,
+			BuyPrice:  utils.ToNumeric(q.BuyPrice),
+			SellPrice: utils.ToNumeric(q.SellPrice),
+		})
 		if err != nil {
-			logger.Warn("Invalid date format, skip", zap.String("date", d))
+			logger.Error("Failed to upsert gold price", zap.String("goldType", q.GoldType), zap.Error(err))
 			continue
 		}
-
-		g, err := c.queries.UpsertPrice(ctx, repository.UpsertPriceParams{
-			Date:      pgtype.Timestamp{Valid: true, Time: date},
-			GoldID:    int32(goldID),
-			GoldType:  goldType,
-			BuyPrice:  utils.ToNumeric(buyPrice),
-			SellPrice: utils.ToNumeric(sellPrice),
+		logger.Info("Upserted gold price", zap.String("provider", source), zap.Any("gold_price", g))
+
+		// every read is kept in the history, unchanged prices included
+		_, err = c.queries.CreateGoldPriceTick(ctx, repository.CreateGoldPriceTickParams{
+			GoldID:     g.GoldID,
+			GoldType:   g.GoldType,
+			BuyPrice:   g.BuyPrice,
+			SellPrice:  g.SellPrice,
+			Source:     source,
+			RecordedAt: g.Date,
 		})
 		if err != nil {
-			logger.Error("Failed to upsert gold price", zap.String("goldType", goldType), zap.Error(err))
-		} else {
-			logger.Info("Upserted gold price", zap.Any("gold_price", g))
+			logger.Error("Failed to record gold price tick", zap.String("goldType", q.GoldType), zap.Error(err))
+		}
+		if notify {
+			c.notifier.Notify(previous, g)
 		}
 	}
 
 	logger.Info("Done updating gold prices")
 	return nil
 }
+
+// pick returns the quote of a gold type from the first provider answering
+// it, in the order configured for the gold type or the default order
+func (c *GoldPriceCrawler) pick(quotes map[string]map[int32]provider.Quote, goldID int32) (string, provider.Quote, bool) {
+	names, ok := c.cfg.GoldPriceSources[strconv.Itoa(int(goldID))]
+	if !ok {
+		for _, p := range c.providers {
+			names = append(names, p.Name())
+		}
+	}
+	for _, name := range names {
+		if q, ok := quotes[name][goldID]; ok {
+			return name, q, true
+		}
+	}
+	return "", provider.Quote{}, false
+}
@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"math"
+	"sync"
+
+	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	db "github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type price struct {
+	buy  float64
+	sell float64
+}
+
+// PriceNotifier publishes market.gold_price_changed when the price of a gold
+// type moves by at least the configured minimum delta. Small moves add up:
+// the change is measured from the last published price, not the last row.
+type PriceNotifier struct {
+	logger    *zap.Logger
+	publisher *mq.Publisher
+	minDelta  float64
+
+	mu sync.Mutex
+	// gold id -> last published price
+	last map[int32]price
+}
+
+// NewPriceNotifier publishes on publisher, nil drops the events
+func NewPriceNotifier(logger *zap.Logger, cfg config.Config, publisher *mq.Publisher) *PriceNotifier {
+	return &PriceNotifier{
+		logger:    logger,
+		publisher: publisher,
+		minDelta:  cfg.GoldPriceMinDelta,
+		last:      make(map[int32]price),
+	}
+}
+
+// Notify compares current with the last published price of its gold id, or
+// with previous (the latest row before the change, nil for a new gold type)
+// when nothing was published yet, and publishes the change if it is large
+// enough. Events are best effort, a failure is only logged.
+func (n *PriceNotifier) Notify(previous *db.GoldPrice, current db.GoldPrice) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := price{
+		buy:  utils.NumericToFloat64(current.BuyPrice),
+		sell: utils.NumericToFloat64(current.SellPrice),
+	}
+	old, ok := n.last[current.GoldID]
+	if !ok && previous != nil {
+		old = price{
+			buy:  utils.NumericToFloat64(previous.BuyPrice),
+			sell: utils.NumericToFloat64(previous.SellPrice),
+		}
+	}
+
+	if old == now {
+		return
+	}
+	if math.Abs(now.buy-old.buy) < n.minDelta && math.Abs(now.sell-old.sell) < n.minDelta {
+		return
+	}
+
+	evt := &events.GoldPriceChangedEvent{
+		GoldId:       current.GoldID,
+		GoldType:     current.GoldType,
+		OldBuyPrice:  old.buy,
+		NewBuyPrice:  now.buy,
+		OldSellPrice: old.sell,
+		NewSellPrice: now.sell,
+		ChangedAt:    timestamppb.Now(),
+	}
+	if current.Date.Valid {
+		evt.ChangedAt = timestamppb.New(current.Date.Time)
+	}
+
+	if n.publisher == nil {
+		return
+	}
+	if err := n.publisher.SendMessage(evt, mqconsts.TOPIC_GOLD_PRICE_CHANGED); err != nil {
+		n.logger.Error("failed to publish event", zap.String("topic", mqconsts.TOPIC_GOLD_PRICE_CHANGED), zap.Error(err))
+		return
+	}
+	n.last[current.GoldID] = now
+	n.logger.Info("Gold price change published", zap.Any("event", evt))
+}
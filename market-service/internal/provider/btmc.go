@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+)
+
+// btmcProvider reads the prices of Bao Tin Minh Chau. The API answers JSON
+// or XML with the same rows, the fields of a row are suffixed by its gold
+// id, e.g. n_1, pb_1, ps_1, d_1.
+type btmcProvider struct {
+	cfg    config.ProviderConfig
+	client *http.Client
+	xml    bool
+}
+
+// --- Struct để parse JSON từ API ---
+type btmcResponse struct {
+	DataList struct {
+		Data []map[string]string `json:"Data"`
+	} `json:"DataList"`
+}
+
+type btmcDataList struct {
+	XMLName xml.Name   `xml:"DataList"`
+	Items   []btmcData `xml:"Data"`
+}
+
+type btmcData struct {
+	Row    string
+	Fields map[string]string
+}
+
+func (d *btmcData) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	d.Fields = make(map[string]string)
+	for _, attr := range start.Attr {
+		d.Fields[attr.Name.Local] = attr.Value
+		if attr.Name.Local == "row" {
+			d.Row = attr.Value
+		}
+	}
+	// skip end element
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if end, ok := t.(xml.EndElement); ok && end.Name == start.Name {
+			break
+		}
+	}
+	return nil
+}
+
+func (p *btmcProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *btmcProvider) Fetch(ctx context.Context) ([]Quote, error) {
+	u, err := url.Parse(p.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	if p.cfg.APIKey != "" {
+		q := u.Query()
+		q.Set("key", p.cfg.APIKey)
+		u.RawQuery = q.Encode()
+	}
+
+	var rows []map[string]string
+	if p.xml {
+		body, err := get(ctx, p.client, u.String(), "application/xml")
+		if err != nil {
+			return nil, err
+		}
+		var data btmcDataList
+		if err := xml.Unmarshal(body, &data); err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %w", err)
+		}
+		for _, item := range data.Items {
+			rows = append(rows, item.Fields)
+		}
+	} else {
+		body, err := get(ctx, p.client, u.String(), "application/json")
+		if err != nil {
+			return nil, err
+		}
+		var data btmcResponse
+		if err := json.Unmarshal(body, &data); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		rows = data.DataList.Data
+	}
+
+	quotes := make([]Quote, 0, len(rows))
+	for _, row := range rows {
+		q, err := p.quote(row)
+		if err != nil {
+			// one malformed row does not invalidate the others
+			continue
+		}
+		quotes = append(quotes, q)
+	}
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("no valid price in the response")
+	}
+	return quotes, nil
+}
+
+// quote reads a row, JSON field names carry the @ of the XML attributes
+func (p *btmcProvider) quote(row map[string]string) (Quote, error) {
+	var q Quote
+	var buy, sell, date string
+	for k, v := range row {
+		field, suffix, ok := strings.Cut(strings.TrimPrefix(k, "@"), "_")
+		if !ok {
+			continue
+		}
+		switch field {
+		case "n":
+			id, err := strconv.Atoi(suffix)
+			if err != nil {
+				return Quote{}, fmt.Errorf("invalid gold id %q", suffix)
+			}
+			q.GoldID, q.GoldType = int32(id), v
+		case "pb":
+			buy = v
+		case "ps":
+			sell = v
+		case "d":
+			date = v
+		}
+	}
+	if q.GoldType == "" {
+		return Quote{}, fmt.Errorf("missing gold type")
+	}
+
+	var err error
+	if q.BuyPrice, err = strconv.ParseFloat(strings.TrimSpace(buy), 64); err != nil {
+		return Quote{}, fmt.Errorf("invalid buy price %q", buy)
+	}
+	if q.SellPrice, err = strconv.ParseFloat(strings.TrimSpace(sell), 64); err != nil {
+		return Quote{}, fmt.Errorf("invalid sell price %q", sell)
+	}
+	q.BuyPrice *= p.cfg.Scale
+	q.SellPrice *= p.cfg.Scale
+	if q.Date, err = time.Parse("02/01/2006 15:04", date); err != nil {
+		return Quote{}, fmt.Errorf("invalid date %q", date)
+	}
+	return q, nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+)
+
+// dojiProvider reads the XML price lists of DOJI, bullion and jewelry.
+// Prices are in thousands of dong per tael.
+type dojiProvider struct {
+	cfg    config.ProviderConfig
+	client *http.Client
+}
+
+type dojiList struct {
+	DateTime string `xml:"DateTime"`
+	Rows     []struct {
+		Name string `xml:"Name,attr"`
+		Buy  string `xml:"Buy,attr"`
+		Sell string `xml:"Sell,attr"`
+	} `xml:"Row"`
+}
+
+type dojiResponse struct {
+	XMLName     xml.Name `xml:"GoldList"`
+	DGPList     dojiList `xml:"DGPlist"`
+	JewelryList dojiList `xml:"JewelryList"`
+}
+
+func (p *dojiProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *dojiProvider) Fetch(ctx context.Context) ([]Quote, error) {
+	u, err := url.Parse(p.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	if p.cfg.APIKey != "" {
+		q := u.Query()
+		q.Set("api_key", p.cfg.APIKey)
+		u.RawQuery = q.Encode()
+	}
+
+	body, err := get(ctx, p.client, u.String(), "application/xml")
+	if err != nil {
+		return nil, err
+	}
+	var data dojiResponse
+	if err := xml.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse XML: %w", err)
+	}
+
+	var quotes []Quote
+	for _, list := range []dojiList{data.DGPList, data.JewelryList} {
+		date, err := time.Parse("15:04 02/01/2006", list.DateTime)
+		if err != nil {
+			continue
+		}
+		for _, row := range list.Rows {
+			goldID, ok := mappedGoldID(p.cfg, row.Name)
+			if !ok {
+				continue
+			}
+			buy, err := parsePrice(row.Buy, p.cfg.Scale)
+			if err != nil {
+				continue
+			}
+			sell, err := parsePrice(row.Sell, p.cfg.Scale)
+			if err != nil {
+				continue
+			}
+			quotes = append(quotes, Quote{
+				GoldID:    goldID,
+				GoldType:  row.Name,
+				BuyPrice:  buy,
+				SellPrice: sell,
+				Date:      date,
+			})
+		}
+	}
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("no configured gold type in the price list")
+	}
+	return quotes, nil
+}
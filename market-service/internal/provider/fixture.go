@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+)
+
+// fixtureProvider reads the quotes from a JSON file, to run the crawler
+// offline. Quotes without a date are dated when read.
+type fixtureProvider struct {
+	cfg config.ProviderConfig
+}
+
+func (p *fixtureProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *fixtureProvider) Fetch(ctx context.Context) ([]Quote, error) {
+	body, err := os.ReadFile(p.cfg.File)
+	if err != nil {
+		return nil, err
+	}
+	var quotes []Quote
+	if err := json.Unmarshal(body, &quotes); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", p.cfg.File, err)
+	}
+
+	now := time.Now().Truncate(time.Minute)
+	for i := range quotes {
+		if quotes[i].Date.IsZero() {
+			quotes[i].Date = now
+		}
+	}
+	return quotes, nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	"github.com/linhhuynhcoding/jss-microservices/market/consts"
+)
+
+// Quote is the price of a gold type read from a provider
+type Quote struct {
+	GoldID    int32     `json:"gold_id"`
+	GoldType  string    `json:"gold_type"`
+	BuyPrice  float64   `json:"buy_price"`
+	SellPrice float64   `json:"sell_price"`
+	Date      time.Time `json:"date"`
+}
+
+// PriceProvider reads the current gold prices from a source
+type PriceProvider interface {
+	Name() string
+	Fetch(ctx context.Context) ([]Quote, error)
+}
+
+// keyedKinds are the providers refusing requests without an API key
+var keyedKinds = []string{consts.PROVIDER_BTMC_JSON, consts.PROVIDER_BTMC_XML, consts.PROVIDER_DOJI}
+
+// New returns the provider described by cfg
+func New(cfg config.ProviderConfig) (PriceProvider, error) {
+	if cfg.Name == "" {
+		cfg.Name = cfg.Kind
+	}
+	if cfg.APIKey == "" && slices.Contains(keyedKinds, cfg.Kind) {
+		return nil, fmt.Errorf("provider %s: API key is required, set %s or API_KEY", cfg.Name, cfg.APIKeyEnv())
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = consts.DEFAULT_PROVIDER_TIMEOUT
+	}
+	client := &http.Client{Timeout: cfg.Timeout}
+
+	switch cfg.Kind {
+	case consts.PROVIDER_BTMC_JSON:
+		return &btmcProvider{cfg: withDefaults(cfg, consts.BTMC_URL, 1), client: client}, nil
+	case consts.PROVIDER_BTMC_XML:
+		return &btmcProvider{cfg: withDefaults(cfg, consts.BTMC_URL, 1), client: client, xml: true}, nil
+	// thousands of dong per tael, stored per mace like BTMC
+	case consts.PROVIDER_SJC:
+		return &sjcProvider{cfg: withDefaults(cfg, consts.SJC_URL, 100), client: client}, nil
+	case consts.PROVIDER_DOJI:
+		return &dojiProvider{cfg: withDefaults(cfg, consts.DOJI_URL, 100), client: client}, nil
+	case consts.PROVIDER_FIXTURE:
+		if cfg.File == "" {
+			return nil, fmt.Errorf("provider %s: fixture file is required", cfg.Name)
+		}
+		return &fixtureProvider{cfg: cfg}, nil
+	}
+	return nil, fmt.Errorf("provider %s: unknown kind %q", cfg.Name, cfg.Kind)
+}
+
+func withDefaults(cfg config.ProviderConfig, url string, scale float64) config.ProviderConfig {
+	if cfg.URL == "" {
+		cfg.URL = url
+	}
+	if cfg.Scale == 0 {
+		cfg.Scale = scale
+	}
+	return cfg
+}
+
+// get downloads the body of url, accept is sent as the Accept header when set
+func get(ctx context.Context, client *http.Client, url, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return body, nil
+}
+
+// parsePrice reads a price written with thousand separators, e.g. "68,150"
+// or "68.150", and applies the scale of the provider
+func parsePrice(s string, scale float64) (float64, error) {
+	s = strings.NewReplacer(",", "", ".", "", " ", "").Replace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty price")
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	return v * scale, nil
+}
+
+// mappedGoldID returns the gold id configured for a gold type of the provider
+func mappedGoldID(cfg config.ProviderConfig, goldType string) (int32, bool) {
+	id, ok := cfg.GoldIDs[strings.ToLower(strings.TrimSpace(goldType))]
+	return id, ok
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/market/config"
+)
+
+// sjcProvider reads the XML price list of SJC. Prices are in thousands of
+// dong per tael, only the first city of the list is used.
+type sjcProvider struct {
+	cfg    config.ProviderConfig
+	client *http.Client
+}
+
+type sjcResponse struct {
+	XMLName  xml.Name `xml:"root"`
+	RateList struct {
+		Updated string `xml:"updated,attr"`
+		Cities  []struct {
+			Name  string `xml:"name,attr"`
+			Items []struct {
+				Type string `xml:"type,attr"`
+				Buy  string `xml:"buy,attr"`
+				Sell string `xml:"sell,attr"`
+			} `xml:"item"`
+		} `xml:"city"`
+	} `xml:"ratelist"`
+}
+
+func (p *sjcProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *sjcProvider) Fetch(ctx context.Context) ([]Quote, error) {
+	body, err := get(ctx, p.client, p.cfg.URL, "application/xml")
+	if err != nil {
+		return nil, err
+	}
+	var data sjcResponse
+	if err := xml.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse XML: %w", err)
+	}
+	if len(data.RateList.Cities) == 0 {
+		return nil, fmt.Errorf("no city in the price list")
+	}
+	date, err := time.Parse("03:04:05 PM 02/01/2006", data.RateList.Updated)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update time %q", data.RateList.Updated)
+	}
+
+	var quotes []Quote
+	for _, item := range data.RateList.Cities[0].Items {
+		goldID, ok := mappedGoldID(p.cfg, item.Type)
+		if !ok {
+			continue
+		}
+		buy, err := parsePrice(item.Buy, p.cfg.Scale)
+		if err != nil {
+			continue
+		}
+		sell, err := parsePrice(item.Sell, p.cfg.Scale)
+		if err != nil {
+			continue
+		}
+		quotes = append(quotes, Quote{
+			GoldID:    goldID,
+			GoldType:  item.Type,
+			BuyPrice:  buy,
+			SellPrice: sell,
+			Date:      date,
+		})
+	}
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("no configured gold type in the price list")
+	}
+	return quotes, nil
+}
@@ -35,8 +35,8 @@ func (q *Queries) CountGoldPricesByType(ctx context.Context, goldType string) (i
 }
 
 const createGoldPrice = `-- name: CreateGoldPrice :one
-INSERT INTO gold_prices (date, gold_type, buy_price, sell_price)
-VALUES ($1, $2, $3, $4)
+INSERT INTO gold_prices (date, gold_type, buy_price, sell_price, gold_id)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING id, date, gold_id, gold_type, buy_price, sell_price
 `
 
@@ -45,6 +45,7 @@ type CreateGoldPriceParams struct {
 	GoldType  string           `json:"gold_type"`
 	BuyPrice  pgtype.Numeric   `json:"buy_price"`
 	SellPrice pgtype.Numeric   `json:"sell_price"`
+	GoldID    int32            `json:"gold_id"`
 }
 
 func (q *Queries) CreateGoldPrice(ctx context.Context, arg CreateGoldPriceParams) (GoldPrice, error) {
@@ -53,6 +54,7 @@ func (q *Queries) CreateGoldPrice(ctx context.Context, arg CreateGoldPriceParams
 		arg.GoldType,
 		arg.BuyPrice,
 		arg.SellPrice,
+		arg.GoldID,
 	)
 	var i GoldPrice
 	err := row.Scan(
@@ -174,6 +176,27 @@ func (q *Queries) GetGoldPricesByType(ctx context.Context, arg GetGoldPricesByTy
 	return items, nil
 }
 
+const getLatestGoldPriceByGoldID = `-- name: GetLatestGoldPriceByGoldID :one
+SELECT id, date, gold_id, gold_type, buy_price, sell_price FROM gold_prices
+WHERE gold_id = $1
+ORDER BY date DESC NULLS LAST, id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestGoldPriceByGoldID(ctx context.Context, goldID int32) (GoldPrice, error) {
+	row := q.db.QueryRow(ctx, getLatestGoldPriceByGoldID, goldID)
+	var i GoldPrice
+	err := row.Scan(
+		&i.ID,
+		&i.Date,
+		&i.GoldID,
+		&i.GoldType,
+		&i.BuyPrice,
+		&i.SellPrice,
+	)
+	return i, err
+}
+
 const getLatestGoldPrices = `-- name: GetLatestGoldPrices :many
 SELECT DISTINCT ON (gold_id) id, date, gold_id, gold_type, buy_price, sell_price
 FROM gold_prices
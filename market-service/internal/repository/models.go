@@ -8,6 +8,41 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Alloy struct {
+	ID              int32          `json:"id"`
+	Code            string         `json:"code"`
+	Name            string         `json:"name"`
+	Metal           string         `json:"metal"`
+	Karat           int32          `json:"karat"`
+	Purity          pgtype.Numeric `json:"purity"`
+	GoldID          pgtype.Int4    `json:"gold_id"`
+	ReferencePurity pgtype.Numeric `json:"reference_purity"`
+}
+
+type Buyback struct {
+	ID              int32            `json:"id"`
+	GoldID          int32            `json:"gold_id"`
+	GoldType        string           `json:"gold_type"`
+	Weight          pgtype.Numeric   `json:"weight"`
+	BuyPrice        pgtype.Numeric   `json:"buy_price"`
+	PolicyID        pgtype.Int4      `json:"policy_id"`
+	BuybackRate     pgtype.Numeric   `json:"buyback_rate"`
+	GoldValue       pgtype.Numeric   `json:"gold_value"`
+	Deductions      []byte           `json:"deductions"`
+	DeductionAmount pgtype.Numeric   `json:"deduction_amount"`
+	Amount          pgtype.Numeric   `json:"amount"`
+	Disposition     string           `json:"disposition"`
+	OrderID         pgtype.Int4      `json:"order_id"`
+	ProductID       pgtype.Int4      `json:"product_id"`
+	SerialNumber    pgtype.Text      `json:"serial_number"`
+	WarrantyUntil   pgtype.Timestamp `json:"warranty_until"`
+	CustomerID      string           `json:"customer_id"`
+	StaffID         string           `json:"staff_id"`
+	Note            pgtype.Text      `json:"note"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	AlloyID         pgtype.Int4      `json:"alloy_id"`
+}
+
 type BuybackPolicy struct {
 	ID          int32            `json:"id"`
 	ProductType string           `json:"product_type"`
@@ -24,3 +59,13 @@ type GoldPrice struct {
 	BuyPrice  pgtype.Numeric   `json:"buy_price"`
 	SellPrice pgtype.Numeric   `json:"sell_price"`
 }
+
+type GoldPriceTick struct {
+	ID         int64            `json:"id"`
+	GoldID     int32            `json:"gold_id"`
+	GoldType   string           `json:"gold_type"`
+	BuyPrice   pgtype.Numeric   `json:"buy_price"`
+	SellPrice  pgtype.Numeric   `json:"sell_price"`
+	Source     string           `json:"source"`
+	RecordedAt pgtype.Timestamp `json:"recorded_at"`
+}
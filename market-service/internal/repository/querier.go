@@ -6,6 +6,8 @@ package repository
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
@@ -13,11 +15,15 @@ type Querier interface {
 	CountBuybackPoliciesByProductType(ctx context.Context, productType string) (int64, error)
 	CountGoldPrices(ctx context.Context) (int64, error)
 	CountGoldPricesByType(ctx context.Context, goldType string) (int64, error)
+	CreateBuyback(ctx context.Context, arg CreateBuybackParams) (Buyback, error)
 	CreateBuybackPolicy(ctx context.Context, arg CreateBuybackPolicyParams) (BuybackPolicy, error)
 	CreateGoldPrice(ctx context.Context, arg CreateGoldPriceParams) (GoldPrice, error)
+	CreateGoldPriceTick(ctx context.Context, arg CreateGoldPriceTickParams) (GoldPriceTick, error)
 	DeleteBuybackPolicy(ctx context.Context, id int32) error
 	DeleteGoldPrice(ctx context.Context, id int32) error
 	GetActiveBuybackPolicies(ctx context.Context, arg GetActiveBuybackPoliciesParams) ([]BuybackPolicy, error)
+	GetAlloy(ctx context.Context, id int32) (Alloy, error)
+	GetBuybackBySerial(ctx context.Context, serialNumber pgtype.Text) (Buyback, error)
 	GetBuybackPolicies(ctx context.Context, arg GetBuybackPoliciesParams) ([]BuybackPolicy, error)
 	GetBuybackPoliciesByProductType(ctx context.Context, arg GetBuybackPoliciesByProductTypeParams) ([]BuybackPolicy, error)
 	GetBuybackPolicy(ctx context.Context, id int32) (BuybackPolicy, error)
@@ -27,9 +33,15 @@ type Querier interface {
 	GetGoldPricesByDateRange(ctx context.Context, arg GetGoldPricesByDateRangeParams) ([]GoldPrice, error)
 	GetGoldPricesByType(ctx context.Context, arg GetGoldPricesByTypeParams) ([]GoldPrice, error)
 	GetGoldPricesWithHighestBuyPrice(ctx context.Context, arg GetGoldPricesWithHighestBuyPriceParams) ([]GoldPrice, error)
+	GetLatestGoldPriceByGoldID(ctx context.Context, goldID int32) (GoldPrice, error)
 	GetLatestGoldPrices(ctx context.Context) ([]GoldPrice, error)
+	ListAlloys(ctx context.Context) ([]Alloy, error)
+	// OHLC of the ticks of a gold id by day, week or month, unit is a
+	// date_trunc field
+	ListGoldPriceCandles(ctx context.Context, arg ListGoldPriceCandlesParams) ([]ListGoldPriceCandlesRow, error)
 	SearchBuybackPoliciesByProductType(ctx context.Context, arg SearchBuybackPoliciesByProductTypeParams) ([]BuybackPolicy, error)
 	SearchGoldPricesByType(ctx context.Context, arg SearchGoldPricesByTypeParams) ([]GoldPrice, error)
+	UpdateAlloy(ctx context.Context, arg UpdateAlloyParams) (Alloy, error)
 	UpdateBuybackPolicy(ctx context.Context, arg UpdateBuybackPolicyParams) (BuybackPolicy, error)
 	UpdateBuybackRate(ctx context.Context, arg UpdateBuybackRateParams) (BuybackPolicy, error)
 	UpdateGoldPrice(ctx context.Context, arg UpdateGoldPriceParams) (GoldPrice, error)
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
+	"github.com/linhhuynhcoding/jss-microservices/market/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
+)
+
+func (s *Service) ListAlloys(ctx context.Context, req *api.ListAlloysRequest) (*api.ListAlloysResponse, error) {
+	s.logger.Info("ListAlloys called", zap.Any("req", req))
+
+	alloys, err := s.queries.ListAlloys(ctx)
+	if err != nil {
+		s.logger.Error("failed to list alloys", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list alloys: %v", err)
+	}
+	gps, err := s.queries.GetLatestGoldPrices(ctx)
+	if err != nil {
+		s.logger.Error("failed to get latest gold prices", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get latest gold prices: %v", err)
+	}
+	prices := make(map[int32]*db.GoldPrice, len(gps))
+	for i := range gps {
+		prices[gps[i].GoldID] = &gps[i]
+	}
+
+	results := make([]*api.Alloy, 0, len(alloys))
+	for _, a := range alloys {
+		var gp *db.GoldPrice
+		if a.GoldID.Valid {
+			gp = prices[a.GoldID.Int32]
+		}
+		results = append(results, s.dbAlloy2PbAlloy(a, gp))
+	}
+	return &api.ListAlloysResponse{Alloys: results}, nil
+}
+
+func (s *Service) GetAlloy(ctx context.Context, req *api.GetAlloyRequest) (*api.GetAlloyResponse, error) {
+	s.logger.Info("GetAlloy called", zap.Any("req", req))
+
+	alloy, gp, err := s.alloyWithPrice(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetAlloyResponse{Alloy: s.dbAlloy2PbAlloy(alloy, gp)}, nil
+}
+
+func (s *Service) UpdateAlloy(ctx context.Context, req *api.UpdateAlloyRequest) (*api.UpdateAlloyResponse, error) {
+	s.logger.Info("UpdateAlloy called", zap.Any("req", req))
+
+	// products are repriced off the alloy purity
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if req.ReferencePurity < 0 || req.ReferencePurity > 1 {
+		return nil, status.Error(codes.InvalidArgument, "reference purity must be between 0 and 1")
+	}
+	arg := db.UpdateAlloyParams{
+		ID:     req.Id,
+		Name:   pgtype.Text{String: req.Name, Valid: req.Name != ""},
+		GoldID: pgtype.Int4{Int32: req.GoldId, Valid: req.GoldId != 0},
+	}
+	if req.ReferencePurity != 0 {
+		arg.ReferencePurity = utils.ToNumeric(req.ReferencePurity)
+	}
+
+	if _, err := s.queries.UpdateAlloy(ctx, arg); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "alloy not found")
+		}
+		s.logger.Error("failed to update alloy", zap.Error(err), zap.Int32("id", req.Id))
+		return nil, status.Errorf(codes.Internal, "failed to update alloy: %v", err)
+	}
+
+	alloy, gp, err := s.alloyWithPrice(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Alloy updated", zap.Any("alloy", alloy))
+	return &api.UpdateAlloyResponse{Alloy: s.dbAlloy2PbAlloy(alloy, gp)}, nil
+}
+
+// alloyWithPrice returns the alloy and the latest gold price it is priced
+// off, nil when it is not mapped or the gold id has no price yet
+func (s *Service) alloyWithPrice(ctx context.Context, id int32) (db.Alloy, *db.GoldPrice, error) {
+	alloy, err := s.queries.GetAlloy(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return alloy, nil, status.Error(codes.NotFound, "alloy not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get alloy", zap.Error(err), zap.Int32("id", id))
+		return alloy, nil, status.Errorf(codes.Internal, "failed to get alloy: %v", err)
+	}
+	if !alloy.GoldID.Valid {
+		return alloy, nil, nil
+	}
+
+	gp, err := s.queries.GetLatestGoldPriceByGoldID(ctx, alloy.GoldID.Int32)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return alloy, nil, nil
+	}
+	if err != nil {
+		s.logger.Error("failed to get latest gold price", zap.Error(err), zap.Int32("gold_id", alloy.GoldID.Int32))
+		return alloy, nil, status.Errorf(codes.Internal, "failed to get gold price: %v", err)
+	}
+	return alloy, &gp, nil
+}
+
+// alloyPrice scales a price of the metal quoted by the gold price to the
+// purity of the alloy
+func alloyPrice(alloy db.Alloy, price float64) float64 {
+	reference := utils.NumericToFloat64(alloy.ReferencePurity)
+	if reference == 0 {
+		return 0
+	}
+	return price * utils.NumericToFloat64(alloy.Purity) / reference
+}
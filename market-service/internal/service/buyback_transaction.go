@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
+	"github.com/linhhuynhcoding/jss-microservices/market/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
+	product_api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+)
+
+// deduction is a condition deduction as stored in buybacks.deductions
+type deduction struct {
+	Reason  string  `json:"reason"`
+	Percent float64 `json:"percent,omitempty"`
+	Amount  float64 `json:"amount,omitempty"`
+}
+
+func (s *Service) QuoteBuyback(ctx context.Context, req *api.QuoteBuybackRequest) (*api.QuoteBuybackResponse, error) {
+	s.logger.Info("QuoteBuyback called", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	quote, err := s.quoteBuyback(ctx, req.Item)
+	if err != nil {
+		return nil, err
+	}
+	return &api.QuoteBuybackResponse{Quote: quote}, nil
+}
+
+func (s *Service) ExecuteBuyback(ctx context.Context, req *api.ExecuteBuybackRequest) (*api.ExecuteBuybackResponse, error) {
+	s.logger.Info("ExecuteBuyback called", zap.Any("req", req))
+
+	staffID, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	if req.Disposition != consts.BUYBACK_SCRAP && req.Disposition != consts.BUYBACK_RESALE {
+		return nil, status.Errorf(codes.InvalidArgument, "disposition must be %s or %s", consts.BUYBACK_SCRAP, consts.BUYBACK_RESALE)
+	}
+
+	// the item is valued again, the price may have moved since the quote
+	quote, err := s.quoteBuyback(ctx, req.Item)
+	if err != nil {
+		return nil, err
+	}
+	if req.QuotedAmount != 0 && math.Round(req.QuotedAmount) != quote.Amount {
+		return nil, status.Errorf(codes.FailedPrecondition, "the valuation changed to %.0f, quote again", quote.Amount)
+	}
+
+	customerID := quote.CustomerId
+	if phone := strings.TrimSpace(req.CustomerId); phone != "" {
+		resp, err := s.adapter.productClient.GetCustomer(ctx, &product_api.GetCustomerRequest{Phone: phone})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "customer not found")
+			}
+			return nil, status.Error(codes.Unavailable, "cannot get customer")
+		}
+		customerID = resp.GetCustomer().GetUuid()
+	}
+	if customerID == "" {
+		return nil, status.Error(codes.InvalidArgument, "customer_id is required")
+	}
+
+	deductions := make([]deduction, 0, len(quote.Deductions))
+	for _, d := range quote.Deductions {
+		deductions = append(deductions, deduction{Reason: d.Reason, Percent: d.Percent, Amount: d.Amount})
+	}
+	deductionsJSON, err := json.Marshal(deductions)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode deductions: %v", err)
+	}
+
+	var b db.Buyback
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		if quote.SerialNumber != "" {
+			previous, err := q.GetBuybackBySerial(ctx, pgtype.Text{String: quote.SerialNumber, Valid: true})
+			if err == nil {
+				return status.Errorf(codes.FailedPrecondition, "serial %s was already bought back with buyback %d", quote.SerialNumber, previous.ID)
+			}
+			if !errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.Internal, "failed to get buyback: %v", err)
+			}
+		}
+
+		b, err = q.CreateBuyback(ctx, db.CreateBuybackParams{
+			GoldID:          quote.GoldId,
+			GoldType:        quote.GoldType,
+			Weight:          utils.ToNumeric(quote.Weight),
+			BuyPrice:        utils.ToNumeric(quote.BuyPrice),
+			PolicyID:        pgtype.Int4{Int32: int32(quote.PolicyId), Valid: true},
+			BuybackRate:     utils.ToNumeric(quote.BuybackRate),
+			GoldValue:       utils.ToNumeric(quote.GoldValue),
+			Deductions:      deductionsJSON,
+			DeductionAmount: utils.ToNumeric(quote.DeductionAmount),
+			Amount:          utils.ToNumeric(quote.Amount),
+			Disposition:     req.Disposition,
+			OrderID:         pgtype.Int4{Int32: quote.OrderId, Valid: quote.OrderId != 0},
+			ProductID:       pgtype.Int4{Int32: quote.ProductId, Valid: quote.ProductId != 0},
+			SerialNumber:    pgtype.Text{String: quote.SerialNumber, Valid: quote.SerialNumber != ""},
+			WarrantyUntil:   utils.ToPgTimestamp(quote.WarrantyUntil),
+			CustomerID:      customerID,
+			StaffID:         staffID,
+			Note:            pgtype.Text{String: req.Note, Valid: req.Note != ""},
+			AlloyID:         pgtype.Int4{Int32: quote.AlloyId, Valid: quote.AlloyId != 0},
+		})
+		if isUniqueViolation(err) {
+			// bought back concurrently, buybacks_serial_number_idx
+			return status.Errorf(codes.FailedPrecondition, "serial %s was already bought back", quote.SerialNumber)
+		}
+		if err != nil {
+			s.logger.Error("failed to create buyback", zap.Error(err))
+			return status.Errorf(codes.Internal, "failed to create buyback: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Buyback created", zap.Any("buyback", b))
+
+	s.publishBuyback(b)
+
+	return &api.ExecuteBuybackResponse{
+		Buyback: &api.Buyback{
+			Id:          int64(b.ID),
+			Quote:       quote,
+			CustomerId:  b.CustomerID,
+			StaffId:     b.StaffID,
+			Disposition: b.Disposition,
+			Note:        b.Note.String,
+			CreatedAt:   utils.PgToPbTimestamp(b.CreatedAt),
+		},
+	}, nil
+}
+
+// quoteBuyback values an item at the latest buy price of its gold type, or
+// of its alloy: gold value * buyback rate - deductions
+func (s *Service) quoteBuyback(ctx context.Context, item *api.BuybackItem) (*api.BuybackQuote, error) {
+	if item == nil {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+	quote := &api.BuybackQuote{
+		GoldId:       item.GoldId,
+		Weight:       item.Weight,
+		OrderId:      item.OrderId,
+		SerialNumber: strings.TrimSpace(item.SerialNumber),
+		Deductions:   item.Deductions,
+		AlloyId:      item.AlloyId,
+	}
+	if quote.SerialNumber != "" {
+		if err := s.linkSale(ctx, quote); err != nil {
+			return nil, err
+		}
+	}
+
+	// an alloy is priced off the gold price it is mapped to
+	var alloy *db.Alloy
+	if quote.AlloyId != 0 {
+		a, err := s.queries.GetAlloy(ctx, quote.AlloyId)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "alloy not found")
+		}
+		if err != nil {
+			s.logger.Error("failed to get alloy", zap.Error(err), zap.Int32("id", quote.AlloyId))
+			return nil, status.Errorf(codes.Internal, "failed to get alloy: %v", err)
+		}
+		if !a.GoldID.Valid {
+			return nil, status.Errorf(codes.FailedPrecondition, "alloy %s is not mapped to a gold price", a.Code)
+		}
+		alloy = &a
+		quote.GoldId = a.GoldID.Int32
+		quote.Purity = utils.NumericToFloat64(a.Purity)
+	}
+	if quote.GoldId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gold_id or alloy_id is required")
+	}
+	if quote.Weight <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "weight must be positive")
+	}
+	for _, d := range quote.Deductions {
+		if strings.TrimSpace(d.Reason) == "" {
+			return nil, status.Error(codes.InvalidArgument, "deduction reason is required")
+		}
+		if d.Percent < 0 || d.Percent > 100 || d.Amount < 0 {
+			return nil, status.Error(codes.InvalidArgument, "deduction percent must be between 0 and 100 and amount positive")
+		}
+	}
+
+	price, err := s.queries.GetLatestGoldPriceByGoldID(ctx, quote.GoldId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.FailedPrecondition, "no gold price for gold id %d", quote.GoldId)
+	}
+	if err != nil {
+		s.logger.Error("failed to get latest gold price", zap.Error(err), zap.Int32("gold_id", quote.GoldId))
+		return nil, status.Errorf(codes.Internal, "failed to get gold price: %v", err)
+	}
+
+	policy, err := s.buybackPolicy(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	quote.GoldType = price.GoldType
+	quote.BuyPrice = utils.NumericToFloat64(price.BuyPrice)
+	if alloy != nil {
+		quote.BuyPrice = math.Round(alloyPrice(*alloy, quote.BuyPrice))
+	}
+	quote.PolicyId = int64(policy.ID)
+	quote.BuybackRate = utils.NumericToFloat64(policy.BuybackRate)
+	quote.GoldValue = math.Round(quote.BuyPrice * quote.Weight / consts.MACE_OF_GOLD_WEIGHT)
+	for _, d := range quote.Deductions {
+		quote.DeductionAmount += quote.GoldValue*d.Percent/100 + d.Amount
+	}
+	quote.DeductionAmount = math.Round(quote.DeductionAmount)
+	quote.Amount = math.Max(0, math.Round(quote.GoldValue*quote.BuybackRate/100-quote.DeductionAmount))
+	return quote, nil
+}
+
+// linkSale fills the quote from the sale of its serial number: order,
+// customer, warranty and, when not given, gold type, alloy and weight
+func (s *Service) linkSale(ctx context.Context, quote *api.BuybackQuote) error {
+	resp, err := s.adapter.productClient.GetSerialHistory(ctx, &product_api.GetSerialHistoryRequest{
+		SerialNumber: quote.SerialNumber,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.NotFound, "serial %s not found", quote.SerialNumber)
+		}
+		return status.Error(codes.Unavailable, "cannot get serial")
+	}
+	if resp.GetSerial().GetStatus() == consts.SERIAL_BOUGHT_BACK {
+		return status.Errorf(codes.FailedPrecondition, "serial %s was already bought back", quote.SerialNumber)
+	}
+	if resp.GetOrderId() == 0 {
+		return status.Errorf(codes.FailedPrecondition, "serial %s was not sold", quote.SerialNumber)
+	}
+	if quote.OrderId != 0 && quote.OrderId != resp.GetOrderId() {
+		return status.Errorf(codes.FailedPrecondition, "serial %s was sold with order %d", quote.SerialNumber, resp.GetOrderId())
+	}
+
+	product := resp.GetProduct()
+	quote.OrderId = resp.GetOrderId()
+	quote.ProductId = product.GetId()
+	quote.CustomerId = resp.GetCustomerId()
+	if quote.GoldId == 0 {
+		quote.GoldId = product.GetGoldType()
+	}
+	if quote.AlloyId == 0 {
+		quote.AlloyId = product.GetAlloyId()
+	}
+	if quote.Weight == 0 {
+		quote.Weight = resp.GetSerial().GetWeight()
+	}
+	if quote.Weight == 0 {
+		quote.Weight = product.GetWeight()
+	}
+
+	// the warranty runs from the sale of the piece
+	if product.GetWarrantyPeriod() <= 0 {
+		return nil
+	}
+	for _, e := range resp.GetEvents() {
+		if e.GetEventType() != consts.SERIAL_EVENT_SOLD {
+			continue
+		}
+		soldAt, err := time.Parse(time.RFC3339, e.GetCreatedAt())
+		if err != nil {
+			s.logger.Warn("invalid sale time", zap.String("serial", quote.SerialNumber), zap.Error(err))
+			break
+		}
+		until := soldAt.AddDate(0, int(product.GetWarrantyPeriod()), 0)
+		quote.WarrantyUntil = timestamppb.New(until)
+		quote.UnderWarranty = time.Now().Before(until)
+	}
+	return nil
+}
+
+// buybackPolicy returns the policy of the item, given by id or else the
+// latest one of its product type
+func (s *Service) buybackPolicy(ctx context.Context, item *api.BuybackItem) (db.BuybackPolicy, error) {
+	if item.PolicyId != 0 {
+		policy, err := s.queries.GetBuybackPolicy(ctx, int32(item.PolicyId))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return policy, status.Error(codes.NotFound, "buyback policy not found")
+		}
+		if err != nil {
+			s.logger.Error("failed to get buyback policy", zap.Error(err), zap.Int64("id", item.PolicyId))
+			return policy, status.Errorf(codes.Internal, "failed to get buyback policy: %v", err)
+		}
+		return policy, nil
+	}
+
+	if item.ProductType == "" {
+		return db.BuybackPolicy{}, status.Error(codes.InvalidArgument, "policy_id or product_type is required")
+	}
+	policies, err := s.queries.GetBuybackPoliciesByProductType(ctx, db.GetBuybackPoliciesByProductTypeParams{
+		ProductType: item.ProductType,
+		Limit:       1,
+	})
+	if err != nil {
+		s.logger.Error("failed to get buyback policies", zap.Error(err), zap.String("product_type", item.ProductType))
+		return db.BuybackPolicy{}, status.Errorf(codes.Internal, "failed to get buyback policy: %v", err)
+	}
+	if len(policies) == 0 {
+		return db.BuybackPolicy{}, status.Errorf(codes.FailedPrecondition, "no buyback policy for product type %s", item.ProductType)
+	}
+	return policies[0], nil
+}
+
+// publishBuyback sends market.buyback_executed for inventory to receive the
+// item. Events are best effort, a failure is only logged.
+func (s *Service) publishBuyback(b db.Buyback) {
+	if s.adapter.publisher == nil {
+		return
+	}
+	evt := &events.BuybackExecutedEvent{
+		BuybackId:    b.ID,
+		GoldId:       b.GoldID,
+		GoldType:     b.GoldType,
+		Weight:       utils.NumericToFloat64(b.Weight),
+		Amount:       utils.NumericToFloat64(b.Amount),
+		Disposition:  b.Disposition,
+		OrderId:      b.OrderID.Int32,
+		ProductId:    b.ProductID.Int32,
+		SerialNumber: b.SerialNumber.String,
+		CustomerId:   b.CustomerID,
+		StaffId:      b.StaffID,
+		CreatedAt:    utils.PgToPbTimestamp(b.CreatedAt),
+		AlloyId:      b.AlloyID.Int32,
+	}
+	if err := s.adapter.publisher.SendMessage(evt, mqconsts.TOPIC_BUYBACK_EXECUTED); err != nil {
+		s.logger.Error("failed to publish event", zap.String("topic", mqconsts.TOPIC_BUYBACK_EXECUTED), zap.Error(err))
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,7 +19,10 @@ import (
 func (s *Service) CreateGoldPrice(ctx context.Context, req *api.CreateGoldPriceRequest) (*api.CreateGoldPriceResponse, error) {
 	s.logger.Info("CreateGoldPrice called", zap.Any("req", req))
 
+	previous, ok := s.latestGoldPrice(ctx, req.GoldId)
+
 	arg := db.CreateGoldPriceParams{
+		GoldID:    req.GoldId,
 		GoldType:  req.GoldType,
 		BuyPrice:  utils.ToNumeric(float64(req.BuyPrice)),
 		SellPrice: utils.ToNumeric(float64(req.SellPrice)),
@@ -30,6 +36,14 @@ func (s *Service) CreateGoldPrice(ctx context.Context, req *api.CreateGoldPriceR
 	}
 
 	s.logger.Info("Gold price created successfully", zap.Any("gold_price", gp))
+	at := time.Now()
+	if gp.Date.Valid {
+		at = gp.Date.Time
+	}
+	s.recordTick(ctx, gp, at)
+	if ok {
+		s.notifier.Notify(previous, gp)
+	}
 	return &api.CreateGoldPriceResponse{
 		GoldPrice: s.dbGoldPrice2PbGoldPrice(&gp),
 	}, nil
@@ -77,11 +91,25 @@ func (s *Service) ListGoldPrices(ctx context.Context, req *api.ListGoldPricesReq
 func (s *Service) UpdateGoldPrice(ctx context.Context, req *api.UpdateGoldPriceRequest) (*api.UpdateGoldPriceResponse, error) {
 	s.logger.Info("UpdateGoldPrice called", zap.Any("req", req))
 
+	old, err := s.queries.GetGoldPrice(ctx, int32(req.Id))
+	if err != nil {
+		s.logger.Error("gold price not found", zap.Error(err), zap.Int64("id", req.Id))
+		return nil, status.Errorf(codes.NotFound, "gold price not found: %v", err)
+	}
+
 	arg := db.UpdateGoldPriceParams{
 		ID:        int32(req.Id),
+		Date:      old.Date,
+		GoldType:  old.GoldType,
 		BuyPrice:  utils.ToNumeric(float64(req.BuyPrice)),
 		SellPrice: utils.ToNumeric(float64(req.SellPrice)),
 	}
+	if req.Date != nil {
+		arg.Date = utils.ToPgTimestamp(req.Date)
+	}
+	if req.GoldType != "" {
+		arg.GoldType = req.GoldType
+	}
 
 	gp, err := s.queries.UpdateGoldPrice(ctx, arg)
 	if err != nil {
@@ -90,6 +118,11 @@ func (s *Service) UpdateGoldPrice(ctx context.Context, req *api.UpdateGoldPriceR
 	}
 
 	s.logger.Info("Gold price updated", zap.Any("gold_price", gp))
+	// a correction of an older row does not change the current price
+	if latest, ok := s.latestGoldPrice(ctx, gp.GoldID); ok && latest != nil && latest.ID == gp.ID {
+		s.recordTick(ctx, gp, time.Now())
+		s.notifier.Notify(&old, gp)
+	}
 	return &api.UpdateGoldPriceResponse{
 		GoldPrice: s.dbGoldPrice2PbGoldPrice(&gp),
 	}, nil
@@ -126,3 +159,17 @@ func (s *Service) GetLatestGoldPrice(ctx context.Context, req *api.GetLatestGold
 		GoldPrices: results,
 	}, nil
 }
+
+// latestGoldPrice returns the current price of a gold id, nil when it has no
+// price yet. ok is false when it could not be read.
+func (s *Service) latestGoldPrice(ctx context.Context, goldID int32) (*db.GoldPrice, bool) {
+	gp, err := s.queries.GetLatestGoldPriceByGoldID(ctx, goldID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, true
+	}
+	if err != nil {
+		s.logger.Error("failed to get latest gold price", zap.Error(err), zap.Int32("gold_id", goldID))
+		return nil, false
+	}
+	return &gp, true
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
+	"github.com/linhhuynhcoding/jss-microservices/market/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
+)
+
+func (s *Service) GetGoldPriceHistory(ctx context.Context, req *api.GetGoldPriceHistoryRequest) (*api.GetGoldPriceHistoryResponse, error) {
+	s.logger.Info("GetGoldPriceHistory called", zap.Any("req", req))
+
+	interval := req.Interval
+	if interval == "" {
+		interval = consts.INTERVAL_DAY
+	}
+	if !slices.Contains([]string{consts.INTERVAL_DAY, consts.INTERVAL_WEEK, consts.INTERVAL_MONTH}, interval) {
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be day, week or month")
+	}
+	maPeriod := int(req.MaPeriod)
+	if maPeriod == 0 {
+		maPeriod = consts.DEFAULT_MA_PERIOD
+	}
+	if maPeriod < 0 || maPeriod > consts.MAX_MA_PERIOD {
+		return nil, status.Errorf(codes.InvalidArgument, "ma_period must be between 1 and %d", consts.MAX_MA_PERIOD)
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := addCandles(to, interval, -consts.DEFAULT_HISTORY_CANDLES)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must be before to")
+	}
+
+	latest, err := s.queries.GetLatestGoldPriceByGoldID(ctx, req.GoldId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "gold price not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get latest gold price", zap.Error(err), zap.Int32("gold_id", req.GoldId))
+		return nil, status.Errorf(codes.Internal, "failed to get gold price history: %v", err)
+	}
+
+	// the candles before the range feed the first moving averages and changes
+	rows, err := s.queries.ListGoldPriceCandles(ctx, db.ListGoldPriceCandlesParams{
+		Unit:     interval,
+		GoldID:   req.GoldId,
+		FromTime: pgtype.Timestamp{Time: addCandles(from, interval, -maPeriod), Valid: true},
+		ToTime:   pgtype.Timestamp{Time: to, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to list gold price candles", zap.Error(err), zap.Int32("gold_id", req.GoldId))
+		return nil, status.Errorf(codes.Internal, "failed to get gold price history: %v", err)
+	}
+
+	candles := make([]*api.GoldPriceCandle, 0, len(rows))
+	buys := make([]*api.Ohlc, 0, len(rows))
+	sells := make([]*api.Ohlc, 0, len(rows))
+	for _, r := range rows {
+		c := &api.GoldPriceCandle{
+			Start: utils.PgToPbTimestamp(r.StartAt),
+			Buy:   toOhlc(r.BuyOpen, r.BuyHigh, r.BuyLow, r.BuyClose),
+			Sell:  toOhlc(r.SellOpen, r.SellHigh, r.SellLow, r.SellClose),
+			Ticks: int32(r.Ticks),
+		}
+		candles = append(candles, c)
+		buys = append(buys, c.Buy)
+		sells = append(sells, c.Sell)
+	}
+	fillIndicators(buys, maPeriod)
+	fillIndicators(sells, maPeriod)
+
+	// drop the candles ending before the range
+	candles = slices.DeleteFunc(candles, func(c *api.GoldPriceCandle) bool {
+		return !addCandles(c.Start.AsTime(), interval, 1).After(from)
+	})
+
+	resp := &api.GetGoldPriceHistoryResponse{
+		GoldId:   req.GoldId,
+		GoldType: latest.GoldType,
+		Interval: interval,
+		Candles:  candles,
+	}
+	if len(candles) > 0 {
+		first, last := candles[0], candles[len(candles)-1]
+		resp.BuyChangePercent = percentChange(first.Buy.Open, last.Buy.Close)
+		resp.SellChangePercent = percentChange(first.Sell.Open, last.Sell.Close)
+	}
+
+	s.logger.Info("Gold price history retrieved", zap.Int32("gold_id", req.GoldId), zap.Int("count", len(candles)))
+	return resp, nil
+}
+
+// recordTick appends a price entered through the API to the price history
+func (s *Service) recordTick(ctx context.Context, gp db.GoldPrice, at time.Time) {
+	_, err := s.queries.CreateGoldPriceTick(ctx, db.CreateGoldPriceTickParams{
+		GoldID:     gp.GoldID,
+		GoldType:   gp.GoldType,
+		BuyPrice:   gp.BuyPrice,
+		SellPrice:  gp.SellPrice,
+		Source:     consts.TICK_SOURCE_MANUAL,
+		RecordedAt: pgtype.Timestamp{Time: at, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to record gold price tick", zap.Error(err), zap.Int32("gold_id", gp.GoldID))
+	}
+}
+
+func toOhlc(open, high, low, close pgtype.Numeric) *api.Ohlc {
+	return &api.Ohlc{
+		Open:  utils.NumericToFloat64(open),
+		High:  utils.NumericToFloat64(high),
+		Low:   utils.NumericToFloat64(low),
+		Close: utils.NumericToFloat64(close),
+	}
+}
+
+// fillIndicators sets the moving average of the closes and the change since
+// the previous candle, series is oldest first
+func fillIndicators(series []*api.Ohlc, period int) {
+	var sum float64
+	for i, c := range series {
+		sum += c.Close
+		if i >= period {
+			sum -= series[i-period].Close
+		}
+		if i+1 >= period {
+			c.MovingAverage = sum / float64(period)
+		}
+		if i > 0 {
+			c.ChangePercent = percentChange(series[i-1].Close, c.Close)
+		}
+	}
+}
+
+func percentChange(from, to float64) float64 {
+	if from == 0 {
+		return 0
+	}
+	return (to - from) / from * 100
+}
+
+// addCandles moves t by n days, weeks or months
+func addCandles(t time.Time, interval string, n int) time.Time {
+	switch interval {
+	case consts.INTERVAL_WEEK:
+		return t.AddDate(0, 0, 7*n)
+	case consts.INTERVAL_MONTH:
+		return t.AddDate(0, n, 0)
+	}
+	return t.AddDate(0, 0, n)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"math"
+
 	utils "github.com/linhhuynhcoding/jss-microservices/jss-shared/utils/format"
 	db "github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
 	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
@@ -15,3 +17,22 @@ func (s *Service) dbGoldPrice2PbGoldPrice(dbGp *db.GoldPrice) *api.GoldPrice {
 		Date:      utils.PgToPbTimestamp(dbGp.Date),
 	}
 }
+
+func (s *Service) dbAlloy2PbAlloy(a db.Alloy, gp *db.GoldPrice) *api.Alloy {
+	alloy := &api.Alloy{
+		Id:              a.ID,
+		Code:            a.Code,
+		Name:            a.Name,
+		Metal:           a.Metal,
+		Karat:           a.Karat,
+		Purity:          utils.NumericToFloat64(a.Purity),
+		GoldId:          a.GoldID.Int32,
+		ReferencePurity: utils.NumericToFloat64(a.ReferencePurity),
+	}
+	if gp != nil {
+		alloy.BuyPrice = math.Round(alloyPrice(a, utils.NumericToFloat64(gp.BuyPrice)))
+		alloy.SellPrice = math.Round(alloyPrice(a, utils.NumericToFloat64(gp.SellPrice)))
+		alloy.PriceDate = utils.PgToPbTimestamp(gp.Date)
+	}
+	return alloy
+}
@@ -4,24 +4,43 @@ import (
 	"context"
 
 	"github.com/linhhuynhcoding/jss-microservices/market/config"
+	auth_service "github.com/linhhuynhcoding/jss-microservices/market/internal/adapter/auth-service"
+	product_service "github.com/linhhuynhcoding/jss-microservices/market/internal/adapter/product-service"
+	"github.com/linhhuynhcoding/jss-microservices/market/internal/notifier"
 	"github.com/linhhuynhcoding/jss-microservices/market/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
 	"github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
 	"go.uber.org/zap"
 )
 
+type Adapter struct {
+	authClient    auth_service.IAuthServiceClient
+	productClient product_service.IProductServiceClient
+	publisher     *mq.Publisher
+}
+
 type Service struct {
 	market.UnimplementedMarketServer
 
-	logger  *zap.Logger
-	cfg     config.Config
-	queries repository.Store
+	logger   *zap.Logger
+	cfg      config.Config
+	queries  repository.Store
+	notifier *notifier.PriceNotifier
+
+	adapter *Adapter
 }
 
-func NewService(ctx context.Context, logger *zap.Logger, cfg config.Config, store repository.Store) *Service {
+func NewService(ctx context.Context, logger *zap.Logger, cfg config.Config, store repository.Store, priceNotifier *notifier.PriceNotifier, publisher *mq.Publisher) *Service {
 
 	return &Service{
-		logger:  logger,
-		cfg:     cfg,
-		queries: store,
+		logger:   logger,
+		cfg:      cfg,
+		queries:  store,
+		notifier: priceNotifier,
+		adapter: &Adapter{
+			authClient:    auth_service.NewAuthServiceClient(logger, cfg),
+			productClient: product_service.NewProductServiceClient(logger, cfg),
+			publisher:     publisher,
+		},
 	}
 }
@@ -10,4 +10,5 @@ type RabbitMQConfig struct {
 	ExchangeName string
 
 	SubscribeKeys []string // just for subscriber. Usage example: ["product.create_product, product.*"]
+	QueueName     string   // just for subscriber. Durable queue shared by the instances, empty for an exclusive queue
 }
@@ -8,11 +8,28 @@ var (
 	// PRODUCT_CUSTOMER_SERVICE
 	TOPIC_CUSTOMER_BROADCAST string = "customer.*"
 	TOPIC_CREATE_CUSTOMER    string = "customer.create_customer"
+	TOPIC_UPDATE_CUSTOMER    string = "customer.update_customer"
+	TOPIC_DELETE_CUSTOMER    string = "customer.delete_customer"
+	TOPIC_MERGE_CUSTOMER     string = "customer.merge_customer"
+	TOPIC_REKEY_CUSTOMER     string = "customer.rekey_customer"
+
+	// privacy requests are answered by every service holding customer data
+	TOPIC_CUSTOMER_PRIVACY_REQUEST string = "customer.privacy_request"
+	TOPIC_CUSTOMER_PRIVACY_ACK     string = "customer.privacy_ack"
+
+	TOPIC_SEGMENT_UPDATED string = "customer.segment_updated"
 
 	TOPIC_PRODUCT_BROADCAST string = "product.*"
 	TOPIC_CREATE_PRODUCT    string = "product.create_product"
+	TOPIC_UPDATE_PRODUCT    string = "product.update_product"
+	TOPIC_DELETE_PRODUCT    string = "product.delete_product"
+	TOPIC_UPDATE_STOCK      string = "product.update_stock"
+	TOPIC_PRODUCT_LOW_STOCK string = "product.low_stock"
 
 	TOPIC_CREATE_ORDER string = "order.create_order"
+
+	TOPIC_GOLD_PRICE_CHANGED string = "market.gold_price_changed"
+	TOPIC_BUYBACK_EXECUTED   string = "market.buyback_executed"
 )
 
 // DIRECT ROUTING KEYS
@@ -0,0 +1,16 @@
+package consts
+
+// DURABLE QUEUES, shared by the instances of a service and kept while it is
+// down. They are also declared in definitions.json so nothing is lost before
+// the first start of the consumer.
+var (
+	QUEUE_PRIVACY_REQUEST_ORDER        string = "order-service.customer.privacy_request"
+	QUEUE_PRIVACY_REQUEST_LOYALTY      string = "loyalty-service.customer.privacy_request"
+	QUEUE_PRIVACY_REQUEST_NOTIFICATION string = "notification-service.customer.privacy_request"
+	QUEUE_PRIVACY_ACK                  string = "product-customer-service.customer.privacy_ack"
+	QUEUE_BUYBACK_EXECUTED_PRODUCT     string = "product-customer-service.market.buyback_executed"
+	QUEUE_CUSTOMER_MERGED_LOYALTY      string = "loyalty-service.customer.merge_customer"
+	QUEUE_SEGMENT_UPDATED_LOYALTY      string = "loyalty-service.customer.segment_updated"
+	QUEUE_CUSTOMER_REKEYED_ORDER       string = "order-service.customer.rekey_customer"
+	QUEUE_CUSTOMER_REKEYED_LOYALTY     string = "loyalty-service.customer.rekey_customer"
+)
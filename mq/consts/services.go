@@ -0,0 +1,10 @@
+package consts
+
+// SERVICE NAMES, used to acknowledge cross-service requests
+var (
+	SERVICE_PRODUCT_CUSTOMER string = "product-customer-service"
+	SERVICE_ORDER            string = "order-service"
+	SERVICE_LOYALTY          string = "loyalty-service"
+	SERVICE_NOTIFICATION     string = "notification-service"
+	SERVICE_MARKET           string = "market-service"
+)
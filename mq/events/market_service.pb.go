@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v3.21.12
+// source: market_service.proto
+
+package events
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GoldPriceChangedEvent is published on market.gold_price_changed when the
+// price of a gold type moves by at least the configured threshold since the
+// last event
+type GoldPriceChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoldId        int32                  `protobuf:"varint,1,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	GoldType      string                 `protobuf:"bytes,2,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	OldBuyPrice   float64                `protobuf:"fixed64,3,opt,name=old_buy_price,json=oldBuyPrice,proto3" json:"old_buy_price,omitempty"`
+	NewBuyPrice   float64                `protobuf:"fixed64,4,opt,name=new_buy_price,json=newBuyPrice,proto3" json:"new_buy_price,omitempty"`
+	OldSellPrice  float64                `protobuf:"fixed64,5,opt,name=old_sell_price,json=oldSellPrice,proto3" json:"old_sell_price,omitempty"`
+	NewSellPrice  float64                `protobuf:"fixed64,6,opt,name=new_sell_price,json=newSellPrice,proto3" json:"new_sell_price,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoldPriceChangedEvent) Reset() {
+	*x = GoldPriceChangedEvent{}
+	mi := &file_market_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoldPriceChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoldPriceChangedEvent) ProtoMessage() {}
+
+func (x *GoldPriceChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_market_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoldPriceChangedEvent.ProtoReflect.Descriptor instead.
+func (*GoldPriceChangedEvent) Descriptor() ([]byte, []int) {
+	return file_market_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GoldPriceChangedEvent) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *GoldPriceChangedEvent) GetGoldType() string {
+	if x != nil {
+		return x.GoldType
+	}
+	return ""
+}
+
+func (x *GoldPriceChangedEvent) GetOldBuyPrice() float64 {
+	if x != nil {
+		return x.OldBuyPrice
+	}
+	return 0
+}
+
+func (x *GoldPriceChangedEvent) GetNewBuyPrice() float64 {
+	if x != nil {
+		return x.NewBuyPrice
+	}
+	return 0
+}
+
+func (x *GoldPriceChangedEvent) GetOldSellPrice() float64 {
+	if x != nil {
+		return x.OldSellPrice
+	}
+	return 0
+}
+
+func (x *GoldPriceChangedEvent) GetNewSellPrice() float64 {
+	if x != nil {
+		return x.NewSellPrice
+	}
+	return 0
+}
+
+func (x *GoldPriceChangedEvent) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// BuybackExecutedEvent is published on market.buyback_executed when an item
+// is bought back, for inventory to receive it as scrap or resale stock
+type BuybackExecutedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuybackId     int32                  `protobuf:"varint,1,opt,name=buyback_id,json=buybackId,proto3" json:"buyback_id,omitempty"`
+	GoldId        int32                  `protobuf:"varint,2,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	GoldType      string                 `protobuf:"bytes,3,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"` // grams
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Disposition   string                 `protobuf:"bytes,6,opt,name=disposition,proto3" json:"disposition,omitempty"` // scrap, resale
+	OrderId       int32                  `protobuf:"varint,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,9,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,10,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // uuid
+	StaffId       string                 `protobuf:"bytes,11,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AlloyId       int32                  `protobuf:"varint,13,opt,name=alloy_id,json=alloyId,proto3" json:"alloy_id,omitempty"` // 0 when valued off gold_id alone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuybackExecutedEvent) Reset() {
+	*x = BuybackExecutedEvent{}
+	mi := &file_market_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuybackExecutedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuybackExecutedEvent) ProtoMessage() {}
+
+func (x *BuybackExecutedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_market_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuybackExecutedEvent.ProtoReflect.Descriptor instead.
+func (*BuybackExecutedEvent) Descriptor() ([]byte, []int) {
+	return file_market_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuybackExecutedEvent) GetBuybackId() int32 {
+	if x != nil {
+		return x.BuybackId
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetGoldType() string {
+	if x != nil {
+		return x.GoldType
+	}
+	return ""
+}
+
+func (x *BuybackExecutedEvent) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *BuybackExecutedEvent) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *BuybackExecutedEvent) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *BuybackExecutedEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *BuybackExecutedEvent) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *BuybackExecutedEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BuybackExecutedEvent) GetAlloyId() int32 {
+	if x != nil {
+		return x.AlloyId
+	}
+	return 0
+}
+
+var File_market_service_proto protoreflect.FileDescriptor
+
+const file_market_service_proto_rawDesc = "" +
+	"\n" +
+	"\x14market_service.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x02\n" +
+	"\x15GoldPriceChangedEvent\x12\x17\n" +
+	"\agold_id\x18\x01 \x01(\x05R\x06goldId\x12\x1b\n" +
+	"\tgold_type\x18\x02 \x01(\tR\bgoldType\x12\"\n" +
+	"\rold_buy_price\x18\x03 \x01(\x01R\voldBuyPrice\x12\"\n" +
+	"\rnew_buy_price\x18\x04 \x01(\x01R\vnewBuyPrice\x12$\n" +
+	"\x0eold_sell_price\x18\x05 \x01(\x01R\foldSellPrice\x12$\n" +
+	"\x0enew_sell_price\x18\x06 \x01(\x01R\fnewSellPrice\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xae\x03\n" +
+	"\x14BuybackExecutedEvent\x12\x1d\n" +
+	"\n" +
+	"buyback_id\x18\x01 \x01(\x05R\tbuybackId\x12\x17\n" +
+	"\agold_id\x18\x02 \x01(\x05R\x06goldId\x12\x1b\n" +
+	"\tgold_type\x18\x03 \x01(\tR\bgoldType\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12 \n" +
+	"\vdisposition\x18\x06 \x01(\tR\vdisposition\x12\x19\n" +
+	"\border_id\x18\a \x01(\x05R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\b \x01(\x05R\tproductId\x12#\n" +
+	"\rserial_number\x18\t \x01(\tR\fserialNumber\x12\x1f\n" +
+	"\vcustomer_id\x18\n" +
+	" \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\bstaff_id\x18\v \x01(\tR\astaffId\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x19\n" +
+	"\balloy_id\x18\r \x01(\x05R\aalloyIdB<Z:github.com/linhhuynhcoding/jss-microservices/mq/gen/eventsb\x06proto3"
+
+var (
+	file_market_service_proto_rawDescOnce sync.Once
+	file_market_service_proto_rawDescData []byte
+)
+
+func file_market_service_proto_rawDescGZIP() []byte {
+	file_market_service_proto_rawDescOnce.Do(func() {
+		file_market_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_market_service_proto_rawDesc), len(file_market_service_proto_rawDesc)))
+	})
+	return file_market_service_proto_rawDescData
+}
+
+var file_market_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_market_service_proto_goTypes = []any{
+	(*GoldPriceChangedEvent)(nil), // 0: pb.GoldPriceChangedEvent
+	(*BuybackExecutedEvent)(nil),  // 1: pb.BuybackExecutedEvent
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_market_service_proto_depIdxs = []int32{
+	2, // 0: pb.GoldPriceChangedEvent.changed_at:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.BuybackExecutedEvent.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_market_service_proto_init() }
+func file_market_service_proto_init() {
+	if File_market_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_service_proto_rawDesc), len(file_market_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_market_service_proto_goTypes,
+		DependencyIndexes: file_market_service_proto_depIdxs,
+		MessageInfos:      file_market_service_proto_msgTypes,
+	}.Build()
+	File_market_service_proto = out.File
+	file_market_service_proto_goTypes = nil
+	file_market_service_proto_depIdxs = nil
+}
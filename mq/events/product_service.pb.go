@@ -9,6 +9,7 @@ package events
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,28 +22,745 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type ProductEvent struct {
+// ProductLowStockEvent is published when the stock of a product drops to or
+// below its reorder point
+type ProductLowStockEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Stock           int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	ReorderPoint    int32                  `protobuf:"varint,5,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32                  `protobuf:"varint,6,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"` // suggested quantity to order
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductLowStockEvent) Reset() {
+	*x = ProductLowStockEvent{}
+	mi := &file_product_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductLowStockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductLowStockEvent) ProtoMessage() {}
+
+func (x *ProductLowStockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductLowStockEvent.ProtoReflect.Descriptor instead.
+func (*ProductLowStockEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductLowStockEvent) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductLowStockEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductLowStockEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductLowStockEvent) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductLowStockEvent) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *ProductLowStockEvent) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+// Product is the full read model of a product, prices in VND
+type Product struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId      int32                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ParentId        int32                  `protobuf:"varint,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // set on size variants
+	Size            string                 `protobuf:"bytes,6,opt,name=size,proto3" json:"size,omitempty"`
+	GoldType        int32                  `protobuf:"varint,7,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Weight          float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"` // gram
+	GoldPriceAtTime float64                `protobuf:"fixed64,9,opt,name=gold_price_at_time,json=goldPriceAtTime,proto3" json:"gold_price_at_time,omitempty"`
+	LaborCost       float64                `protobuf:"fixed64,10,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"`
+	StoneCost       float64                `protobuf:"fixed64,11,opt,name=stone_cost,json=stoneCost,proto3" json:"stone_cost,omitempty"`
+	MarkupRate      float64                `protobuf:"fixed64,12,opt,name=markup_rate,json=markupRate,proto3" json:"markup_rate,omitempty"`
+	SellingPrice    float64                `protobuf:"fixed64,13,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"`
+	WarrantyPeriod  int32                  `protobuf:"varint,14,opt,name=warranty_period,json=warrantyPeriod,proto3" json:"warranty_period,omitempty"` // months
+	Image           string                 `protobuf:"bytes,15,opt,name=image,proto3" json:"image,omitempty"`
+	Stock           int32                  `protobuf:"varint,16,opt,name=stock,proto3" json:"stock,omitempty"`
+	BuyTurn         int32                  `protobuf:"varint,17,opt,name=buy_turn,json=buyTurn,proto3" json:"buy_turn,omitempty"`
+	Status          string                 `protobuf:"bytes,18,opt,name=status,proto3" json:"status,omitempty"` // active, discontinued, archived
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_product_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Product) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Product) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Product) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Product) GetGoldType() int32 {
+	if x != nil {
+		return x.GoldType
+	}
+	return 0
+}
+
+func (x *Product) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Product) GetGoldPriceAtTime() float64 {
+	if x != nil {
+		return x.GoldPriceAtTime
+	}
+	return 0
+}
+
+func (x *Product) GetLaborCost() float64 {
+	if x != nil {
+		return x.LaborCost
+	}
+	return 0
+}
+
+func (x *Product) GetStoneCost() float64 {
+	if x != nil {
+		return x.StoneCost
+	}
+	return 0
+}
+
+func (x *Product) GetMarkupRate() float64 {
+	if x != nil {
+		return x.MarkupRate
+	}
+	return 0
+}
+
+func (x *Product) GetSellingPrice() float64 {
+	if x != nil {
+		return x.SellingPrice
+	}
+	return 0
+}
+
+func (x *Product) GetWarrantyPeriod() int32 {
+	if x != nil {
+		return x.WarrantyPeriod
+	}
+	return 0
+}
+
+func (x *Product) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Product) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Product) GetBuyTurn() int32 {
+	if x != nil {
+		return x.BuyTurn
+	}
+	return 0
+}
+
+func (x *Product) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ProductChangedEvent is published on product.create_product and
+// product.update_product with the product after the change
+type ProductChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChangedEvent) Reset() {
+	*x = ProductChangedEvent{}
+	mi := &file_product_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChangedEvent) ProtoMessage() {}
+
+func (x *ProductChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChangedEvent.ProtoReflect.Descriptor instead.
+func (*ProductChangedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductChangedEvent) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// ProductDeletedEvent is published on product.delete_product, an archived
+// product is kept in the database and can be restored
+type ProductDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Hard          bool                   `protobuf:"varint,3,opt,name=hard,proto3" json:"hard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductDeletedEvent) Reset() {
+	*x = ProductDeletedEvent{}
+	mi := &file_product_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDeletedEvent) ProtoMessage() {}
+
+func (x *ProductDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDeletedEvent.ProtoReflect.Descriptor instead.
+func (*ProductDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductDeletedEvent) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductDeletedEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductDeletedEvent) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+// ProductStockChangedEvent is published on product.update_stock for every
+// movement posted to the stock ledger. The opening stock of a new product is
+// carried by product.create_product.
+type ProductStockChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // signed: positive = in, negative = out
+	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`                                  // stock after the movement
+	MovementType  string                 `protobuf:"bytes,5,opt,name=movement_type,json=movementType,proto3" json:"movement_type,omitempty"` // sale, adjustment, stocktake, purchase
+	ReferenceId   int32                  `protobuf:"varint,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`   // order, stocktake session or goods receipt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStockChangedEvent) Reset() {
+	*x = ProductStockChangedEvent{}
+	mi := &file_product_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStockChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStockChangedEvent) ProtoMessage() {}
+
+func (x *ProductStockChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStockChangedEvent.ProtoReflect.Descriptor instead.
+func (*ProductStockChangedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProductStockChangedEvent) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductStockChangedEvent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductStockChangedEvent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductStockChangedEvent) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductStockChangedEvent) GetMovementType() string {
+	if x != nil {
+		return x.MovementType
+	}
+	return ""
+}
+
+func (x *ProductStockChangedEvent) GetReferenceId() int32 {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return 0
+}
+
+type Customer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone             string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email             string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Address           string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Birthday          string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`       // YYYY-MM-DD
+	Anniversary       string                 `protobuf:"bytes,9,opt,name=anniversary,proto3" json:"anniversary,omitempty"` // YYYY-MM-DD
+	RingSize          string                 `protobuf:"bytes,10,opt,name=ring_size,json=ringSize,proto3" json:"ring_size,omitempty"`
+	PreferredGoldType int32                  `protobuf:"varint,11,opt,name=preferred_gold_type,json=preferredGoldType,proto3" json:"preferred_gold_type,omitempty"`
+	Tags              []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Uuid              string                 `protobuf:"bytes,13,opt,name=uuid,proto3" json:"uuid,omitempty"` // customer id in order-service and loyalty-service
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_product_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Customer) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Customer) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Customer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Customer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Customer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Customer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Customer) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *Customer) GetAnniversary() string {
+	if x != nil {
+		return x.Anniversary
+	}
+	return ""
+}
+
+func (x *Customer) GetRingSize() string {
+	if x != nil {
+		return x.RingSize
+	}
+	return ""
+}
+
+func (x *Customer) GetPreferredGoldType() int32 {
+	if x != nil {
+		return x.PreferredGoldType
+	}
+	return 0
+}
+
+func (x *Customer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Customer) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// CustomerChangedEvent is published on customer.create_customer and
+// customer.update_customer
+type CustomerChangedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerChangedEvent) Reset() {
+	*x = CustomerChangedEvent{}
+	mi := &file_product_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerChangedEvent) ProtoMessage() {}
+
+func (x *CustomerChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerChangedEvent.ProtoReflect.Descriptor instead.
+func (*CustomerChangedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CustomerChangedEvent) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// CustomerDeletedEvent is published on customer.delete_customer
+type CustomerDeletedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerDeletedEvent) Reset() {
+	*x = CustomerDeletedEvent{}
+	mi := &file_product_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerDeletedEvent) ProtoMessage() {}
+
+func (x *CustomerDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerDeletedEvent.ProtoReflect.Descriptor instead.
+func (*CustomerDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CustomerDeletedEvent) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+// CustomersMergedEvent is published on customer.merge_customer when a
+// duplicate customer is merged into the survivor. Other services keyed by
+// uuid re-point their records from merged_uuid to survivor_uuid.
+type CustomersMergedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SurvivorId    int32                  `protobuf:"varint,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	SurvivorPhone string                 `protobuf:"bytes,2,opt,name=survivor_phone,json=survivorPhone,proto3" json:"survivor_phone,omitempty"`
+	MergedId      int32                  `protobuf:"varint,3,opt,name=merged_id,json=mergedId,proto3" json:"merged_id,omitempty"`
+	MergedPhone   string                 `protobuf:"bytes,4,opt,name=merged_phone,json=mergedPhone,proto3" json:"merged_phone,omitempty"`
+	SurvivorUuid  string                 `protobuf:"bytes,5,opt,name=survivor_uuid,json=survivorUuid,proto3" json:"survivor_uuid,omitempty"`
+	MergedUuid    string                 `protobuf:"bytes,6,opt,name=merged_uuid,json=mergedUuid,proto3" json:"merged_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductEvent) Reset() {
-	*x = ProductEvent{}
-	mi := &file_product_service_proto_msgTypes[0]
+func (x *CustomersMergedEvent) Reset() {
+	*x = CustomersMergedEvent{}
+	mi := &file_product_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductEvent) String() string {
+func (x *CustomersMergedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductEvent) ProtoMessage() {}
+func (*CustomersMergedEvent) ProtoMessage() {}
 
-func (x *ProductEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_product_service_proto_msgTypes[0]
+func (x *CustomersMergedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -53,26 +771,502 @@ func (x *ProductEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductEvent.ProtoReflect.Descriptor instead.
-func (*ProductEvent) Descriptor() ([]byte, []int) {
-	return file_product_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CustomersMergedEvent.ProtoReflect.Descriptor instead.
+func (*CustomersMergedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProductEvent) GetProductId() string {
+func (x *CustomersMergedEvent) GetSurvivorId() int32 {
 	if x != nil {
-		return x.ProductId
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *CustomersMergedEvent) GetSurvivorPhone() string {
+	if x != nil {
+		return x.SurvivorPhone
+	}
+	return ""
+}
+
+func (x *CustomersMergedEvent) GetMergedId() int32 {
+	if x != nil {
+		return x.MergedId
+	}
+	return 0
+}
+
+func (x *CustomersMergedEvent) GetMergedPhone() string {
+	if x != nil {
+		return x.MergedPhone
+	}
+	return ""
+}
+
+func (x *CustomersMergedEvent) GetSurvivorUuid() string {
+	if x != nil {
+		return x.SurvivorUuid
+	}
+	return ""
+}
+
+func (x *CustomersMergedEvent) GetMergedUuid() string {
+	if x != nil {
+		return x.MergedUuid
+	}
+	return ""
+}
+
+// CustomerRekeyedEvent is published on customer.rekey_customer when records
+// may still be keyed by an older identifier of the customer, e.g. the phone
+// before customers were identified by uuid, or after a phone change.
+// Services re-point the records keyed by previous_ids to uuid.
+type CustomerRekeyedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PreviousIds   []string               `protobuf:"bytes,3,rep,name=previous_ids,json=previousIds,proto3" json:"previous_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerRekeyedEvent) Reset() {
+	*x = CustomerRekeyedEvent{}
+	mi := &file_product_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerRekeyedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerRekeyedEvent) ProtoMessage() {}
+
+func (x *CustomerRekeyedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerRekeyedEvent.ProtoReflect.Descriptor instead.
+func (*CustomerRekeyedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CustomerRekeyedEvent) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerRekeyedEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CustomerRekeyedEvent) GetPreviousIds() []string {
+	if x != nil {
+		return x.PreviousIds
+	}
+	return nil
+}
+
+// CustomerPrivacyRequestEvent is published on customer.privacy_request.
+// Every service holding data about the customer answers with a
+// CustomerPrivacyAckEvent on customer.privacy_ack.
+type CustomerPrivacyRequestEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int32                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // export, erase
+	CustomerId    int32                  `protobuf:"varint,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"` // customer id of the records not re-keyed yet
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Pseudonym     string                 `protobuf:"bytes,7,opt,name=pseudonym,proto3" json:"pseudonym,omitempty"` // unused, erased records stay keyed by uuid
+	Uuid          string                 `protobuf:"bytes,8,opt,name=uuid,proto3" json:"uuid,omitempty"`           // customer id in order-service and loyalty-service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerPrivacyRequestEvent) Reset() {
+	*x = CustomerPrivacyRequestEvent{}
+	mi := &file_product_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerPrivacyRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerPrivacyRequestEvent) ProtoMessage() {}
+
+func (x *CustomerPrivacyRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerPrivacyRequestEvent.ProtoReflect.Descriptor instead.
+func (*CustomerPrivacyRequestEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CustomerPrivacyRequestEvent) GetRequestId() int32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *CustomerPrivacyRequestEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyRequestEvent) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerPrivacyRequestEvent) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyRequestEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyRequestEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyRequestEvent) GetPseudonym() string {
+	if x != nil {
+		return x.Pseudonym
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyRequestEvent) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type CustomerPrivacyAckEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int32                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`        // export: JSON document of the data held by the service
+	Records       int32                  `protobuf:"varint,6,opt,name=records,proto3" json:"records,omitempty"` // number of records exported or anonymized
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerPrivacyAckEvent) Reset() {
+	*x = CustomerPrivacyAckEvent{}
+	mi := &file_product_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerPrivacyAckEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerPrivacyAckEvent) ProtoMessage() {}
+
+func (x *CustomerPrivacyAckEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerPrivacyAckEvent.ProtoReflect.Descriptor instead.
+func (*CustomerPrivacyAckEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CustomerPrivacyAckEvent) GetRequestId() int32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *CustomerPrivacyAckEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyAckEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CustomerPrivacyAckEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CustomerPrivacyAckEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CustomerPrivacyAckEvent) GetRecords() int32 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+// CustomerSegmentUpdatedEvent is published on customer.segment_updated when
+// the members of a segment change. loyalty-service grants voucher_id, when
+// set, to the added customers.
+type CustomerSegmentUpdatedEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId        int32                  `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	VoucherId        int32                  `protobuf:"varint,3,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	AddedCustomers   []string               `protobuf:"bytes,4,rep,name=added_customers,json=addedCustomers,proto3" json:"added_customers,omitempty"`       // customer uuids
+	RemovedCustomers []string               `protobuf:"bytes,5,rep,name=removed_customers,json=removedCustomers,proto3" json:"removed_customers,omitempty"` // customer uuids
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CustomerSegmentUpdatedEvent) Reset() {
+	*x = CustomerSegmentUpdatedEvent{}
+	mi := &file_product_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSegmentUpdatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSegmentUpdatedEvent) ProtoMessage() {}
+
+func (x *CustomerSegmentUpdatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSegmentUpdatedEvent.ProtoReflect.Descriptor instead.
+func (*CustomerSegmentUpdatedEvent) Descriptor() ([]byte, []int) {
+	return file_product_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CustomerSegmentUpdatedEvent) GetSegmentId() int32 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *CustomerSegmentUpdatedEvent) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
+func (x *CustomerSegmentUpdatedEvent) GetVoucherId() int32 {
+	if x != nil {
+		return x.VoucherId
+	}
+	return 0
+}
+
+func (x *CustomerSegmentUpdatedEvent) GetAddedCustomers() []string {
+	if x != nil {
+		return x.AddedCustomers
+	}
+	return nil
+}
+
+func (x *CustomerSegmentUpdatedEvent) GetRemovedCustomers() []string {
+	if x != nil {
+		return x.RemovedCustomers
+	}
+	return nil
+}
+
 var File_product_service_proto protoreflect.FileDescriptor
 
 const file_product_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15product_service.proto\x12\x02pb\"-\n" +
-	"\fProductEvent\x12\x1d\n" +
+	"\x15product_service.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
+	"\x14ProductLowStockEvent\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12#\n" +
+	"\rreorder_point\x18\x05 \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\x06 \x01(\x05R\x0freorderQuantity\"\xf7\x04\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x05R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tparent_id\x18\x05 \x01(\x05R\bparentId\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\tR\x04size\x12\x1b\n" +
+	"\tgold_type\x18\a \x01(\x05R\bgoldType\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x01R\x06weight\x12+\n" +
+	"\x12gold_price_at_time\x18\t \x01(\x01R\x0fgoldPriceAtTime\x12\x1d\n" +
+	"\n" +
+	"labor_cost\x18\n" +
+	" \x01(\x01R\tlaborCost\x12\x1d\n" +
+	"\n" +
+	"stone_cost\x18\v \x01(\x01R\tstoneCost\x12\x1f\n" +
+	"\vmarkup_rate\x18\f \x01(\x01R\n" +
+	"markupRate\x12#\n" +
+	"\rselling_price\x18\r \x01(\x01R\fsellingPrice\x12'\n" +
+	"\x0fwarranty_period\x18\x0e \x01(\x05R\x0ewarrantyPeriod\x12\x14\n" +
+	"\x05image\x18\x0f \x01(\tR\x05image\x12\x14\n" +
+	"\x05stock\x18\x10 \x01(\x05R\x05stock\x12\x19\n" +
+	"\bbuy_turn\x18\x11 \x01(\x05R\abuyTurn\x12\x16\n" +
+	"\x06status\x18\x12 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"<\n" +
+	"\x13ProductChangedEvent\x12%\n" +
+	"\aproduct\x18\x01 \x01(\v2\v.pb.ProductR\aproduct\"\\\n" +
+	"\x13ProductDeletedEvent\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04hard\x18\x03 \x01(\bR\x04hard\"\xc7\x01\n" +
+	"\x18ProductStockChangedEvent\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12#\n" +
+	"\rmovement_type\x18\x05 \x01(\tR\fmovementType\x12!\n" +
+	"\freference_id\x18\x06 \x01(\x05R\vreferenceId\"\x9d\x03\n" +
+	"\bCustomer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bbirthday\x18\b \x01(\tR\bbirthday\x12 \n" +
+	"\vanniversary\x18\t \x01(\tR\vanniversary\x12\x1b\n" +
+	"\tring_size\x18\n" +
+	" \x01(\tR\bringSize\x12.\n" +
+	"\x13preferred_gold_type\x18\v \x01(\x05R\x11preferredGoldType\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x12\n" +
+	"\x04uuid\x18\r \x01(\tR\x04uuid\"@\n" +
+	"\x14CustomerChangedEvent\x12(\n" +
+	"\bcustomer\x18\x01 \x01(\v2\f.pb.CustomerR\bcustomer\"7\n" +
+	"\x14CustomerDeletedEvent\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x05R\n" +
+	"customerId\"\xe4\x01\n" +
+	"\x14CustomersMergedEvent\x12\x1f\n" +
+	"\vsurvivor_id\x18\x01 \x01(\x05R\n" +
+	"survivorId\x12%\n" +
+	"\x0esurvivor_phone\x18\x02 \x01(\tR\rsurvivorPhone\x12\x1b\n" +
+	"\tmerged_id\x18\x03 \x01(\x05R\bmergedId\x12!\n" +
+	"\fmerged_phone\x18\x04 \x01(\tR\vmergedPhone\x12#\n" +
+	"\rsurvivor_uuid\x18\x05 \x01(\tR\fsurvivorUuid\x12\x1f\n" +
+	"\vmerged_uuid\x18\x06 \x01(\tR\n" +
+	"mergedUuid\"n\n" +
+	"\x14CustomerRekeyedEvent\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x05R\n" +
+	"customerId\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12!\n" +
+	"\fprevious_ids\x18\x03 \x03(\tR\vpreviousIds\"\xe3\x01\n" +
+	"\x1bCustomerPrivacyRequestEvent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\x05R\n" +
+	"customerId\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x1c\n" +
+	"\tpseudonym\x18\a \x01(\tR\tpseudonym\x12\x12\n" +
+	"\x04uuid\x18\b \x01(\tR\x04uuid\"\xb0\x01\n" +
+	"\x17CustomerPrivacyAckEvent\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x18\n" +
+	"\arecords\x18\x06 \x01(\x05R\arecords\"\xc5\x01\n" +
+	"\x1bCustomerSegmentUpdatedEvent\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\x05R\tsegmentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductIdB<Z:github.com/linhhuynhcoding/jss-microservices/mq/gen/eventsb\x06proto3"
+	"voucher_id\x18\x03 \x01(\x05R\tvoucherId\x12'\n" +
+	"\x0fadded_customers\x18\x04 \x03(\tR\x0eaddedCustomers\x12+\n" +
+	"\x11removed_customers\x18\x05 \x03(\tR\x10removedCustomersB<Z:github.com/linhhuynhcoding/jss-microservices/mq/gen/eventsb\x06proto3"
 
 var (
 	file_product_service_proto_rawDescOnce sync.Once
@@ -86,16 +1280,35 @@ func file_product_service_proto_rawDescGZIP() []byte {
 	return file_product_service_proto_rawDescData
 }
 
-var file_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_product_service_proto_goTypes = []any{
-	(*ProductEvent)(nil), // 0: pb.ProductEvent
+	(*ProductLowStockEvent)(nil),        // 0: pb.ProductLowStockEvent
+	(*Product)(nil),                     // 1: pb.Product
+	(*ProductChangedEvent)(nil),         // 2: pb.ProductChangedEvent
+	(*ProductDeletedEvent)(nil),         // 3: pb.ProductDeletedEvent
+	(*ProductStockChangedEvent)(nil),    // 4: pb.ProductStockChangedEvent
+	(*Customer)(nil),                    // 5: pb.Customer
+	(*CustomerChangedEvent)(nil),        // 6: pb.CustomerChangedEvent
+	(*CustomerDeletedEvent)(nil),        // 7: pb.CustomerDeletedEvent
+	(*CustomersMergedEvent)(nil),        // 8: pb.CustomersMergedEvent
+	(*CustomerRekeyedEvent)(nil),        // 9: pb.CustomerRekeyedEvent
+	(*CustomerPrivacyRequestEvent)(nil), // 10: pb.CustomerPrivacyRequestEvent
+	(*CustomerPrivacyAckEvent)(nil),     // 11: pb.CustomerPrivacyAckEvent
+	(*CustomerSegmentUpdatedEvent)(nil), // 12: pb.CustomerSegmentUpdatedEvent
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
 }
 var file_product_service_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	13, // 0: pb.Product.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: pb.Product.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: pb.ProductChangedEvent.product:type_name -> pb.Product
+	13, // 3: pb.Customer.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: pb.Customer.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: pb.CustomerChangedEvent.customer:type_name -> pb.Customer
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_product_service_proto_init() }
@@ -109,7 +1322,7 @@ func file_product_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_service_proto_rawDesc), len(file_product_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
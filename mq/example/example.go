@@ -11,7 +11,6 @@ import (
 	"github.com/linhhuynhcoding/jss-microservices/mq/events"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/proto"
 )
 
 func main() {
@@ -36,8 +35,8 @@ func main() {
 	go func() {
 		for {
 			<-time.After(time.Second * 2)
-			err = publisher.SendMessage(&events.ProductEvent{
-				ProductId: "productID",
+			err = publisher.SendMessage(&events.ProductChangedEvent{
+				Product: &events.Product{Id: 1, Code: "productCode"},
 			}, consts.TOPIC_CREATE_PRODUCT)
 		}
 	}()
@@ -54,8 +53,10 @@ func main() {
 	// Start consuming
 	go func() {
 		if errCh <- subscriber.Consume(func(body []byte) error {
-			var data events.ProductEvent
-			_ = proto.Unmarshal(body, &data)
+			var data events.ProductChangedEvent
+			if err := mq.UnwrapEvent(body, &data); err != nil {
+				return err
+			}
 			b, _ := protojson.Marshal(&data)
 
 			fmt.Printf("Received: %v\n", string(b))
@@ -105,11 +105,13 @@ func (p *Publisher) SendMessage(event proto.Message, topic string) error {
 		false,                    // mandatory
 		false,                    // immediate
 		amqp.Publishing{
-			ContentType: "text/plain",
-			Body:        []byte(body),
+			ContentType:  "text/plain",
+			DeliveryMode: amqp.Persistent, // survives a broker restart in a durable queue
+			Body:         []byte(body),
 		})
 	if err != nil {
 		p.logger.Error("failed to sent message", zap.Error(err))
+		return err
 	}
 	p.logger.Info("Sent message sucessfully", zap.Any("topic", topic))
 	return nil
@@ -57,13 +57,15 @@ func NewSubscriber(
 		return nil, err
 	}
 
+	// a named queue outlives the subscriber, messages wait for the next start
+	shared := cfg.QueueName != ""
 	q, err := ch.QueueDeclare(
-		"",    // name (should be empty to auto-generate)
-		true,  // durable
-		true,  // delete when unused
-		true,  // exclusive
-		false, // no-wait
-		nil,   // arguments
+		cfg.QueueName, // name (empty to auto-generate)
+		true,          // durable
+		!shared,       // delete when unused
+		!shared,       // exclusive
+		false,         // no-wait
+		nil,           // arguments
 	)
 	if err != nil {
 		logger.Error("Failed to init mq queue", zap.Error(err))
@@ -1 +1,20 @@
 package mq
+
+import (
+	"fmt"
+
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnwrapEvent decodes a message sent by Publisher.SendMessage into event
+func UnwrapEvent(body []byte, event proto.Message) error {
+	var envelope events.EventEnvelope
+	if err := proto.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal event envelope: %v", err)
+	}
+	if err := proto.Unmarshal(envelope.Payload, event); err != nil {
+		return fmt.Errorf("failed to unmarshal event payload: %v", err)
+	}
+	return nil
+}
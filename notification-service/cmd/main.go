@@ -114,6 +114,9 @@ func main() {
 			zap.String("subscriber", subCfg.SubscriberName),
 			zap.Strings("keys", subCfg.SubscribeKeys),
 		)
+
+		// Customer data export and erasure requests
+		go svc.ConsumePrivacyRequests(context.Background(), cfg)
 	}
 
 	// 4) gRPC server
@@ -4,12 +4,14 @@ go 1.24.6
 
 require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.1
+	github.com/linhhuynhcoding/jss-microservices/mq v0.0.0-20261018213121-8e453f48b886
 	github.com/linhhuynhcoding/jss-microservices/rpc v0.0.0-20250825055631-2e9381e61cf7
 	github.com/spf13/viper v1.20.1
 	github.com/streadway/amqp v1.1.0
 	go.mongodb.org/mongo-driver v1.17.4
 	go.uber.org/zap v1.27.0
 	google.golang.org/grpc v1.74.2
+	google.golang.org/protobuf v1.36.8
 )
 
 require (
@@ -17,7 +19,6 @@ require (
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/klauspost/compress v1.16.7 // indirect
-	github.com/linhhuynhcoding/jss-microservices/mq v0.0.0-20250825055631-2e9381e61cf7 // indirect
 	github.com/montanaflynn/stats v0.7.1 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
 	github.com/rabbitmq/amqp091-go v1.10.0 // indirect
@@ -39,6 +40,5 @@ require (
 	golang.org/x/text v0.26.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250804133106-a7a43d27e69b // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250728155136-f173205681a0 // indirect
-	google.golang.org/protobuf v1.36.8 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
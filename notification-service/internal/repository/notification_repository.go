@@ -3,6 +3,7 @@ package repository
 import (
     "context"
     "errors"
+    "regexp"
     "time"
 
     "github.com/linhhuynhcoding/jss-microservices/notification-service/internal/domain"
@@ -98,4 +99,43 @@ func (r *NotificationRepository) MarkAsRead(ctx context.Context, id primitive.Ob
         return nil, err
     }
     return &n, nil
-}
\ No newline at end of file
+}
+// MentionPattern matches term as a whole identifier, not as a part of a
+// longer phone number, email or word.  The characters around the term are
+// captured in the first and last groups so a replacement can keep them.
+func MentionPattern(term string) string {
+    return `(^|[^\w@.+-])(?i:` + regexp.QuoteMeta(term) + `)(\.?(?:$|[^\w@.+-]))`
+}
+
+// FindMentioning returns notifications whose title or message contains
+// any of the given identifiers, see MentionPattern, oldest first.  Empty
+// terms are ignored.
+func (r *NotificationRepository) FindMentioning(ctx context.Context, terms []string) ([]*domain.Notification, error) {
+    var or bson.A
+    for _, t := range terms {
+        if t == "" {
+            continue
+        }
+        pattern := primitive.Regex{Pattern: MentionPattern(t)}
+        or = append(or, bson.M{"title": pattern}, bson.M{"message": pattern})
+    }
+    notifications := []*domain.Notification{}
+    if len(or) == 0 {
+        return notifications, nil
+    }
+    opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+    cursor, err := r.coll.Find(ctx, bson.M{"$or": or}, opts)
+    if err != nil {
+        return nil, err
+    }
+    if err := cursor.All(ctx, &notifications); err != nil {
+        return nil, err
+    }
+    return notifications, nil
+}
+
+// UpdateText replaces the title and message of a notification.
+func (r *NotificationRepository) UpdateText(ctx context.Context, id primitive.ObjectID, title, message string) error {
+    _, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{"title": title, "message": message}})
+    return err
+}
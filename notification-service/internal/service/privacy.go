@@ -0,0 +1,144 @@
+package service
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "regexp"
+
+    mq "github.com/linhhuynhcoding/jss-microservices/mq"
+    mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+    "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+    "github.com/linhhuynhcoding/jss-microservices/mq/events"
+    "github.com/linhhuynhcoding/jss-microservices/notification-service/internal/dto"
+    "github.com/linhhuynhcoding/jss-microservices/notification-service/internal/repository"
+    "github.com/linhhuynhcoding/jss-microservices/notification-service/pkg/config"
+    "go.uber.org/zap"
+)
+
+// erasedText replaces the identifiers of an erased customer in notifications.
+const erasedText = "[erased]"
+
+// ExportMentions returns, as JSON, the notifications mentioning any of the
+// stable identifiers (uuid, phone, email) of a customer.  The number of notifications is also returned.
+func (s *NotificationService) ExportMentions(ctx context.Context, terms ...string) ([]byte, int32, error) {
+    notifications, err := s.repo.FindMentioning(ctx, terms)
+    if err != nil {
+        return nil, 0, err
+    }
+    res := make([]dto.NotificationResponse, 0, len(notifications))
+    for _, n := range notifications {
+        res = append(res, dto.NotificationResponse{
+            ID:        n.ID.Hex(),
+            UserID:    n.UserID.Hex(),
+            Role:      n.Role,
+            Title:     n.Title,
+            Message:   n.Message,
+            IsRead:    n.IsRead,
+            CreatedAt: n.CreatedAt.Unix(),
+        })
+    }
+    data, err := json.Marshal(map[string]any{"notifications": res})
+    if err != nil {
+        return nil, 0, err
+    }
+    return data, int32(len(res)), nil
+}
+
+// RedactMentions replaces the stable identifiers of a customer in every
+// notification mentioning them.  The name is never matched, it is not
+// unique.  It returns the number of notifications updated.
+func (s *NotificationService) RedactMentions(ctx context.Context, terms ...string) (int32, error) {
+    notifications, err := s.repo.FindMentioning(ctx, terms)
+    if err != nil {
+        return 0, err
+    }
+    var patterns []*regexp.Regexp
+    for _, t := range terms {
+        if t != "" {
+            patterns = append(patterns, regexp.MustCompile(repository.MentionPattern(t)))
+        }
+    }
+    for _, n := range notifications {
+        title, message := n.Title, n.Message
+        for _, p := range patterns {
+            // a match consumes the separator after it, the second pass
+            // catches a mention right behind it
+            for range 2 {
+                title = p.ReplaceAllString(title, "${1}"+erasedText+"${2}")
+                message = p.ReplaceAllString(message, "${1}"+erasedText+"${2}")
+            }
+        }
+        if err := s.repo.UpdateText(ctx, n.ID, title, message); err != nil {
+            return 0, err
+        }
+    }
+    return int32(len(notifications)), nil
+}
+
+// ConsumePrivacyRequests answers the export and erasure requests of
+// product-customer-service.  It blocks until the subscriber stops.
+func (s *NotificationService) ConsumePrivacyRequests(ctx context.Context, cfg *config.Config) {
+    log := s.log.With(zap.String("func", "ConsumePrivacyRequests"))
+
+    mqCfg := mqconfig.RabbitMQConfig{
+        ConnStr:       cfg.RabbitMQURL,
+        ExchangeName:  consts.EXCHANGE_PRODUCT_SERVICE,
+        ExchangeType:  "topic",
+        PublisherName: consts.SERVICE_NOTIFICATION,
+        SubscribeKeys: []string{consts.TOPIC_CUSTOMER_PRIVACY_REQUEST},
+        QueueName:     consts.QUEUE_PRIVACY_REQUEST_NOTIFICATION,
+    }
+    sub, err := mq.NewSubscriber(mqCfg, log)
+    if err != nil {
+        log.Error("mq subscriber init failed", zap.Error(err))
+        return
+    }
+    defer sub.Close()
+    pub, err := mq.NewPublisher(mqCfg, log)
+    if err != nil {
+        log.Error("mq publisher init failed", zap.Error(err))
+        return
+    }
+    defer pub.Close()
+
+    err = sub.Consume(func(b []byte) error {
+        var req events.CustomerPrivacyRequestEvent
+        if err := mq.UnwrapEvent(b, &req); err != nil {
+            log.Warn("drop: unmarshal privacy request failed", zap.Error(err))
+            return nil
+        }
+        return pub.SendMessage(s.handlePrivacyRequest(ctx, &req), consts.TOPIC_CUSTOMER_PRIVACY_ACK)
+    })
+    if err != nil {
+        log.Error("privacy request subscriber exited", zap.Error(err))
+    }
+}
+
+func (s *NotificationService) handlePrivacyRequest(ctx context.Context, req *events.CustomerPrivacyRequestEvent) *events.CustomerPrivacyAckEvent {
+    ack := &events.CustomerPrivacyAckEvent{
+        RequestId: req.RequestId,
+        Service:   consts.SERVICE_NOTIFICATION,
+    }
+
+    var err error
+    switch req.Kind {
+    case "export":
+        ack.Data, ack.Records, err = s.ExportMentions(ctx, req.Uuid, req.Phone, req.Email)
+    case "erase":
+        ack.Records, err = s.RedactMentions(ctx, req.Uuid, req.Phone, req.Email)
+    default:
+        err = fmt.Errorf("unknown privacy request kind %s", req.Kind)
+    }
+    if err != nil {
+        s.log.Error("privacy request failed", zap.Int32("request_id", req.RequestId), zap.Error(err))
+        ack.Error = err.Error()
+        return ack
+    }
+    ack.Success = true
+    s.log.Info("privacy request handled",
+        zap.Int32("request_id", req.RequestId),
+        zap.String("kind", req.Kind),
+        zap.Int32("records", ack.Records))
+    return ack
+}
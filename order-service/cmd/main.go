@@ -52,6 +52,11 @@ func main() {
     }
     defer orderService.Close()
 
+    // Answer customer data export and erasure requests
+    go orderService.ConsumePrivacyRequests(context.Background(), cfg)
+    // Move orders keyed by phone to the customer uuid
+    go orderService.ConsumeCustomerRekeys(context.Background(), cfg)
+
     // Start gRPC server
     grpcServer := grpc.NewServer()
     orderpb.RegisterOrderServiceServer(grpcServer, orderService)
@@ -5,8 +5,8 @@ go 1.24.6
 require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.1
 	github.com/jung-kurt/gofpdf v1.16.2
-	github.com/linhhuynhcoding/jss-microservices/mq v0.0.0-20250905065304-ab9a0e107e21
-	github.com/linhhuynhcoding/jss-microservices/rpc v0.0.0-20250914034005-bff0a1fd2254
+	github.com/linhhuynhcoding/jss-microservices/mq v0.0.0-20261018215404-21ff8aaef7c5
+	github.com/linhhuynhcoding/jss-microservices/rpc v0.0.0-20261018215411-0032afc85c16
 	github.com/spf13/viper v1.16.0
 	go.mongodb.org/mongo-driver v1.11.0
 	go.uber.org/zap v1.27.0
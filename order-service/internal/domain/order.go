@@ -25,6 +25,9 @@ type OrderItem struct {
 	ProductName  string  `bson:"product_name" json:"product_name"`
 	ProductImage string  `bson:"product_image" json:"product_image"`
 	LineTotal    float64 `bson:"line_total" json:"line_total"`
+
+	SerialNumbers []string `bson:"serial_numbers,omitempty" json:"serial_numbers,omitempty"`
+	StoneDetails  string   `bson:"stone_details,omitempty" json:"stone_details,omitempty"`
 }
 
 type Order struct {
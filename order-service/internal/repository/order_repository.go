@@ -98,4 +98,48 @@ func (r *OrderRepository) List(ctx context.Context, page, limit int32) ([]domain
         return nil, 0, err
     }
     return orders, int32(count), nil
-}
\ No newline at end of file
+}
+// ListByCustomer returns every order placed by a customer, oldest first.
+// Customers are identified by their uuid across services, orders not re-keyed
+// yet are still keyed by phone, so several ids may be given.
+func (r *OrderRepository) ListByCustomer(ctx context.Context, customerIDs ...string) ([]domain.Order, error) {
+    opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+    cursor, err := r.coll.Find(ctx, bson.M{"customer_id": bson.M{"$in": customerIDs}}, opts)
+    if err != nil {
+        return nil, err
+    }
+    orders := []domain.Order{}
+    if err := cursor.All(ctx, &orders); err != nil {
+        return nil, err
+    }
+    return orders, nil
+}
+
+// AnonymizeCustomer replaces the identity of a customer on their orders.
+// The orders themselves are kept for accounting and keyed by customerID.
+// It returns the number of orders updated.
+func (r *OrderRepository) AnonymizeCustomer(ctx context.Context, previousIDs []string, customerID, name string) (int64, error) {
+    res, err := r.coll.UpdateMany(
+        ctx,
+        bson.M{"customer_id": bson.M{"$in": append(previousIDs, customerID)}},
+        bson.M{"$set": bson.M{"customer_id": customerID, "customer_name": name}},
+    )
+    if err != nil {
+        return 0, err
+    }
+    return res.ModifiedCount, nil
+}
+
+// RekeyCustomer moves the orders keyed by any of the previous ids of a
+// customer to customerID.  It returns the number of orders updated.
+func (r *OrderRepository) RekeyCustomer(ctx context.Context, previousIDs []string, customerID string) (int64, error) {
+    res, err := r.coll.UpdateMany(
+        ctx,
+        bson.M{"customer_id": bson.M{"$in": previousIDs}},
+        bson.M{"$set": bson.M{"customer_id": customerID}},
+    )
+    if err != nil {
+        return 0, err
+    }
+    return res.ModifiedCount, nil
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/order-service/config"
+
+	mq "github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+
+	"go.uber.org/zap"
+)
+
+// ConsumeCustomerRekeys moves the orders still keyed by an older identifier
+// of a customer, e.g. the phone, to the customer uuid.  It blocks until the
+// subscriber stops.
+func (s *Service) ConsumeCustomerRekeys(ctx context.Context, cfg config.Config) {
+	logger := s.logger.With(zap.String("func", "ConsumeCustomerRekeys"))
+
+	subscriber, err := mq.NewSubscriber(mqconfig.RabbitMQConfig{
+		ConnStr:       cfg.RabbitMQURL,
+		ExchangeName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:  consts.EXCHANGE_TYPE_TOPIC,
+		SubscribeKeys: []string{consts.TOPIC_REKEY_CUSTOMER},
+		QueueName:     consts.QUEUE_CUSTOMER_REKEYED_ORDER,
+	}, logger)
+	if err != nil {
+		logger.Error("failed to init mq subscriber", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+
+	err = subscriber.Consume(func(body []byte) error {
+		var evt events.CustomerRekeyedEvent
+		if err := mq.UnwrapEvent(body, &evt); err != nil {
+			logger.Error("failed to decode customer rekey", zap.Error(err))
+			return nil
+		}
+		if evt.Uuid == "" || len(evt.PreviousIds) == 0 {
+			return nil
+		}
+		n, err := s.repo.RekeyCustomer(ctx, evt.PreviousIds, evt.Uuid)
+		if err != nil {
+			logger.Error("failed to rekey customer orders", zap.String("uuid", evt.Uuid), zap.Error(err))
+			return err
+		}
+		if n > 0 {
+			logger.Info("customer orders rekeyed", zap.String("uuid", evt.Uuid), zap.Int64("orders", n))
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("consumer error", zap.Error(err))
+	}
+}
@@ -129,8 +129,9 @@ func (s *Service) CreateOrder(ctx context.Context, req *orderpb.CreateOrderReque
 	}
 	for _, it := range req.Items {
 		pReq.Products = append(pReq.Products, &productpb.PurchaseProductRequest_Product{
-			ProductId: it.ProductId,
-			Quantity:  it.Quantity,
+			ProductId:     it.ProductId,
+			Quantity:      it.Quantity,
+			SerialNumbers: it.SerialNumbers,
 		})
 	}
 	pResp, err := s.productClient.PurchaseProduct(ctx, pReq)
@@ -139,18 +140,27 @@ func (s *Service) CreateOrder(ctx context.Context, req *orderpb.CreateOrderReque
 		return nil, fmt.Errorf("failed to purchase products: %w", err)
 	}
 
+	// orders and vouchers are keyed by the customer uuid, the request may
+	// carry the phone
+	customerID := req.GetCustomerId()
+	if uid := pResp.GetCustomer().GetUuid(); uid != "" {
+		customerID = uid
+	}
+
 	// 5) Build snapshot: price + name + image
 	type snap struct {
-		price float64
-		name  string
-		image string
+		price  float64
+		name   string
+		image  string
+		stones string
 	}
 	byID := make(map[int32]snap, len(pResp.GetProducts()))
 	for _, p := range pResp.GetProducts() {
 		byID[p.GetId()] = snap{
-			price: p.GetSellingPrice(),
-			name:  p.GetName(),  // từ product proto
-			image: p.GetImage(), // từ product proto
+			price:  p.GetSellingPrice(),
+			name:   p.GetName(),  // từ product proto
+			image:  p.GetImage(), // từ product proto
+			stones: formatStones(p.GetStones()),
 		}
 	}
 
@@ -165,12 +175,14 @@ func (s *Service) CreateOrder(ctx context.Context, req *orderpb.CreateOrderReque
 		line := snap.price * float64(it.Quantity)
 		subtotal += line
 		items = append(items, domain.OrderItem{
-			ProductID:    it.ProductId,
-			Quantity:     it.Quantity,
-			UnitPrice:    snap.price,
-			ProductName:  snap.name,
-			ProductImage: snap.image,
-			LineTotal:    line,
+			ProductID:     it.ProductId,
+			Quantity:      it.Quantity,
+			UnitPrice:     snap.price,
+			ProductName:   snap.name,
+			ProductImage:  snap.image,
+			LineTotal:     line,
+			SerialNumbers: it.SerialNumbers,
+			StoneDetails:  snap.stones,
 		})
 	}
 
@@ -182,7 +194,7 @@ func (s *Service) CreateOrder(ctx context.Context, req *orderpb.CreateOrderReque
 			Vouchers:            req.VoucherCodes,
 			TotalProductAmount:  subtotal,
 			TotalShippingAmount: shipping,
-			CustomerId:          req.GetCustomerId(),
+			CustomerId:          customerID,
 			OrderId:             orderID,
 		}
 		vResp, err := s.loyaltyClient.UsingVoucher(ctx, vReq)
@@ -200,7 +212,7 @@ func (s *Service) CreateOrder(ctx context.Context, req *orderpb.CreateOrderReque
 	order := &domain.Order{
 		OrderID:        orderID,
 		CustomerName:   req.GetCustomerName(),
-		CustomerID:     req.GetCustomerId(),
+		CustomerID:     customerID,
 		StaffID:        userID,
 		Items:          items,
 		VoucherCodes:   req.VoucherCodes,
@@ -379,6 +391,20 @@ func (s *Service) GenerateInvoice(ctx context.Context, req *orderpb.GetOrderRequ
 		pdf.CellFormat(35, 8, formatVNDEn(it.UnitPrice), "1", 0, "R", false, 0, "")
 		pdf.CellFormat(35, 8, formatVNDEn(it.LineTotal), "1", 0, "R", false, 0, "")
 		pdf.Ln(-1)
+		details := make([]string, 0, 2)
+		if len(it.SerialNumbers) > 0 {
+			details = append(details, "S/N: "+strings.Join(it.SerialNumbers, ", "))
+		}
+		if it.StoneDetails != "" {
+			details = append(details, "Stones: "+it.StoneDetails)
+		}
+		for _, d := range details {
+			pdf.SetFont("Arial", "I", 9)
+			pdf.CellFormat(15, 6, "", "LR", 0, "C", false, 0, "")
+			pdf.CellFormat(175, 6, d, "LR", 0, "L", false, 0, "")
+			pdf.Ln(-1)
+			pdf.SetFont("Arial", "", 10)
+		}
 	}
 
 	// Totals
@@ -429,6 +455,29 @@ func bearerFromMD(ctx context.Context) (string, error) {
 	return strings.TrimSpace(authHeader[7:]), nil
 }
 
+// formatStones flattens the stone attributes for the order snapshot and invoice,
+// e.g. "1 diamond 0.50ct Excellent F VS1 (GIA 2141438167)"
+func formatStones(stones []*productpb.ProductStone) string {
+	parts := make([]string, 0, len(stones))
+	for _, st := range stones {
+		fields := []string{fmt.Sprintf("%d %s", st.GetCount(), st.GetStoneType())}
+		if st.GetCarat() > 0 {
+			fields = append(fields, fmt.Sprintf("%.2fct", st.GetCarat()))
+		}
+		for _, f := range []string{st.GetCut(), st.GetColor(), st.GetClarity()} {
+			if f != "" {
+				fields = append(fields, f)
+			}
+		}
+		part := strings.Join(fields, " ")
+		if st.GetCertificateNumber() != "" {
+			part += fmt.Sprintf(" (%s %s)", st.GetCertificateLab(), st.GetCertificateNumber())
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "; ")
+}
+
 func toPBOrder(o *domain.Order) *orderpb.Order {
 	pb := &orderpb.Order{
 		OrderId:        o.OrderID,
@@ -445,12 +494,14 @@ func toPBOrder(o *domain.Order) *orderpb.Order {
 	}
 	for _, it := range o.Items {
 		pb.Items = append(pb.Items, &orderpb.OrderItem{
-			ProductId:    it.ProductID,
-			Quantity:     it.Quantity,
-			UnitPrice:    it.UnitPrice,
-			ProductName:  it.ProductName,
-			ProductImage: it.ProductImage,
-			LineTotal:    it.LineTotal,
+			ProductId:     it.ProductID,
+			Quantity:      it.Quantity,
+			UnitPrice:     it.UnitPrice,
+			ProductName:   it.ProductName,
+			ProductImage:  it.ProductImage,
+			LineTotal:     it.LineTotal,
+			SerialNumbers: it.SerialNumbers,
+			StoneDetails:  it.StoneDetails,
 		})
 	}
 	for _, h := range o.StatusHistory {
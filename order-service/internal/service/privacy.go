@@ -0,0 +1,106 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+
+	"github.com/linhhuynhcoding/jss-microservices/order-service/config"
+
+	mq "github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	"github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+
+	"go.uber.org/zap"
+)
+
+// erasedCustomerName replaces the name on the orders of an erased customer
+const erasedCustomerName = "Erased customer"
+
+// ConsumePrivacyRequests answers the export and erasure requests of
+// product-customer-service.  It blocks until the subscriber stops.
+func (s *Service) ConsumePrivacyRequests(ctx context.Context, cfg config.Config) {
+	logger := s.logger.With(zap.String("func", "ConsumePrivacyRequests"))
+
+	subscriber, err := mq.NewSubscriber(mqconfig.RabbitMQConfig{
+		ConnStr:       cfg.RabbitMQURL,
+		ExchangeName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:  consts.EXCHANGE_TYPE_TOPIC,
+		SubscribeKeys: []string{consts.TOPIC_CUSTOMER_PRIVACY_REQUEST},
+		QueueName:     consts.QUEUE_PRIVACY_REQUEST_ORDER,
+	}, logger)
+	if err != nil {
+		logger.Error("failed to init mq subscriber", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+
+	// acks go back on the exchange of the requester
+	publisher, err := mq.NewPublisher(mqconfig.RabbitMQConfig{
+		ConnStr:       cfg.RabbitMQURL,
+		ExchangeName:  consts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:  consts.EXCHANGE_TYPE_TOPIC,
+		PublisherName: consts.SERVICE_ORDER,
+	}, logger)
+	if err != nil {
+		logger.Error("failed to init mq publisher", zap.Error(err))
+		return
+	}
+	defer publisher.Close()
+
+	err = subscriber.Consume(func(body []byte) error {
+		var req events.CustomerPrivacyRequestEvent
+		if err := mq.UnwrapEvent(body, &req); err != nil {
+			logger.Error("failed to decode privacy request", zap.Error(err))
+			return nil
+		}
+		ack := s.handlePrivacyRequest(ctx, &req)
+		return publisher.SendMessage(ack, consts.TOPIC_CUSTOMER_PRIVACY_ACK)
+	})
+	if err != nil {
+		logger.Error("consumer error", zap.Error(err))
+	}
+}
+
+func (s *Service) handlePrivacyRequest(ctx context.Context, req *events.CustomerPrivacyRequestEvent) *events.CustomerPrivacyAckEvent {
+	logger := s.logger.With(zap.Int32("request_id", req.RequestId), zap.String("kind", req.Kind))
+	ack := &events.CustomerPrivacyAckEvent{
+		RequestId: req.RequestId,
+		Service:   consts.SERVICE_ORDER,
+	}
+	fail := func(err error) *events.CustomerPrivacyAckEvent {
+		logger.Error("failed to handle privacy request", zap.Error(err))
+		ack.Error = err.Error()
+		return ack
+	}
+
+	switch req.Kind {
+	case "export":
+		orders, err := s.repo.ListByCustomer(ctx, req.Uuid, req.Phone)
+		if err != nil {
+			return fail(err)
+		}
+		data, err := json.Marshal(map[string]any{"orders": orders})
+		if err != nil {
+			return fail(err)
+		}
+		ack.Data = data
+		ack.Records = int32(len(orders))
+	case "erase":
+		// the orders stay keyed by uuid, which is not personal data
+		n, err := s.repo.AnonymizeCustomer(ctx, []string{req.Phone}, cmp.Or(req.Uuid, req.Pseudonym), erasedCustomerName)
+		if err != nil {
+			return fail(err)
+		}
+		ack.Records = int32(n)
+	default:
+		logger.Warn("unknown privacy request kind")
+		ack.Error = "unknown privacy request kind " + req.Kind
+		return ack
+	}
+
+	ack.Success = true
+	logger.Info("privacy request handled", zap.Int32("records", ack.Records))
+	return ack
+}
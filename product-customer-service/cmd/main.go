@@ -42,6 +42,9 @@ func main() {
 	store := repository.NewStore(connPool)
 
 	s := service.NewService(ctx, log, cfg, store)
+	go s.ConsumePrivacyAcks(ctx)
+	go s.ConsumeBuybacks(ctx)
+	go s.RefreshSegments(ctx)
 
 	go NewServer(ctx, cfg, log, s)
 	NewGatewayServer(ctx, cfg, log, s)
@@ -86,6 +89,8 @@ func NewGatewayServer(
 	}
 
 	mux.HandlePath("POST", "/v1/upload", service.UploadFileHTTP)
+	mux.HandlePath("POST", "/v1/products/import", service.ImportProductsHTTP)
+	mux.HandlePath("GET", "/v1/files/{name}", service.ServeFileHTTP)
 
 	log.Info("gRPC-Gateway listening on :8080")
 	if err := http.ListenAndServe(fmt.Sprintf(":%v", cfg.HttpPort), mux); err != nil {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/linhhuynhcoding/jss-microservices/product/consts"
 	"github.com/spf13/viper"
@@ -25,7 +26,27 @@ type Config struct {
 
 	UploadFolder string `mapstructure:"UPLOAD_FOLDER"`
 
-	MarketServiceUrl string `mapstructure:"MARKET_SERVICE_URL"`
+	// local, s3 or cloudinary
+	StorageDriver    string `mapstructure:"STORAGE_DRIVER"`
+	StoragePublicUrl string `mapstructure:"STORAGE_PUBLIC_URL"`
+
+	S3Config struct {
+		Endpoint  string `mapstructure:"S3_ENDPOINT"`
+		AccessKey string `mapstructure:"S3_ACCESS_KEY"`
+		SecretKey string `mapstructure:"S3_SECRET_KEY"`
+		Bucket    string `mapstructure:"S3_BUCKET"`
+		Region    string `mapstructure:"S3_REGION"`
+		UseSSL    bool   `mapstructure:"S3_USE_SSL"`
+	} `mapstructure:"S3"`
+
+	RabbitMQURL string `mapstructure:"RABBITMQ_URL"`
+
+	MarketServiceUrl  string `mapstructure:"MARKET_SERVICE_URL"`
+	AuthServiceUrl    string `mapstructure:"AUTH_SERVICE_URL"`
+	LoyaltyServiceUrl string `mapstructure:"LOYALTY_SERVICE_URL"`
+
+	// how often customer segments are recomputed, 0 disables it
+	SegmentRefreshInterval time.Duration `mapstructure:"SEGMENT_REFRESH_INTERVAL"`
 }
 
 func NewConfig() Config {
@@ -38,8 +59,10 @@ func NewConfig() Config {
 
 func LoadDefaultConfig(cfg *Config) {
 	cfg.UploadFolder = consts.DEFAULT_UPLOAD_FOLDER
+	cfg.StorageDriver = consts.STORAGE_LOCAL
 	cfg.HttpPort = consts.HTTP_PORT
 	cfg.GrpcPort = consts.GRPC_PORT
+	cfg.SegmentRefreshInterval = consts.DEFAULT_SEGMENT_REFRESH_INTERVAL
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -1,5 +1,7 @@
 package consts
 
+import "time"
+
 // common
 const (
 	EMPTY_STRING string = ""
@@ -7,6 +9,18 @@ const (
 
 const (
 	DEFAULT_UPLOAD_FOLDER string = "./upload"
+
+	DEFAULT_SEGMENT_REFRESH_INTERVAL = time.Hour
+
+	// wait between two attempts to connect to the message broker
+	PUBLISHER_RETRY_INTERVAL = 30 * time.Second
+)
+
+// storage drivers
+const (
+	STORAGE_LOCAL      = "local"
+	STORAGE_S3         = "s3"
+	STORAGE_CLOUDINARY = "cloudinary"
 )
 
 const (
@@ -17,3 +31,64 @@ const (
 const (
 	MACE_OF_GOLD_WEIGHT = 3.75 // gram
 )
+
+const (
+	ROLE_STAFF   = "STAFF"
+	ROLE_MANAGER = "MANAGER"
+	ROLE_ADMIN   = "ADMIN"
+)
+
+// stock ledger movement types
+const (
+	MOVEMENT_OPENING    = "opening"
+	MOVEMENT_SALE       = "sale"
+	MOVEMENT_ADJUSTMENT = "adjustment"
+	MOVEMENT_STOCKTAKE  = "stocktake"
+	MOVEMENT_PURCHASE   = "purchase"
+)
+
+const (
+	STOCKTAKE_OPEN      = "open"
+	STOCKTAKE_SUBMITTED = "submitted"
+	STOCKTAKE_APPROVED  = "approved"
+	STOCKTAKE_REJECTED  = "rejected"
+)
+
+// product lifecycle, discontinued products are still sold but not restocked
+const (
+	PRODUCT_ACTIVE       = "active"
+	PRODUCT_DISCONTINUED = "discontinued"
+	PRODUCT_ARCHIVED     = "archived"
+)
+
+const (
+	PURCHASE_ORDER_OPEN               = "open"
+	PURCHASE_ORDER_PARTIALLY_RECEIVED = "partially_received"
+	PURCHASE_ORDER_RECEIVED           = "received"
+	PURCHASE_ORDER_CANCELLED          = "cancelled"
+)
+
+const (
+	SERIAL_IN_STOCK    = "in_stock"
+	SERIAL_SOLD        = "sold"
+	SERIAL_BOUGHT_BACK = "bought_back"
+)
+
+const (
+	SERIAL_EVENT_REGISTERED  = "registered"
+	SERIAL_EVENT_SOLD        = "sold"
+	SERIAL_EVENT_COUNTED     = "counted"
+	SERIAL_EVENT_BOUGHT_BACK = "bought_back" // reference_id is the market-service buyback
+)
+
+// privacy requests on customer data
+const (
+	PRIVACY_EXPORT = "export"
+	PRIVACY_ERASE  = "erase"
+
+	PRIVACY_PENDING   = "pending"
+	PRIVACY_COMPLETED = "completed"
+	PRIVACY_FAILED    = "failed"
+
+	ERASED_CUSTOMER_NAME = "Erased customer"
+)
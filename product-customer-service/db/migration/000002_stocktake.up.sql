@@ -0,0 +1,50 @@
+CREATE TABLE "stock_movements" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "product_id" int NOT NULL,
+  "quantity" int NOT NULL, -- signed: positive = in, negative = out
+
+  "movement_type" varchar(20) NOT NULL, -- "opening", "sale", "adjustment", "stocktake"
+  "reference_id" int,
+  "note" text,
+  "created_by" varchar(100),
+
+  "created_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE "stocktake_sessions" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "category_id" int, -- NULL = every category
+
+  "status" varchar(20) NOT NULL DEFAULT 'open', -- "open", "submitted", "approved", "rejected"
+  "note" text,
+
+  "opened_by" varchar(100) NOT NULL,
+  "approved_by" varchar(100),
+
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  "submitted_at" timestamp,
+  "approved_at" timestamp
+);
+
+CREATE TABLE "stocktake_counts" (
+  "session_id" int NOT NULL,
+  "product_id" int NOT NULL,
+  "counted_quantity" int NOT NULL DEFAULT 0,
+  "counted_by" varchar(100),
+  "updated_at" timestamp NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY ("session_id", "product_id")
+);
+
+CREATE INDEX ON "stock_movements" ("product_id");
+
+ALTER TABLE "stock_movements" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id");
+ALTER TABLE "stocktake_sessions" ADD FOREIGN KEY ("category_id") REFERENCES "product_categories" ("id");
+ALTER TABLE "stocktake_counts" ADD FOREIGN KEY ("session_id") REFERENCES "stocktake_sessions" ("id");
+ALTER TABLE "stocktake_counts" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id");
+
+-- Open the ledger with the stock each product currently holds
+INSERT INTO "stock_movements" ("product_id", "quantity", "movement_type", "note")
+SELECT "id", "stock", 'opening', 'ledger backfill'
+FROM "products"
+WHERE COALESCE("stock", 0) <> 0;
@@ -0,0 +1,33 @@
+CREATE TABLE "product_serials" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "serial_number" varchar(50) UNIQUE NOT NULL,
+  "product_id" int NOT NULL,
+
+  "weight" decimal(10,2),
+  "stone_details" text,
+  "certificate_number" varchar(100),
+
+  "status" varchar(20) NOT NULL DEFAULT 'in_stock', -- "in_stock", "sold"
+  "order_id" int,
+
+  "created_at" timestamp,
+  "updated_at" timestamp
+);
+
+CREATE TABLE "product_serial_events" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "serial_id" int NOT NULL,
+  "event_type" varchar(20) NOT NULL, -- "registered", "sold", "counted"
+  "reference_id" int,
+  "note" text,
+  "created_by" varchar(100),
+  "created_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "order_record" ADD COLUMN "serial_numbers" text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX ON "product_serials" ("product_id");
+CREATE INDEX ON "product_serial_events" ("serial_id");
+
+ALTER TABLE "product_serials" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id");
+ALTER TABLE "product_serial_events" ADD FOREIGN KEY ("serial_id") REFERENCES "product_serials" ("id");
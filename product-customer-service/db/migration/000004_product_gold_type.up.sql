@@ -0,0 +1 @@
+ALTER TABLE "products" ADD COLUMN "gold_type" int; -- gold price id in market-service
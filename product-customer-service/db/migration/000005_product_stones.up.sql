@@ -0,0 +1,21 @@
+CREATE TABLE "product_stones" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "product_id" int NOT NULL,
+
+  "stone_type" varchar(50) NOT NULL, -- "diamond", "ruby", "sapphire", ...
+  "carat" decimal(8,3),
+  "cut" varchar(30),
+  "color" varchar(10),
+  "clarity" varchar(10),
+  "stone_count" int NOT NULL DEFAULT 1,
+
+  "certificate_lab" varchar(50), -- "GIA", "PNJ", "SJC", ...
+  "certificate_number" varchar(100),
+
+  "created_at" timestamp
+);
+
+CREATE INDEX ON "product_stones" ("product_id");
+CREATE INDEX ON "product_stones" ("stone_type");
+
+ALTER TABLE "product_stones" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE;
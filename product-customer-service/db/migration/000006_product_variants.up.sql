@@ -0,0 +1,6 @@
+ALTER TABLE "products" ADD COLUMN "parent_id" int; -- NULL for standalone and parent products
+ALTER TABLE "products" ADD COLUMN "size" varchar(20); -- ring / bracelet size of a variant
+
+CREATE INDEX ON "products" ("parent_id");
+
+ALTER TABLE "products" ADD FOREIGN KEY ("parent_id") REFERENCES "products" ("id");
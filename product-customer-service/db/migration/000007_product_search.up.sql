@@ -0,0 +1,15 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent() is only STABLE, wrap it so it can be used in an index.
+-- "Nhẫn vàng Đẹp" -> "nhan vang dep"
+CREATE OR REPLACE FUNCTION f_unaccent(text) RETURNS text AS $$
+  SELECT lower(public.unaccent('public.unaccent'::regdictionary, $1))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+CREATE INDEX "products_search_idx" ON "products"
+  USING gin (f_unaccent(coalesce("name", '') || ' ' || "code") gin_trgm_ops);
+
+CREATE INDEX ON "products" ("category_id");
+CREATE INDEX ON "products" ("selling_price");
+CREATE INDEX ON "products" ("created_at");
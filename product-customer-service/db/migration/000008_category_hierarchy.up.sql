@@ -0,0 +1,7 @@
+ALTER TABLE "product_categories" ADD COLUMN "parent_id" int;
+ALTER TABLE "product_categories" ADD COLUMN "default_markup_rate" decimal(5,2); -- used when a product is created without markup rate
+ALTER TABLE "product_categories" ADD COLUMN "default_warranty_period" int; -- months
+
+CREATE INDEX ON "product_categories" ("parent_id");
+
+ALTER TABLE "product_categories" ADD FOREIGN KEY ("parent_id") REFERENCES "product_categories" ("id");
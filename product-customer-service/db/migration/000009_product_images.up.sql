@@ -0,0 +1,30 @@
+CREATE TABLE "product_images" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "product_id" int, -- NULL until the image is attached to a product
+  "file_id" varchar(32) NOT NULL,
+  "original_filename" varchar(255),
+  "source_format" varchar(10) NOT NULL, -- jpeg, png, webp
+  "width" int NOT NULL,
+  "height" int NOT NULL,
+  "sort_order" int NOT NULL DEFAULT 0,
+  "is_primary" boolean NOT NULL DEFAULT false,
+  "created_at" timestamp
+);
+
+CREATE TABLE "product_image_renditions" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "image_id" int NOT NULL,
+  "size" varchar(20) NOT NULL, -- thumbnail, medium, large
+  "format" varchar(10) NOT NULL, -- webp, jpeg
+  "width" int NOT NULL,
+  "height" int NOT NULL,
+  "storage_key" varchar(255) NOT NULL,
+  "url" text NOT NULL
+);
+
+CREATE INDEX ON "product_images" ("product_id", "sort_order");
+CREATE UNIQUE INDEX "product_images_one_primary" ON "product_images" ("product_id") WHERE "is_primary";
+CREATE INDEX ON "product_image_renditions" ("image_id");
+
+ALTER TABLE "product_images" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id") ON DELETE CASCADE;
+ALTER TABLE "product_image_renditions" ADD FOREIGN KEY ("image_id") REFERENCES "product_images" ("id") ON DELETE CASCADE;
@@ -0,0 +1,4 @@
+ALTER TABLE "products" ADD COLUMN "status" varchar(20) NOT NULL DEFAULT 'active'; -- "active", "discontinued", "archived"
+ALTER TABLE "products" ADD COLUMN "archived_at" timestamp;
+
+CREATE INDEX ON "products" ("status");
@@ -0,0 +1,4 @@
+ALTER TABLE "products" ADD COLUMN "reorder_point" int; -- alert when stock drops to this level, NULL disables the alert
+ALTER TABLE "products" ADD COLUMN "reorder_quantity" int; -- suggested quantity for the purchase order
+
+CREATE INDEX ON "products" ("reorder_point") WHERE "reorder_point" IS NOT NULL;
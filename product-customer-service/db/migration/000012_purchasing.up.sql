@@ -0,0 +1,70 @@
+CREATE TABLE "suppliers" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "name" varchar NOT NULL,
+  "phone" varchar(20),
+  "email" varchar,
+  "address" text,
+  "tax_code" varchar(20),
+  "note" text,
+  "is_active" boolean NOT NULL DEFAULT true,
+
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  "updated_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE "purchase_orders" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "supplier_id" int NOT NULL,
+
+  "status" varchar(20) NOT NULL DEFAULT 'open', -- "open", "partially_received", "received", "cancelled"
+  "note" text,
+  "expected_at" timestamp,
+
+  "created_by" varchar(100) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT NOW(),
+  "updated_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE "purchase_order_lines" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "purchase_order_id" int NOT NULL,
+  "product_id" int NOT NULL,
+  "quantity" int NOT NULL,
+  "received_quantity" int NOT NULL DEFAULT 0,
+  "gold_cost_per_gram" decimal(15,2) NOT NULL, -- agreed with the supplier
+  "labor_cost" decimal(15,2) NOT NULL DEFAULT 0, -- per piece
+
+  UNIQUE ("purchase_order_id", "product_id")
+);
+
+CREATE TABLE "goods_receipts" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "purchase_order_id" int NOT NULL,
+  "extra_cost" decimal(15,2) NOT NULL DEFAULT 0, -- shipping, insurance, duties, spread over the received pieces
+  "note" text,
+
+  "received_by" varchar(100) NOT NULL,
+  "received_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE "goods_receipt_lines" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "receipt_id" int NOT NULL,
+  "purchase_order_line_id" int NOT NULL,
+  "product_id" int NOT NULL,
+  "quantity" int NOT NULL,
+  "landed_cost" decimal(15,2) NOT NULL -- per piece: gold + labor + share of the extra cost
+);
+
+CREATE INDEX ON "purchase_orders" ("supplier_id");
+CREATE INDEX ON "purchase_orders" ("status");
+CREATE INDEX ON "goods_receipts" ("purchase_order_id");
+CREATE INDEX ON "goods_receipt_lines" ("receipt_id");
+
+ALTER TABLE "purchase_orders" ADD FOREIGN KEY ("supplier_id") REFERENCES "suppliers" ("id");
+ALTER TABLE "purchase_order_lines" ADD FOREIGN KEY ("purchase_order_id") REFERENCES "purchase_orders" ("id");
+ALTER TABLE "purchase_order_lines" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id");
+ALTER TABLE "goods_receipts" ADD FOREIGN KEY ("purchase_order_id") REFERENCES "purchase_orders" ("id");
+ALTER TABLE "goods_receipt_lines" ADD FOREIGN KEY ("receipt_id") REFERENCES "goods_receipts" ("id");
+ALTER TABLE "goods_receipt_lines" ADD FOREIGN KEY ("purchase_order_line_id") REFERENCES "purchase_order_lines" ("id");
+ALTER TABLE "goods_receipt_lines" ADD FOREIGN KEY ("product_id") REFERENCES "products" ("id");
@@ -0,0 +1,13 @@
+-- Phone numbers are entered as 0912 345 678, +84 912 345 678, 84912345678...
+-- f_normalize_phone() reduces them to the local form "0912345678".
+CREATE OR REPLACE FUNCTION f_normalize_phone(text) RETURNS text AS $$
+  SELECT regexp_replace(regexp_replace($1, '[^0-9+]', '', 'g'), '^(\+840?|84(?=[0-9]{9}$))', '0')
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+CREATE INDEX "customers_phone_idx" ON "customers" (f_normalize_phone("phone"));
+
+CREATE INDEX "customers_search_idx" ON "customers"
+  USING gin (f_unaccent("name" || ' ' || coalesce("email", '')) gin_trgm_ops);
+
+CREATE INDEX "customers_phone_search_idx" ON "customers"
+  USING gin (f_normalize_phone("phone") gin_trgm_ops);
@@ -0,0 +1,6 @@
+-- Price of one piece when the order was placed, products.selling_price moves
+-- with the gold price. Rows recorded before this migration keep NULL, their
+-- price is unknown and they are left out of the spend figures.
+ALTER TABLE "order_record" ADD COLUMN "unit_price" decimal(15,2);
+
+CREATE INDEX ON "order_record" ("customer_id", "created_at");
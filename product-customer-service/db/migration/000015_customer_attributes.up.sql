@@ -0,0 +1,20 @@
+ALTER TABLE "customers"
+  ADD COLUMN "birthday" date,
+  ADD COLUMN "anniversary" date,
+  ADD COLUMN "ring_size" varchar(10),
+  ADD COLUMN "preferred_gold_type" int, -- gold price id in market-service
+  ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX ON "customers" USING gin ("tags");
+
+CREATE TABLE "customer_notes" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "customer_id" int NOT NULL,
+  "note" text NOT NULL,
+  "created_by" varchar NOT NULL, -- user id in auth-service
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "customer_notes" ("customer_id", "created_at");
+
+ALTER TABLE "customer_notes" ADD FOREIGN KEY ("customer_id") REFERENCES "customers" ("id") ON DELETE CASCADE;
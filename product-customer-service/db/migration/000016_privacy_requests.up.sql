@@ -0,0 +1,32 @@
+-- An erased customer keeps its row, without a phone, so that financial
+-- records still point to it. erased_at marks it as erased.
+ALTER TABLE "customers" ALTER COLUMN "phone" DROP NOT NULL;
+ALTER TABLE "customers" ADD COLUMN "erased_at" timestamp;
+
+CREATE TABLE "privacy_requests" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "kind" varchar(10) NOT NULL, -- export, erase
+  "customer_id" int NOT NULL, -- no foreign key, kept as an audit trail
+  "status" varchar(20) NOT NULL DEFAULT 'pending', -- pending, completed, failed
+  "requested_by" varchar NOT NULL,
+  "payload" jsonb, -- identifiers sent to the services, cleared once completed
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "completed_at" timestamp
+);
+
+-- One row per service that answered the request
+CREATE TABLE "privacy_request_acks" (
+  "request_id" int NOT NULL,
+  "service" varchar(50) NOT NULL,
+  "success" bool NOT NULL,
+  "error" text,
+  "data" jsonb, -- export only
+  "records" int NOT NULL DEFAULT 0,
+  "acknowledged_at" timestamp NOT NULL DEFAULT (now()),
+
+  PRIMARY KEY ("request_id", "service")
+);
+
+CREATE INDEX ON "privacy_requests" ("customer_id");
+
+ALTER TABLE "privacy_request_acks" ADD FOREIGN KEY ("request_id") REFERENCES "privacy_requests" ("id") ON DELETE CASCADE;
@@ -0,0 +1,25 @@
+CREATE TABLE "customer_segments" (
+  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  "name" varchar NOT NULL UNIQUE,
+  "description" text,
+  "rules" jsonb NOT NULL, -- SegmentRules, loyalty balance as a minPoints/maxPoints range
+  "voucher_id" int, -- loyalty-service voucher granted to new members
+  "member_count" int NOT NULL DEFAULT 0,
+  "computed_at" timestamp,
+  "created_by" varchar NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+-- Members as of the last computation
+CREATE TABLE "customer_segment_members" (
+  "segment_id" int NOT NULL,
+  "customer_id" int NOT NULL,
+  "spend" decimal(15,2) NOT NULL DEFAULT 0,
+  "last_visit" timestamp,
+  "added_at" timestamp NOT NULL DEFAULT (now()),
+
+  PRIMARY KEY ("segment_id", "customer_id")
+);
+
+ALTER TABLE "customer_segment_members" ADD FOREIGN KEY ("segment_id") REFERENCES "customer_segments" ("id") ON DELETE CASCADE;
+ALTER TABLE "customer_segment_members" ADD FOREIGN KEY ("customer_id") REFERENCES "customers" ("id") ON DELETE CASCADE;
@@ -0,0 +1,22 @@
+-- Customers are identified by a stable uuid across services, the phone can
+-- change without orphaning their history.
+ALTER TABLE "customers" ADD COLUMN "uuid" uuid NOT NULL DEFAULT gen_random_uuid();
+
+CREATE UNIQUE INDEX ON "customers" ("uuid");
+
+-- order_record.customer_id moves from the phone to the customer uuid
+ALTER TABLE "order_record" ADD COLUMN "customer_uuid" uuid;
+
+UPDATE "order_record" r
+SET "customer_uuid" = c."uuid"
+FROM "customers" c
+WHERE c."phone" = r."customer_id";
+
+ALTER TABLE "order_record" DROP COLUMN "customer_id";
+ALTER TABLE "order_record" RENAME COLUMN "customer_uuid" TO "customer_id";
+ALTER TABLE "order_record" ALTER COLUMN "customer_id" SET NOT NULL;
+ALTER TABLE "order_record" ADD PRIMARY KEY ("customer_id", "product_id", "order_id");
+
+CREATE INDEX ON "order_record" ("customer_id", "created_at");
+
+ALTER TABLE "order_record" ADD FOREIGN KEY ("customer_id") REFERENCES "customers" ("uuid");
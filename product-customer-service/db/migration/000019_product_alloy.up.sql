@@ -0,0 +1 @@
+ALTER TABLE "products" ADD COLUMN "alloy_id" int; -- alloy id in market-service, prices the gold at its purity
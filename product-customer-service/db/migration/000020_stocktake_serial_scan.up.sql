@@ -0,0 +1,2 @@
+-- A piece is counted at most once per stocktake session
+CREATE UNIQUE INDEX ON "product_serial_events" ("serial_id", "reference_id") WHERE "event_type" = 'counted';
@@ -1,6 +1,6 @@
 -- name: CreateProductCategory :one
-INSERT INTO product_categories (name)
-VALUES ($1)
+INSERT INTO product_categories (name, parent_id, default_markup_rate, default_warranty_period)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetProductCategoryByID :one
@@ -19,10 +19,22 @@ ORDER BY id;
 
 -- name: UpdateProductCategory :one
 UPDATE product_categories
-SET name = $2
+SET
+  name                    = $2,
+  parent_id               = $3,
+  default_markup_rate     = $4,
+  default_warranty_period = $5
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteProductCategory :exec
 DELETE FROM product_categories
 WHERE id = $1;
+
+-- name: CountProductsInCategory :one
+SELECT count(*) FROM products
+WHERE category_id = $1;
+
+-- name: CountChildCategories :one
+SELECT count(*) FROM product_categories
+WHERE parent_id = $1;
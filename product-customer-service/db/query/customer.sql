@@ -11,12 +11,58 @@ SELECT * FROM customers
 WHERE id = $1;
 
 -- name: GetCustomerByPhone :one
+-- Matches any format of the number, e.g. +84912345678 finds 0912345678.
 SELECT * FROM customers
-WHERE phone = $1;
+WHERE f_normalize_phone(phone) = f_normalize_phone(sqlc.arg('phone')::text)
+ORDER BY id
+LIMIT 1;
+
+-- name: GetCustomerByUUID :one
+SELECT * FROM customers
+WHERE uuid = $1;
 
 -- name: ListCustomers :many
+-- Search matches name/email without diacritics, or part of the phone number.
+-- A customer must carry all the given tags, occasion_month matches the
+-- birthday or the anniversary.
 SELECT * FROM customers
-ORDER BY id LIMIT $1 OFFSET $2;
+WHERE (
+  sqlc.narg('search')::text IS NULL
+  OR f_unaccent(name || ' ' || coalesce(email, '')) LIKE '%' || f_unaccent(sqlc.narg('search')) || '%'
+  OR (
+    f_normalize_phone(sqlc.narg('search')) <> ''
+    AND f_normalize_phone(phone) LIKE '%' || f_normalize_phone(sqlc.narg('search')) || '%'
+  )
+)
+AND tags @> sqlc.arg('tags')::text[]
+AND (sqlc.narg('preferred_gold_type')::int IS NULL OR preferred_gold_type = sqlc.narg('preferred_gold_type'))
+AND (sqlc.narg('ring_size')::text IS NULL OR ring_size = sqlc.narg('ring_size'))
+AND (
+  sqlc.narg('occasion_month')::int IS NULL
+  OR EXTRACT(MONTH FROM birthday) = sqlc.narg('occasion_month')
+  OR EXTRACT(MONTH FROM anniversary) = sqlc.narg('occasion_month')
+)
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountCustomers :one
+SELECT COUNT(*) FROM customers
+WHERE (
+  sqlc.narg('search')::text IS NULL
+  OR f_unaccent(name || ' ' || coalesce(email, '')) LIKE '%' || f_unaccent(sqlc.narg('search')) || '%'
+  OR (
+    f_normalize_phone(sqlc.narg('search')) <> ''
+    AND f_normalize_phone(phone) LIKE '%' || f_normalize_phone(sqlc.narg('search')) || '%'
+  )
+)
+AND tags @> sqlc.arg('tags')::text[]
+AND (sqlc.narg('preferred_gold_type')::int IS NULL OR preferred_gold_type = sqlc.narg('preferred_gold_type'))
+AND (sqlc.narg('ring_size')::text IS NULL OR ring_size = sqlc.narg('ring_size'))
+AND (
+  sqlc.narg('occasion_month')::int IS NULL
+  OR EXTRACT(MONTH FROM birthday) = sqlc.narg('occasion_month')
+  OR EXTRACT(MONTH FROM anniversary) = sqlc.narg('occasion_month')
+);
 
 -- name: UpdateCustomer :one
 UPDATE customers
@@ -32,3 +78,44 @@ RETURNING *;
 -- name: DeleteCustomer :exec
 DELETE FROM customers
 WHERE id = $1;
+
+-- name: UpdateCustomerAttributes :one
+UPDATE customers
+SET
+    birthday = $2,
+    anniversary = $3,
+    ring_size = $4,
+    preferred_gold_type = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetCustomerTags :one
+UPDATE customers
+SET
+    tags = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: AnonymizeCustomer :one
+-- Keeps the row so that financial records still point to a customer.
+UPDATE customers
+SET
+    name = $2,
+    phone = NULL,
+    email = NULL,
+    address = NULL,
+    birthday = NULL,
+    anniversary = NULL,
+    ring_size = NULL,
+    preferred_gold_type = NULL,
+    tags = '{}',
+    erased_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListCustomersByIDs :many
+SELECT * FROM customers
+WHERE id = ANY(sqlc.arg('ids')::int[]);
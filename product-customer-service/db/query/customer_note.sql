@@ -0,0 +1,34 @@
+-- name: CreateCustomerNote :one
+INSERT INTO customer_notes (
+    customer_id, note, created_by
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetCustomerNote :one
+SELECT * FROM customer_notes
+WHERE id = $1;
+
+-- name: ListCustomerNotes :many
+SELECT * FROM customer_notes
+WHERE customer_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountCustomerNotes :one
+SELECT COUNT(*) FROM customer_notes
+WHERE customer_id = $1;
+
+-- name: DeleteCustomerNote :exec
+DELETE FROM customer_notes
+WHERE id = $1;
+
+-- name: MoveCustomerNotes :execrows
+UPDATE customer_notes
+SET customer_id = sqlc.arg('to_customer_id')
+WHERE customer_id = sqlc.arg('from_customer_id');
+
+-- name: DeleteCustomerNotes :execrows
+DELETE FROM customer_notes
+WHERE customer_id = $1;
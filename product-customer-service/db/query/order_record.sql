@@ -1,14 +1,15 @@
 -- name: CreateOrderRecord :one
 INSERT INTO order_record (
-  customer_id, product_id, order_id, quantity, status
+  customer_id, product_id, order_id, quantity, status, serial_numbers, unit_price
 ) VALUES (
-  $1, $2, $3, $4, COALESCE($5, 'pending')
+  $1, $2, $3, $4, COALESCE($5, 'pending'), $6, $7
 )
 ON CONFLICT (customer_id, product_id, order_id)
 DO UPDATE SET
-  quantity   = order_record.quantity + EXCLUDED.quantity,
-  status     = COALESCE(EXCLUDED.status, order_record.status),
-  updated_at = NOW()
+  quantity       = order_record.quantity + EXCLUDED.quantity,
+  status         = COALESCE(EXCLUDED.status, order_record.status),
+  serial_numbers = order_record.serial_numbers || EXCLUDED.serial_numbers,
+  updated_at     = NOW()
 RETURNING *;
 
 
@@ -41,3 +42,64 @@ WHERE customer_id = $1
   AND product_id  = $2
   AND order_id    = $3
 RETURNING *;
+
+-- name: GetOrderRecordByOrderAndProduct :one
+SELECT *
+FROM order_record
+WHERE order_id = $1
+  AND product_id = $2
+LIMIT 1;
+
+-- name: MoveOrderRecords :execrows
+-- Re-points the orders of a merged customer.
+UPDATE order_record
+SET
+  customer_id = sqlc.arg('to_customer_id'),
+  updated_at  = NOW()
+WHERE customer_id = sqlc.arg('from_customer_id');
+
+-- name: GetCustomerPurchaseStats :one
+SELECT
+  COUNT(DISTINCT order_id)::int AS order_count,
+  COALESCE(SUM(quantity), 0)::int AS piece_count,
+  COALESCE(SUM(quantity * unit_price), 0)::decimal AS lifetime_spend,
+  MAX(created_at)::timestamp AS last_visit,
+  COUNT(*) AS purchase_count,
+  COUNT(*) FILTER (WHERE unit_price IS NULL)::int AS unpriced_count
+FROM order_record
+WHERE customer_id = $1;
+
+-- name: ListCustomerFavouriteCategories :many
+SELECT
+  c.id AS category_id,
+  c.name AS category_name,
+  SUM(r.quantity)::int AS piece_count,
+  COALESCE(SUM(r.quantity * r.unit_price), 0)::decimal AS spend
+FROM order_record r
+JOIN products p ON p.id = r.product_id
+JOIN product_categories c ON c.id = p.category_id
+WHERE r.customer_id = $1
+GROUP BY c.id, c.name
+ORDER BY piece_count DESC, spend DESC
+LIMIT $2;
+
+-- name: ListCustomerPurchases :many
+SELECT
+  r.order_id,
+  r.product_id,
+  p.code,
+  p.name,
+  p.image,
+  p.category_id,
+  p.gold_type,
+  p.weight,
+  r.quantity,
+  r.unit_price,
+  r.serial_numbers,
+  r.status,
+  r.created_at
+FROM order_record r
+JOIN products p ON p.id = r.product_id
+WHERE r.customer_id = $1
+ORDER BY r.created_at DESC, r.order_id DESC
+LIMIT $2 OFFSET $3;
@@ -0,0 +1,59 @@
+-- name: CreatePrivacyRequest :one
+INSERT INTO privacy_requests (
+    kind, customer_id, requested_by, payload
+) VALUES (
+    $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: GetPrivacyRequest :one
+SELECT * FROM privacy_requests
+WHERE id = $1;
+
+-- name: GetPrivacyRequestForUpdate :one
+SELECT * FROM privacy_requests
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetLatestPrivacyRequestForUpdate :one
+SELECT * FROM privacy_requests
+WHERE customer_id = $1 AND kind = $2
+ORDER BY id DESC
+LIMIT 1
+FOR UPDATE;
+
+-- name: SetPrivacyRequestStatus :one
+-- The payload is only kept while the request may still be sent again.
+UPDATE privacy_requests
+SET
+    status = $2,
+    completed_at = CASE WHEN $2 = 'pending' THEN NULL ELSE NOW() END,
+    payload = CASE WHEN $2 = 'completed' THEN NULL ELSE payload END
+WHERE id = $1
+RETURNING *;
+
+-- name: UpsertPrivacyRequestAck :one
+-- A service may answer again after a redelivery, the last answer wins.
+INSERT INTO privacy_request_acks (
+    request_id, service, success, error, data, records
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (request_id, service)
+DO UPDATE SET
+    success         = EXCLUDED.success,
+    error           = EXCLUDED.error,
+    data            = EXCLUDED.data,
+    records         = EXCLUDED.records,
+    acknowledged_at = NOW()
+RETURNING *;
+
+-- name: ListPrivacyRequestAcks :many
+SELECT * FROM privacy_request_acks
+WHERE request_id = $1
+ORDER BY service;
+
+-- name: DeleteFailedPrivacyRequestAcks :exec
+-- Services that failed answer again when the request is sent again.
+DELETE FROM privacy_request_acks
+WHERE request_id = $1 AND NOT success;
@@ -1,5 +1,93 @@
 -- name: ListProducts :many
-SELECT * FROM products ORDER BY id LIMIT $1 OFFSET $2;
+-- Top level products only, variants are loaded under their parent.
+-- Text search matches name/code without diacritics, by substring or trigram similarity.
+-- Archived products are hidden unless asked for.
+-- Stone filters match the stones of the product or of any of its variants.
+SELECT * FROM products
+WHERE parent_id IS NULL
+AND (sqlc.narg('category_id')::int IS NULL OR category_id = sqlc.narg('category_id'))
+AND (sqlc.narg('gold_type')::int IS NULL OR gold_type = sqlc.narg('gold_type'))
+AND (sqlc.narg('min_price')::decimal IS NULL OR selling_price >= sqlc.narg('min_price'))
+AND (sqlc.narg('max_price')::decimal IS NULL OR selling_price <= sqlc.narg('max_price'))
+AND (sqlc.narg('min_weight')::decimal IS NULL OR weight >= sqlc.narg('min_weight'))
+AND (sqlc.narg('max_weight')::decimal IS NULL OR weight <= sqlc.narg('max_weight'))
+AND (
+  NOT sqlc.arg('in_stock')::bool
+  OR stock > 0
+  OR EXISTS (SELECT 1 FROM products v WHERE v.parent_id = products.id AND v.stock > 0)
+)
+AND (
+  sqlc.narg('search')::text IS NULL
+  OR f_unaccent(coalesce(name, '') || ' ' || code) LIKE '%' || f_unaccent(sqlc.narg('search')) || '%'
+  OR f_unaccent(sqlc.narg('search')) <% f_unaccent(coalesce(name, '') || ' ' || code)
+)
+AND (
+  CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status')
+  ELSE sqlc.arg('include_archived')::bool OR status <> 'archived' END
+)
+AND (
+  NOT sqlc.arg('filter_stones')::bool
+  OR EXISTS (
+    SELECT 1 FROM product_stones s
+    WHERE (s.product_id = products.id
+      OR s.product_id IN (SELECT v.id FROM products v WHERE v.parent_id = products.id))
+      AND (sqlc.narg('stone_type')::text IS NULL OR s.stone_type = sqlc.narg('stone_type'))
+      AND (sqlc.narg('min_carat')::decimal IS NULL OR s.carat >= sqlc.narg('min_carat'))
+      AND (sqlc.narg('max_carat')::decimal IS NULL OR s.carat <= sqlc.narg('max_carat'))
+      AND (sqlc.narg('stone_color')::text IS NULL OR s.color = sqlc.narg('stone_color'))
+      AND (sqlc.narg('stone_clarity')::text IS NULL OR s.clarity = sqlc.narg('stone_clarity'))
+      AND (sqlc.narg('certificate_lab')::text IS NULL OR s.certificate_lab = sqlc.narg('certificate_lab'))
+  )
+)
+ORDER BY
+  CASE WHEN sqlc.arg('sort')::text = 'price_asc' THEN selling_price END ASC NULLS LAST,
+  CASE WHEN sqlc.arg('sort')::text = 'price_desc' THEN selling_price END DESC NULLS LAST,
+  CASE WHEN sqlc.arg('sort')::text = 'newest' THEN created_at END DESC NULLS LAST,
+  CASE WHEN sqlc.arg('sort')::text = 'best_selling' THEN buy_turn END DESC NULLS LAST,
+  CASE WHEN sqlc.narg('search')::text IS NOT NULL
+    THEN word_similarity(f_unaccent(sqlc.narg('search')), f_unaccent(coalesce(name, '') || ' ' || code))
+  END DESC,
+  id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountProducts :one
+-- Same filters as ListProducts.
+SELECT count(*) FROM products
+WHERE parent_id IS NULL
+AND (sqlc.narg('category_id')::int IS NULL OR category_id = sqlc.narg('category_id'))
+AND (sqlc.narg('gold_type')::int IS NULL OR gold_type = sqlc.narg('gold_type'))
+AND (sqlc.narg('min_price')::decimal IS NULL OR selling_price >= sqlc.narg('min_price'))
+AND (sqlc.narg('max_price')::decimal IS NULL OR selling_price <= sqlc.narg('max_price'))
+AND (sqlc.narg('min_weight')::decimal IS NULL OR weight >= sqlc.narg('min_weight'))
+AND (sqlc.narg('max_weight')::decimal IS NULL OR weight <= sqlc.narg('max_weight'))
+AND (
+  NOT sqlc.arg('in_stock')::bool
+  OR stock > 0
+  OR EXISTS (SELECT 1 FROM products v WHERE v.parent_id = products.id AND v.stock > 0)
+)
+AND (
+  sqlc.narg('search')::text IS NULL
+  OR f_unaccent(coalesce(name, '') || ' ' || code) LIKE '%' || f_unaccent(sqlc.narg('search')) || '%'
+  OR f_unaccent(sqlc.narg('search')) <% f_unaccent(coalesce(name, '') || ' ' || code)
+)
+AND (
+  CASE WHEN sqlc.narg('status')::text IS NOT NULL THEN status = sqlc.narg('status')
+  ELSE sqlc.arg('include_archived')::bool OR status <> 'archived' END
+)
+AND (
+  NOT sqlc.arg('filter_stones')::bool
+  OR EXISTS (
+    SELECT 1 FROM product_stones s
+    WHERE (s.product_id = products.id
+      OR s.product_id IN (SELECT v.id FROM products v WHERE v.parent_id = products.id))
+      AND (sqlc.narg('stone_type')::text IS NULL OR s.stone_type = sqlc.narg('stone_type'))
+      AND (sqlc.narg('min_carat')::decimal IS NULL OR s.carat >= sqlc.narg('min_carat'))
+      AND (sqlc.narg('max_carat')::decimal IS NULL OR s.carat <= sqlc.narg('max_carat'))
+      AND (sqlc.narg('stone_color')::text IS NULL OR s.color = sqlc.narg('stone_color'))
+      AND (sqlc.narg('stone_clarity')::text IS NULL OR s.clarity = sqlc.narg('stone_clarity'))
+      AND (sqlc.narg('certificate_lab')::text IS NULL OR s.certificate_lab = sqlc.narg('certificate_lab'))
+  )
+);
 
 -- name: GetProductByID :one
 SELECT * FROM products WHERE id = $1;
@@ -7,33 +95,156 @@ SELECT * FROM products WHERE id = $1;
 -- name: CreateProduct :one
 INSERT INTO products (
   name, code, category_id, weight, gold_price_at_time, labor_cost, stone_cost, stock,
-  markup_rate, selling_price, warranty_period, image, created_at, updated_at
+  markup_rate, selling_price, warranty_period, image, gold_type, parent_id, size,
+  reorder_point, reorder_quantity, alloy_id, created_at, updated_at
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW()
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NOW(), NOW()
 )
 RETURNING *;
 
 -- name: UpdateProductByCode :one
+-- NULL keeps the current value, clear_reorder_point removes the low stock alert
+-- and an alloy_id of 0 removes the alloy.
 UPDATE products
 SET
-  name              = COALESCE($1, name),
-  category_id       = COALESCE($2, category_id),
-  weight            = COALESCE($3, weight),
-  gold_price_at_time= COALESCE($4, gold_price_at_time),
-  labor_cost        = COALESCE($5, labor_cost),
-  stone_cost        = COALESCE($6, stone_cost),
-  buy_turn          = COALESCE($7, buy_turn),
-  markup_rate       = COALESCE($8, markup_rate),
-  selling_price     = COALESCE($9, selling_price),
-  warranty_period   = COALESCE($10, warranty_period),
-  image             = COALESCE($11, image),
-  stock             = COALESCE($12, stock),
+  name              = COALESCE(sqlc.narg('name'), name),
+  category_id       = COALESCE(sqlc.narg('category_id'), category_id),
+  weight            = COALESCE(sqlc.narg('weight'), weight),
+  gold_price_at_time= COALESCE(sqlc.narg('gold_price_at_time'), gold_price_at_time),
+  labor_cost        = COALESCE(sqlc.narg('labor_cost'), labor_cost),
+  stone_cost        = COALESCE(sqlc.narg('stone_cost'), stone_cost),
+  buy_turn          = COALESCE(sqlc.narg('buy_turn'), buy_turn),
+  markup_rate       = COALESCE(sqlc.narg('markup_rate'), markup_rate),
+  selling_price     = COALESCE(sqlc.narg('selling_price'), selling_price),
+  warranty_period   = COALESCE(sqlc.narg('warranty_period'), warranty_period),
+  image             = COALESCE(sqlc.narg('image'), image),
+  stock             = COALESCE(sqlc.narg('stock'), stock),
+  alloy_id          = CASE WHEN sqlc.narg('alloy_id')::int IS NULL THEN alloy_id
+                      ELSE NULLIF(sqlc.narg('alloy_id')::int, 0) END,
+  reorder_point     = CASE WHEN sqlc.arg('clear_reorder_point')::bool THEN NULL
+                      ELSE COALESCE(sqlc.narg('reorder_point')::int, reorder_point) END,
+  reorder_quantity  = CASE WHEN sqlc.arg('clear_reorder_point')::bool THEN NULL
+                      ELSE COALESCE(sqlc.narg('reorder_quantity')::int, reorder_quantity) END,
   updated_at        = NOW()
-WHERE code = $13
+WHERE code = sqlc.arg('code')
 RETURNING *;
 
 -- name: DeleteProduct :exec
-DELETE FROM products WHERE id = $1;
+-- Removes the product with its variants.
+DELETE FROM products WHERE id = $1 OR parent_id = $1;
+
+-- name: SetProductStatus :execrows
+-- Variants follow the status of their parent.
+UPDATE products
+SET
+  status      = sqlc.arg('status'),
+  archived_at = CASE WHEN sqlc.arg('status') = 'archived' THEN COALESCE(archived_at, NOW()) END,
+  updated_at  = NOW()
+WHERE id = sqlc.arg('id') OR parent_id = sqlc.arg('id');
+
+-- name: CountProductReferences :one
+-- History that prevents a hard delete of the products.
+SELECT
+  (SELECT count(*) FROM order_record o WHERE o.product_id = ANY(sqlc.arg('ids')::int[]))::int AS orders,
+  (SELECT count(*) FROM product_serials ps WHERE ps.product_id = ANY(sqlc.arg('ids')::int[]))::int AS serials,
+  (SELECT count(*) FROM stocktake_counts sc WHERE sc.product_id = ANY(sqlc.arg('ids')::int[]))::int AS stocktake_counts,
+  (SELECT count(*) FROM purchase_order_lines pol WHERE pol.product_id = ANY(sqlc.arg('ids')::int[]))::int AS purchase_order_lines,
+  (SELECT count(*) FROM goods_receipt_lines grl WHERE grl.product_id = ANY(sqlc.arg('ids')::int[]))::int AS goods_receipt_lines;
 
 -- name: GetProductsById :many 
-SELECT * FROM products WHERE id = ANY($1::int[]);
\ No newline at end of file
+SELECT * FROM products WHERE id = ANY($1::int[]);
+
+-- name: GetProductByCode :one
+SELECT * FROM products WHERE code = $1;
+
+
+-- name: ListProductsByCategory :many
+SELECT * FROM products WHERE category_id = $1 ORDER BY id;
+
+-- name: ListProductVariantsByParentIDs :many
+SELECT * FROM products
+WHERE parent_id = ANY($1::int[])
+ORDER BY parent_id, size, id;
+
+-- name: SyncProductVariants :exec
+UPDATE products
+SET
+  name        = $2,
+  category_id = $3,
+  image       = $4,
+  labor_cost  = $5,
+  stone_cost  = $6,
+  markup_rate = $7,
+  gold_type   = $8,
+  alloy_id    = $9,
+  updated_at  = NOW()
+WHERE parent_id = $1;
+
+-- name: SetProductPrice :one
+-- Reprices the product off the given gold price.
+UPDATE products
+SET
+  gold_price_at_time = sqlc.arg('gold_price_at_time'),
+  selling_price      = sqlc.arg('selling_price'),
+  updated_at         = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: GetProductsByCodes :many
+SELECT * FROM products
+WHERE code = ANY($1::text[]);
+
+-- name: ListProductsForExport :many
+-- Variants are listed right after their parent, archived products are left out.
+SELECT * FROM products
+WHERE status <> 'archived'
+AND (sqlc.narg('category_id')::int IS NULL OR category_id = sqlc.narg('category_id'))
+ORDER BY COALESCE(parent_id, id), id;
+
+-- name: SetProductImageUrl :exec
+-- Variants share the image of their parent.
+UPDATE products
+SET image = $2, updated_at = NOW()
+WHERE id = $1 OR parent_id = $1;
+
+-- name: ListLowStockProducts :many
+-- Sellable products at or below their reorder point, emptiest first.
+SELECT * FROM products
+WHERE reorder_point IS NOT NULL
+  AND COALESCE(stock, 0) <= reorder_point
+  AND status = 'active'
+  AND (sqlc.narg('category_id')::int IS NULL OR category_id = sqlc.narg('category_id'))
+ORDER BY COALESCE(stock, 0) - reorder_point, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountLowStockProducts :one
+SELECT count(*) FROM products
+WHERE reorder_point IS NOT NULL
+  AND COALESCE(stock, 0) <= reorder_point
+  AND status = 'active'
+  AND (sqlc.narg('category_id')::int IS NULL OR category_id = sqlc.narg('category_id'));
+
+-- name: GetProductForUpdate :one
+SELECT * FROM products WHERE id = $1 FOR UPDATE;
+
+-- name: SellProductStock :one
+-- No row when the product holds less than the quantity sold.
+UPDATE products
+SET
+  stock      = stock - sqlc.arg('quantity')::int,
+  buy_turn   = COALESCE(buy_turn, 0) + 1,
+  updated_at = NOW()
+WHERE id = sqlc.arg('id') AND stock >= sqlc.arg('quantity')::int
+RETURNING *;
+
+-- name: ReceiveProductStock :one
+-- Goods receipt: adds the stock and stores the averaged landed cost.
+UPDATE products
+SET
+  stock              = COALESCE(stock, 0) + sqlc.arg('quantity')::int,
+  gold_price_at_time = sqlc.arg('gold_price_at_time'),
+  labor_cost         = sqlc.arg('labor_cost'),
+  selling_price      = sqlc.arg('selling_price'),
+  updated_at         = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING *;
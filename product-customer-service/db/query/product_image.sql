@@ -0,0 +1,56 @@
+-- name: CreateProductImage :one
+INSERT INTO product_images (
+  product_id, file_id, original_filename, source_format, width, height, sort_order, is_primary, created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, NOW()
+)
+RETURNING *;
+
+-- name: CreateProductImageRendition :one
+INSERT INTO product_image_renditions (
+  image_id, size, format, width, height, storage_key, url
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetNextProductImageSortOrder :one
+SELECT COALESCE(MAX(sort_order) + 1, 0)::int AS next_sort_order
+FROM product_images
+WHERE product_id = $1;
+
+-- name: ListProductImages :many
+SELECT * FROM product_images
+WHERE product_id = $1
+ORDER BY sort_order, id;
+
+-- name: ListProductImageRenditions :many
+SELECT * FROM product_image_renditions
+WHERE image_id = ANY($1::int[])
+ORDER BY image_id, id;
+
+-- name: ClearPrimaryProductImage :exec
+UPDATE product_images
+SET is_primary = false
+WHERE product_id = $1 AND is_primary;
+
+-- name: UpdateProductImagePosition :execrows
+-- Attaches a free image or moves an image of the same product.
+UPDATE product_images
+SET
+  product_id = $2,
+  sort_order = $3,
+  is_primary = $4
+WHERE id = $1 AND (product_id IS NULL OR product_id = $2);
+
+-- name: DeleteProductImagesExcept :many
+-- Removes the images left out of the gallery, returns their renditions so the
+-- stored files can be deleted too.
+WITH deleted AS (
+  DELETE FROM product_images
+  WHERE product_id = sqlc.arg('product_id') AND NOT (id = ANY(sqlc.arg('keep_ids')::int[]))
+  RETURNING id
+)
+SELECT r.* FROM product_image_renditions r
+JOIN deleted d ON d.id = r.image_id
+ORDER BY r.id;
@@ -0,0 +1,40 @@
+-- name: CreateProductSerial :one
+INSERT INTO product_serials (
+  serial_number, product_id, weight, stone_details, certificate_number, status, created_at, updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, 'in_stock', NOW(), NOW()
+)
+RETURNING *;
+
+-- name: GetProductSerialByNumber :one
+SELECT * FROM product_serials WHERE serial_number = $1;
+
+-- name: ListProductSerials :many
+SELECT * FROM product_serials WHERE product_id = $1 ORDER BY id;
+
+-- name: CountAvailableProductSerials :one
+SELECT COUNT(*) FROM product_serials WHERE product_id = $1 AND status = 'in_stock';
+
+-- name: MarkProductSerialSold :one
+UPDATE product_serials
+SET status = 'sold', order_id = $2, updated_at = NOW()
+WHERE serial_number = $1 AND product_id = $3 AND status = 'in_stock'
+RETURNING *;
+
+-- name: MarkProductSerialBoughtBack :one
+-- No row when the piece was not sold or is already bought back.
+UPDATE product_serials
+SET status = 'bought_back', updated_at = NOW()
+WHERE serial_number = $1 AND status = 'sold'
+RETURNING *;
+
+-- name: CreateProductSerialEvent :one
+INSERT INTO product_serial_events (
+  serial_id, event_type, reference_id, note, created_by, created_at
+) VALUES (
+  $1, $2, $3, $4, $5, NOW()
+)
+RETURNING *;
+
+-- name: ListProductSerialEvents :many
+SELECT * FROM product_serial_events WHERE serial_id = $1 ORDER BY id;
@@ -0,0 +1,15 @@
+-- name: CreateProductStone :one
+INSERT INTO product_stones (
+  product_id, stone_type, carat, cut, color, clarity, stone_count, certificate_lab, certificate_number, created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, NOW()
+)
+RETURNING *;
+
+-- name: ListProductStonesByProductIDs :many
+SELECT * FROM product_stones
+WHERE product_id = ANY($1::int[])
+ORDER BY product_id, id;
+
+-- name: DeleteProductStones :exec
+DELETE FROM product_stones WHERE product_id = $1;
@@ -0,0 +1,76 @@
+-- name: CreatePurchaseOrder :one
+INSERT INTO purchase_orders (
+  supplier_id, note, expected_at, created_by, status, created_at, updated_at
+) VALUES (
+  $1, $2, $3, $4, 'open', NOW(), NOW()
+)
+RETURNING *;
+
+-- name: GetPurchaseOrder :one
+SELECT * FROM purchase_orders WHERE id = $1;
+
+-- name: GetPurchaseOrderForUpdate :one
+SELECT * FROM purchase_orders WHERE id = $1 FOR UPDATE;
+
+-- name: ListPurchaseOrders :many
+SELECT * FROM purchase_orders
+WHERE (sqlc.narg('supplier_id')::int IS NULL OR supplier_id = sqlc.narg('supplier_id'))
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountPurchaseOrders :one
+SELECT count(*) FROM purchase_orders
+WHERE (sqlc.narg('supplier_id')::int IS NULL OR supplier_id = sqlc.narg('supplier_id'))
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'));
+
+-- name: UpdatePurchaseOrderStatus :one
+UPDATE purchase_orders
+SET status = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePurchaseOrderLine :one
+INSERT INTO purchase_order_lines (
+  purchase_order_id, product_id, quantity, gold_cost_per_gram, labor_cost
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListPurchaseOrderLines :many
+SELECT * FROM purchase_order_lines
+WHERE purchase_order_id = $1
+ORDER BY id;
+
+-- name: AddPurchaseOrderLineReceived :one
+UPDATE purchase_order_lines
+SET received_quantity = received_quantity + sqlc.arg('quantity')::int
+WHERE id = sqlc.arg('id') AND received_quantity + sqlc.arg('quantity')::int <= quantity
+RETURNING *;
+
+-- name: CreateGoodsReceipt :one
+INSERT INTO goods_receipts (
+  purchase_order_id, extra_cost, note, received_by, received_at
+) VALUES (
+  $1, $2, $3, $4, NOW()
+)
+RETURNING *;
+
+-- name: CreateGoodsReceiptLine :one
+INSERT INTO goods_receipt_lines (
+  receipt_id, purchase_order_line_id, product_id, quantity, landed_cost
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: ListGoodsReceipts :many
+SELECT * FROM goods_receipts
+WHERE purchase_order_id = $1
+ORDER BY id;
+
+-- name: ListGoodsReceiptLines :many
+SELECT * FROM goods_receipt_lines
+WHERE receipt_id = ANY($1::int[])
+ORDER BY receipt_id, id;
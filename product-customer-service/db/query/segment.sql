@@ -0,0 +1,109 @@
+-- name: CreateSegment :one
+INSERT INTO customer_segments (
+    name, description, rules, voucher_id, created_by
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: GetSegment :one
+SELECT * FROM customer_segments
+WHERE id = $1;
+
+-- name: GetSegmentForUpdate :one
+SELECT * FROM customer_segments
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListSegments :many
+SELECT * FROM customer_segments
+ORDER BY id;
+
+-- name: EvaluateSegment :many
+-- Spend and categories count the purchases of the last window_days, the
+-- last visit looks at the whole history. Categories include their sub
+-- categories.
+WITH RECURSIVE segment_categories AS (
+    SELECT id FROM product_categories
+    WHERE id = ANY(sqlc.arg('category_ids')::int[])
+    UNION
+    SELECT pc.id FROM product_categories pc
+    JOIN segment_categories sc ON pc.parent_id = sc.id
+)
+SELECT
+    c.id,
+    c.uuid,
+    s.spend::decimal AS spend,
+    s.last_visit::timestamp AS last_visit
+FROM customers c
+CROSS JOIN LATERAL (
+    SELECT
+        COALESCE(SUM(r.quantity * r.unit_price) FILTER (
+            WHERE sqlc.narg('window_days')::int IS NULL OR r.created_at >= NOW() - make_interval(days => sqlc.narg('window_days'))
+        ), 0) AS spend,
+        MAX(r.created_at) AS last_visit
+    FROM order_record r
+    WHERE r.customer_id = c.uuid
+) s
+WHERE c.erased_at IS NULL
+AND c.tags @> sqlc.arg('tags')::text[]
+AND (sqlc.narg('min_spend')::decimal IS NULL OR s.spend >= sqlc.narg('min_spend'))
+AND (sqlc.narg('max_spend')::decimal IS NULL OR s.spend <= sqlc.narg('max_spend'))
+AND (sqlc.narg('visited_within_days')::int IS NULL OR s.last_visit >= NOW() - make_interval(days => sqlc.narg('visited_within_days')))
+AND (
+    sqlc.narg('not_visited_for_days')::int IS NULL
+    OR s.last_visit IS NULL
+    OR s.last_visit < NOW() - make_interval(days => sqlc.narg('not_visited_for_days'))
+)
+AND (
+    cardinality(sqlc.arg('category_ids')::int[]) = 0
+    OR EXISTS (
+        SELECT 1 FROM order_record r
+        JOIN products p ON p.id = r.product_id
+        WHERE r.customer_id = c.uuid
+        AND p.category_id IN (SELECT id FROM segment_categories)
+        AND (sqlc.narg('window_days')::int IS NULL OR r.created_at >= NOW() - make_interval(days => sqlc.narg('window_days')))
+    )
+)
+ORDER BY s.spend DESC, c.id;
+
+-- name: ListSegmentMemberIDs :many
+SELECT customer_id FROM customer_segment_members
+WHERE segment_id = $1;
+
+-- name: UpsertSegmentMembers :exec
+INSERT INTO customer_segment_members (
+    segment_id, customer_id, spend, last_visit
+)
+SELECT
+    sqlc.arg('segment_id'),
+    unnest(sqlc.arg('customer_ids')::int[]),
+    unnest(sqlc.arg('spends')::decimal[]),
+    unnest(sqlc.arg('last_visits')::timestamp[])
+ON CONFLICT (segment_id, customer_id)
+DO UPDATE SET
+    spend      = EXCLUDED.spend,
+    last_visit = EXCLUDED.last_visit;
+
+-- name: DeleteSegmentMembers :many
+-- Removes the members left out of keep_ids and returns their uuid.
+DELETE FROM customer_segment_members m
+USING customers c
+WHERE c.id = m.customer_id
+AND m.segment_id = sqlc.arg('segment_id')
+AND NOT (m.customer_id = ANY(sqlc.arg('keep_ids')::int[]))
+RETURNING c.uuid;
+
+-- name: SetSegmentComputed :one
+UPDATE customer_segments
+SET
+    member_count = $2,
+    computed_at  = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListSegmentMembers :many
+SELECT * FROM customer_segment_members
+WHERE segment_id = $1
+ORDER BY spend DESC, customer_id
+LIMIT $2 OFFSET $3;
@@ -0,0 +1,22 @@
+-- name: CreateStockMovement :one
+INSERT INTO stock_movements (
+  product_id, quantity, movement_type, reference_id, note, created_by, created_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, NOW()
+)
+RETURNING *;
+
+-- name: GetProductLedgerBalance :one
+SELECT COALESCE(SUM(quantity), 0)::int AS balance
+FROM stock_movements
+WHERE product_id = $1;
+
+-- name: ListStockMovementsByProduct :many
+SELECT * FROM stock_movements
+WHERE product_id = $1
+ORDER BY id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: DeleteStockMovementsByProducts :exec
+DELETE FROM stock_movements
+WHERE product_id = ANY($1::int[]);
@@ -0,0 +1,60 @@
+-- name: CreateStocktakeSession :one
+INSERT INTO stocktake_sessions (
+  category_id, note, opened_by, status, created_at
+) VALUES (
+  $1, $2, $3, 'open', NOW()
+)
+RETURNING *;
+
+-- name: GetStocktakeSession :one
+SELECT * FROM stocktake_sessions WHERE id = $1;
+
+-- name: SubmitStocktakeSession :one
+UPDATE stocktake_sessions
+SET status = 'submitted', submitted_at = NOW()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: ApproveStocktakeSession :one
+UPDATE stocktake_sessions
+SET status = 'approved', approved_by = $2, approved_at = NOW()
+WHERE id = $1 AND status = 'submitted'
+RETURNING *;
+
+-- name: RejectStocktakeSession :one
+UPDATE stocktake_sessions
+SET status = 'rejected', approved_by = $2, approved_at = NOW()
+WHERE id = $1 AND status = 'submitted'
+RETURNING *;
+
+-- name: AddStocktakeCount :one
+INSERT INTO stocktake_counts (
+  session_id, product_id, counted_quantity, counted_by, updated_at
+) VALUES (
+  $1, $2, $3, $4, NOW()
+)
+ON CONFLICT (session_id, product_id) DO UPDATE
+SET counted_quantity = stocktake_counts.counted_quantity + EXCLUDED.counted_quantity,
+    counted_by       = EXCLUDED.counted_by,
+    updated_at       = NOW()
+RETURNING *;
+
+-- name: ListStocktakeVariances :many
+-- Only counted products are reconciled, anything nobody scanned keeps its stock.
+SELECT
+  p.id AS product_id,
+  p.code,
+  p.name,
+  c.counted_quantity,
+  COALESCE(m.balance, 0)::int AS expected_quantity
+FROM stocktake_counts c
+JOIN products p ON p.id = c.product_id
+LEFT JOIN (
+  SELECT product_id, SUM(quantity) AS balance
+  FROM stock_movements
+  GROUP BY product_id
+) m ON m.product_id = p.id
+WHERE c.session_id = $1
+  AND p.status <> 'archived'
+  AND NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id)
+ORDER BY p.id;
@@ -0,0 +1,34 @@
+-- name: CreateSupplier :one
+INSERT INTO suppliers (
+  name, phone, email, address, tax_code, note, created_at, updated_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, NOW(), NOW()
+)
+RETURNING *;
+
+-- name: GetSupplierByID :one
+SELECT * FROM suppliers WHERE id = $1;
+
+-- name: ListSuppliers :many
+SELECT * FROM suppliers
+WHERE (NOT sqlc.arg('active_only')::bool OR is_active)
+ORDER BY name, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSuppliers :one
+SELECT count(*) FROM suppliers
+WHERE (NOT sqlc.arg('active_only')::bool OR is_active);
+
+-- name: UpdateSupplier :one
+UPDATE suppliers
+SET
+  name       = $2,
+  phone      = $3,
+  email      = $4,
+  address    = $5,
+  tax_code   = $6,
+  note       = $7,
+  is_active  = $8,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
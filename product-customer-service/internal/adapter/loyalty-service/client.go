@@ -0,0 +1,74 @@
+package loyalty_service
+
+import (
+	"context"
+
+	"github.com/linhhuynhcoding/jss-microservices/product/config"
+	"github.com/linhhuynhcoding/jss-microservices/product/pkg/grpc"
+	"go.uber.org/zap"
+
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/loyalty"
+)
+
+type ILoyaltyServiceClient interface {
+	GetCustomerTotalPoints(ctx context.Context, req *api.GetCustomerTotalPointsRequest) (*api.GetCustomerTotalPointsResponse, error)
+	GetCustomersTotalPoints(ctx context.Context, req *api.GetCustomersTotalPointsRequest) (*api.GetCustomersTotalPointsResponse, error)
+}
+
+type LoyaltyServiceClient struct {
+	client api.LoyaltyClient
+	logger *zap.Logger
+	cfg    config.Config
+}
+
+func NewLoyaltyServiceClient(
+	logger *zap.Logger,
+	cfg config.Config,
+) ILoyaltyServiceClient {
+	return &LoyaltyServiceClient{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (l *LoyaltyServiceClient) Connect() error {
+	if l.client == nil {
+		conn, err := grpc.NewConnection(l.cfg.LoyaltyServiceUrl)
+		if err != nil {
+			l.logger.Error("failed to connect to loyalty service", zap.Error(err))
+			return err
+		}
+		l.client = api.NewLoyaltyClient(conn)
+	}
+	return nil
+}
+
+func (l *LoyaltyServiceClient) GetCustomerTotalPoints(ctx context.Context, req *api.GetCustomerTotalPointsRequest) (*api.GetCustomerTotalPointsResponse, error) {
+	log := l.logger.With(zap.String("func", "GetCustomerTotalPoints"))
+	if err := l.Connect(); err != nil {
+		log.Error("failed to connect to loyalty service", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := l.client.GetCustomerTotalPoints(ctx, req)
+	if err != nil {
+		log.Error("failed to get customer total points", zap.Error(err))
+		return nil, err
+	}
+	return resp, err
+}
+
+func (l *LoyaltyServiceClient) GetCustomersTotalPoints(ctx context.Context, req *api.GetCustomersTotalPointsRequest) (*api.GetCustomersTotalPointsResponse, error) {
+	log := l.logger.With(zap.String("func", "GetCustomersTotalPoints"))
+	if err := l.Connect(); err != nil {
+		log.Error("failed to connect to loyalty service", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := l.client.GetCustomersTotalPoints(ctx, req)
+	if err != nil {
+		log.Error("failed to get customers total points", zap.Error(err))
+		return nil, err
+	}
+	return resp, err
+}
@@ -12,6 +12,7 @@ import (
 
 type IMarketServiceClient interface {
 	GetGoldPrice(ctx context.Context, req *api.GetGoldPriceRequest) (*api.GetGoldPriceResponse, error)
+	GetAlloy(ctx context.Context, req *api.GetAlloyRequest) (*api.GetAlloyResponse, error)
 }
 
 type MarketServiceClient struct {
@@ -56,3 +57,18 @@ func (m *MarketServiceClient) GetGoldPrice(ctx context.Context, req *api.GetGold
 	}
 	return resp, err
 }
+
+func (m *MarketServiceClient) GetAlloy(ctx context.Context, req *api.GetAlloyRequest) (*api.GetAlloyResponse, error) {
+	log := m.logger.With(zap.String("func", "GetAlloy"))
+	if err := m.Connect(); err != nil {
+		log.Error("failed to connect to market service", zap.Error(err))
+		return nil, err
+	}
+
+	resp, err := m.client.GetAlloy(ctx, req)
+	if err != nil {
+		log.Error("failed to get alloy", zap.Error(err))
+		return nil, err
+	}
+	return resp, err
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+	"github.com/linhhuynhcoding/jss-microservices/product/config"
+	"go.uber.org/zap"
+)
+
+type CloudinaryStorage struct {
+	cld    *cloudinary.Cloudinary
+	logger *zap.Logger
+	cfg    config.Config
+}
+
+func NewCloudinaryStorage(logger *zap.Logger, cfg config.Config) (IStorage, error) {
+	cld, err := cloudinary.NewFromURL(cfg.CloudinaryConfig.ConnectString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to cloudinary: %v", err)
+	}
+
+	return &CloudinaryStorage{
+		cld:    cld,
+		logger: logger,
+		cfg:    cfg,
+	}, nil
+}
+
+func (c *CloudinaryStorage) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	resp, err := c.cld.Upload.Upload(ctx, bytes.NewReader(data), uploader.UploadParams{
+		Folder:         c.folder(),
+		PublicID:       publicID(key),
+		UniqueFilename: api.Bool(false),
+		Overwrite:      api.Bool(true),
+	})
+	if err != nil {
+		c.logger.Error("failed to upload image", zap.Error(err))
+		return "", fmt.Errorf("failed to upload image: %v", err)
+	}
+	if resp.Error.Message != "" {
+		return "", fmt.Errorf("failed to upload image: %s", resp.Error.Message)
+	}
+
+	return resp.SecureURL, nil
+}
+
+func (c *CloudinaryStorage) Delete(ctx context.Context, key string) error {
+	_, err := c.cld.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID: c.folder() + "/" + publicID(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete image: %v", err)
+	}
+	return nil
+}
+
+func (c *CloudinaryStorage) folder() string {
+	if c.cfg.CloudinaryConfig.UploadFolder != "" {
+		return c.cfg.CloudinaryConfig.UploadFolder
+	}
+	return "jss_folder"
+}
+
+// publicID drops the extension, cloudinary adds it from the detected format
+func publicID(key string) string {
+	return strings.TrimSuffix(key, path.Ext(key))
+}
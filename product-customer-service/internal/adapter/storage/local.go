@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/linhhuynhcoding/jss-microservices/product/config"
+	"go.uber.org/zap"
+)
+
+// LOCAL_FILE_ROUTE is where the gateway serves the files of the local backend
+const LOCAL_FILE_ROUTE = "/v1/files/"
+
+type LocalStorage struct {
+	logger *zap.Logger
+	cfg    config.Config
+}
+
+func NewLocalStorage(logger *zap.Logger, cfg config.Config) IStorage {
+	return &LocalStorage{
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+func (l *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("failed to create upload directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write file: %v", err)
+	}
+
+	return strings.TrimSuffix(l.cfg.StoragePublicUrl, "/") + LOCAL_FILE_ROUTE + key, nil
+}
+
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %v", err)
+	}
+	return nil
+}
+
+// path keeps the key inside the upload folder
+func (l *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("invalid file key %q", key)
+	}
+	return filepath.Join(l.cfg.UploadFolder, clean), nil
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/linhhuynhcoding/jss-microservices/product/config"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.uber.org/zap"
+)
+
+// S3Storage works with any S3 compatible server, MinIO in docker-compose
+type S3Storage struct {
+	client *minio.Client
+	logger *zap.Logger
+	cfg    config.Config
+
+	bucketOnce sync.Once
+	bucketErr  error
+}
+
+func NewS3Storage(logger *zap.Logger, cfg config.Config) (IStorage, error) {
+	if cfg.S3Config.Endpoint == "" || cfg.S3Config.Bucket == "" {
+		return nil, fmt.Errorf("S3_ENDPOINT and S3_BUCKET are required")
+	}
+	client, err := minio.New(cfg.S3Config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3Config.AccessKey, cfg.S3Config.SecretKey, ""),
+		Secure: cfg.S3Config.UseSSL,
+		Region: cfg.S3Config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %v", err)
+	}
+
+	return &S3Storage{
+		client: client,
+		logger: logger,
+		cfg:    cfg,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	if err := s.ensureBucket(ctx); err != nil {
+		return "", err
+	}
+
+	_, err := s.client.PutObject(ctx, s.cfg.S3Config.Bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		s.logger.Error("failed to put object", zap.String("key", key), zap.Error(err))
+		return "", fmt.Errorf("failed to put object: %v", err)
+	}
+
+	return s.url(key), nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.cfg.S3Config.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object: %v", err)
+	}
+	return nil
+}
+
+// ensureBucket creates the bucket on the first upload
+func (s *S3Storage) ensureBucket(ctx context.Context) error {
+	s.bucketOnce.Do(func() {
+		bucket := s.cfg.S3Config.Bucket
+		exists, err := s.client.BucketExists(ctx, bucket)
+		if err != nil {
+			s.bucketErr = fmt.Errorf("failed to check bucket: %v", err)
+			return
+		}
+		if exists {
+			return
+		}
+		err = s.client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: s.cfg.S3Config.Region})
+		if err != nil {
+			s.bucketErr = fmt.Errorf("failed to create bucket: %v", err)
+		}
+	})
+	return s.bucketErr
+}
+
+// url prefers STORAGE_PUBLIC_URL, e.g. a CDN in front of the bucket
+func (s *S3Storage) url(key string) string {
+	if s.cfg.StoragePublicUrl != "" {
+		return strings.TrimSuffix(s.cfg.StoragePublicUrl, "/") + "/" + key
+	}
+	return strings.TrimSuffix(s.client.EndpointURL().String(), "/") + "/" + s.cfg.S3Config.Bucket + "/" + key
+}
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linhhuynhcoding/jss-microservices/product/config"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	"go.uber.org/zap"
+)
+
+// IStorage stores uploaded files and returns the public url of the object
+type IStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStorage returns the backend selected by STORAGE_DRIVER, local disk by default
+func NewStorage(logger *zap.Logger, cfg config.Config) (IStorage, error) {
+	switch cfg.StorageDriver {
+	case "", consts.STORAGE_LOCAL:
+		return NewLocalStorage(logger, cfg), nil
+	case consts.STORAGE_S3:
+		return NewS3Storage(logger, cfg)
+	case consts.STORAGE_CLOUDINARY:
+		return NewCloudinaryStorage(logger, cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+	}
+}
@@ -7,18 +7,62 @@ package repository
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countChildCategories = `-- name: CountChildCategories :one
+SELECT count(*) FROM product_categories
+WHERE parent_id = $1
+`
+
+func (q *Queries) CountChildCategories(ctx context.Context, parentID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countChildCategories, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countProductsInCategory = `-- name: CountProductsInCategory :one
+SELECT count(*) FROM products
+WHERE category_id = $1
+`
+
+func (q *Queries) CountProductsInCategory(ctx context.Context, categoryID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countProductsInCategory, categoryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProductCategory = `-- name: CreateProductCategory :one
-INSERT INTO product_categories (name)
-VALUES ($1)
-RETURNING id, name
+INSERT INTO product_categories (name, parent_id, default_markup_rate, default_warranty_period)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, parent_id, default_markup_rate, default_warranty_period
 `
 
-func (q *Queries) CreateProductCategory(ctx context.Context, name string) (ProductCategory, error) {
-	row := q.db.QueryRow(ctx, createProductCategory, name)
+type CreateProductCategoryParams struct {
+	Name                  string         `json:"name"`
+	ParentID              pgtype.Int4    `json:"parent_id"`
+	DefaultMarkupRate     pgtype.Numeric `json:"default_markup_rate"`
+	DefaultWarrantyPeriod pgtype.Int4    `json:"default_warranty_period"`
+}
+
+func (q *Queries) CreateProductCategory(ctx context.Context, arg CreateProductCategoryParams) (ProductCategory, error) {
+	row := q.db.QueryRow(ctx, createProductCategory,
+		arg.Name,
+		arg.ParentID,
+		arg.DefaultMarkupRate,
+		arg.DefaultWarrantyPeriod,
+	)
 	var i ProductCategory
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ParentID,
+		&i.DefaultMarkupRate,
+		&i.DefaultWarrantyPeriod,
+	)
 	return i, err
 }
 
@@ -33,7 +77,7 @@ func (q *Queries) DeleteProductCategory(ctx context.Context, id int32) error {
 }
 
 const getProductCategoryByID = `-- name: GetProductCategoryByID :one
-SELECT id, name FROM product_categories
+SELECT id, name, parent_id, default_markup_rate, default_warranty_period FROM product_categories
 WHERE id = $1
 LIMIT 1
 `
@@ -41,12 +85,18 @@ LIMIT 1
 func (q *Queries) GetProductCategoryByID(ctx context.Context, id int32) (ProductCategory, error) {
 	row := q.db.QueryRow(ctx, getProductCategoryByID, id)
 	var i ProductCategory
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ParentID,
+		&i.DefaultMarkupRate,
+		&i.DefaultWarrantyPeriod,
+	)
 	return i, err
 }
 
 const getProductCategoryByName = `-- name: GetProductCategoryByName :one
-SELECT id, name FROM product_categories
+SELECT id, name, parent_id, default_markup_rate, default_warranty_period FROM product_categories
 WHERE name = $1
 LIMIT 1
 `
@@ -54,12 +104,18 @@ LIMIT 1
 func (q *Queries) GetProductCategoryByName(ctx context.Context, name string) (ProductCategory, error) {
 	row := q.db.QueryRow(ctx, getProductCategoryByName, name)
 	var i ProductCategory
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ParentID,
+		&i.DefaultMarkupRate,
+		&i.DefaultWarrantyPeriod,
+	)
 	return i, err
 }
 
 const listProductCategories = `-- name: ListProductCategories :many
-SELECT id, name FROM product_categories
+SELECT id, name, parent_id, default_markup_rate, default_warranty_period FROM product_categories
 ORDER BY id
 `
 
@@ -72,7 +128,13 @@ func (q *Queries) ListProductCategories(ctx context.Context) ([]ProductCategory,
 	items := []ProductCategory{}
 	for rows.Next() {
 		var i ProductCategory
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ParentID,
+			&i.DefaultMarkupRate,
+			&i.DefaultWarrantyPeriod,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -85,19 +147,38 @@ func (q *Queries) ListProductCategories(ctx context.Context) ([]ProductCategory,
 
 const updateProductCategory = `-- name: UpdateProductCategory :one
 UPDATE product_categories
-SET name = $2
+SET
+  name                    = $2,
+  parent_id               = $3,
+  default_markup_rate     = $4,
+  default_warranty_period = $5
 WHERE id = $1
-RETURNING id, name
+RETURNING id, name, parent_id, default_markup_rate, default_warranty_period
 `
 
 type UpdateProductCategoryParams struct {
-	ID   int32  `json:"id"`
-	Name string `json:"name"`
+	ID                    int32          `json:"id"`
+	Name                  string         `json:"name"`
+	ParentID              pgtype.Int4    `json:"parent_id"`
+	DefaultMarkupRate     pgtype.Numeric `json:"default_markup_rate"`
+	DefaultWarrantyPeriod pgtype.Int4    `json:"default_warranty_period"`
 }
 
 func (q *Queries) UpdateProductCategory(ctx context.Context, arg UpdateProductCategoryParams) (ProductCategory, error) {
-	row := q.db.QueryRow(ctx, updateProductCategory, arg.ID, arg.Name)
+	row := q.db.QueryRow(ctx, updateProductCategory,
+		arg.ID,
+		arg.Name,
+		arg.ParentID,
+		arg.DefaultMarkupRate,
+		arg.DefaultWarrantyPeriod,
+	)
 	var i ProductCategory
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ParentID,
+		&i.DefaultMarkupRate,
+		&i.DefaultWarrantyPeriod,
+	)
 	return i, err
 }
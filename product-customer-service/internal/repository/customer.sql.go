@@ -8,21 +8,109 @@ package repository
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeCustomer = `-- name: AnonymizeCustomer :one
+UPDATE customers
+SET
+    name = $2,
+    phone = NULL,
+    email = NULL,
+    address = NULL,
+    birthday = NULL,
+    anniversary = NULL,
+    ring_size = NULL,
+    preferred_gold_type = NULL,
+    tags = '{}',
+    erased_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid
+`
+
+type AnonymizeCustomerParams struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Keeps the row so that financial records still point to a customer.
+func (q *Queries) AnonymizeCustomer(ctx context.Context, arg AnonymizeCustomerParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, anonymizeCustomer, arg.ID, arg.Name)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Phone,
+		&i.Email,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
+	)
+	return i, err
+}
+
+const countCustomers = `-- name: CountCustomers :one
+SELECT COUNT(*) FROM customers
+WHERE (
+  $1::text IS NULL
+  OR f_unaccent(name || ' ' || coalesce(email, '')) LIKE '%' || f_unaccent($1) || '%'
+  OR (
+    f_normalize_phone($1) <> ''
+    AND f_normalize_phone(phone) LIKE '%' || f_normalize_phone($1) || '%'
+  )
+)
+AND tags @> $2::text[]
+AND ($3::int IS NULL OR preferred_gold_type = $3)
+AND ($4::text IS NULL OR ring_size = $4)
+AND (
+  $5::int IS NULL
+  OR EXTRACT(MONTH FROM birthday) = $5
+  OR EXTRACT(MONTH FROM anniversary) = $5
+)
+`
+
+type CountCustomersParams struct {
+	Search            pgtype.Text `json:"search"`
+	Tags              []string    `json:"tags"`
+	PreferredGoldType pgtype.Int4 `json:"preferred_gold_type"`
+	RingSize          pgtype.Text `json:"ring_size"`
+	OccasionMonth     pgtype.Int4 `json:"occasion_month"`
+}
+
+func (q *Queries) CountCustomers(ctx context.Context, arg CountCustomersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCustomers,
+		arg.Search,
+		arg.Tags,
+		arg.PreferredGoldType,
+		arg.RingSize,
+		arg.OccasionMonth,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createCustomer = `-- name: CreateCustomer :one
 INSERT INTO customers (
     name, phone, email, address, created_at, updated_at
 ) VALUES (
     $1, $2, $3, $4, NOW(), NOW()
 )
-RETURNING id, name, phone, email, address, created_at, updated_at
+RETURNING id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid
 `
 
 type CreateCustomerParams struct {
 	Name    string      `json:"name"`
-	Phone   string      `json:"phone"`
+	Phone   pgtype.Text `json:"phone"`
 	Email   pgtype.Text `json:"email"`
 	Address pgtype.Text `json:"address"`
 }
@@ -43,6 +131,13 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.Address,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
 	)
 	return i, err
 }
@@ -58,7 +153,7 @@ func (q *Queries) DeleteCustomer(ctx context.Context, id int32) error {
 }
 
 const getCustomerByID = `-- name: GetCustomerByID :one
-SELECT id, name, phone, email, address, created_at, updated_at FROM customers
+SELECT id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid FROM customers
 WHERE id = $1
 `
 
@@ -73,15 +168,25 @@ func (q *Queries) GetCustomerByID(ctx context.Context, id int32) (Customer, erro
 		&i.Address,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
 	)
 	return i, err
 }
 
 const getCustomerByPhone = `-- name: GetCustomerByPhone :one
-SELECT id, name, phone, email, address, created_at, updated_at FROM customers
-WHERE phone = $1
+SELECT id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid FROM customers
+WHERE f_normalize_phone(phone) = f_normalize_phone($1::text)
+ORDER BY id
+LIMIT 1
 `
 
+// Matches any format of the number, e.g. +84912345678 finds 0912345678.
 func (q *Queries) GetCustomerByPhone(ctx context.Context, phone string) (Customer, error) {
 	row := q.db.QueryRow(ctx, getCustomerByPhone, phone)
 	var i Customer
@@ -93,22 +198,89 @@ func (q *Queries) GetCustomerByPhone(ctx context.Context, phone string) (Custome
 		&i.Address,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
+	)
+	return i, err
+}
+
+const getCustomerByUUID = `-- name: GetCustomerByUUID :one
+SELECT id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid FROM customers
+WHERE uuid = $1
+`
+
+func (q *Queries) GetCustomerByUUID(ctx context.Context, uuid uuid.UUID) (Customer, error) {
+	row := q.db.QueryRow(ctx, getCustomerByUUID, uuid)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Phone,
+		&i.Email,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
 	)
 	return i, err
 }
 
 const listCustomers = `-- name: ListCustomers :many
-SELECT id, name, phone, email, address, created_at, updated_at FROM customers
-ORDER BY id LIMIT $1 OFFSET $2
+SELECT id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid FROM customers
+WHERE (
+  $1::text IS NULL
+  OR f_unaccent(name || ' ' || coalesce(email, '')) LIKE '%' || f_unaccent($1) || '%'
+  OR (
+    f_normalize_phone($1) <> ''
+    AND f_normalize_phone(phone) LIKE '%' || f_normalize_phone($1) || '%'
+  )
+)
+AND tags @> $2::text[]
+AND ($3::int IS NULL OR preferred_gold_type = $3)
+AND ($4::text IS NULL OR ring_size = $4)
+AND (
+  $5::int IS NULL
+  OR EXTRACT(MONTH FROM birthday) = $5
+  OR EXTRACT(MONTH FROM anniversary) = $5
+)
+ORDER BY id
+LIMIT $6 OFFSET $7
 `
 
 type ListCustomersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Search            pgtype.Text `json:"search"`
+	Tags              []string    `json:"tags"`
+	PreferredGoldType pgtype.Int4 `json:"preferred_gold_type"`
+	RingSize          pgtype.Text `json:"ring_size"`
+	OccasionMonth     pgtype.Int4 `json:"occasion_month"`
+	Limit             int32       `json:"limit"`
+	Offset            int32       `json:"offset"`
 }
 
+// Search matches name/email without diacritics, or part of the phone number.
+// A customer must carry all the given tags, occasion_month matches the
+// birthday or the anniversary.
 func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Customer, error) {
-	rows, err := q.db.Query(ctx, listCustomers, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listCustomers,
+		arg.Search,
+		arg.Tags,
+		arg.PreferredGoldType,
+		arg.RingSize,
+		arg.OccasionMonth,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +296,13 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 			&i.Address,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Birthday,
+			&i.Anniversary,
+			&i.RingSize,
+			&i.PreferredGoldType,
+			&i.Tags,
+			&i.ErasedAt,
+			&i.Uuid,
 		); err != nil {
 			return nil, err
 		}
@@ -135,6 +314,82 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 	return items, nil
 }
 
+const listCustomersByIDs = `-- name: ListCustomersByIDs :many
+SELECT id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid FROM customers
+WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) ListCustomersByIDs(ctx context.Context, ids []int32) ([]Customer, error) {
+	rows, err := q.db.Query(ctx, listCustomersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Customer{}
+	for rows.Next() {
+		var i Customer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Phone,
+			&i.Email,
+			&i.Address,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Birthday,
+			&i.Anniversary,
+			&i.RingSize,
+			&i.PreferredGoldType,
+			&i.Tags,
+			&i.ErasedAt,
+			&i.Uuid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCustomerTags = `-- name: SetCustomerTags :one
+UPDATE customers
+SET
+    tags = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid
+`
+
+type SetCustomerTagsParams struct {
+	ID   int32    `json:"id"`
+	Tags []string `json:"tags"`
+}
+
+func (q *Queries) SetCustomerTags(ctx context.Context, arg SetCustomerTagsParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, setCustomerTags, arg.ID, arg.Tags)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Phone,
+		&i.Email,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
+	)
+	return i, err
+}
+
 const updateCustomer = `-- name: UpdateCustomer :one
 UPDATE customers
 SET
@@ -144,13 +399,13 @@ SET
     address = $5,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, phone, email, address, created_at, updated_at
+RETURNING id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid
 `
 
 type UpdateCustomerParams struct {
 	ID      int32       `json:"id"`
 	Name    string      `json:"name"`
-	Phone   string      `json:"phone"`
+	Phone   pgtype.Text `json:"phone"`
 	Email   pgtype.Text `json:"email"`
 	Address pgtype.Text `json:"address"`
 }
@@ -172,6 +427,61 @@ func (q *Queries) UpdateCustomer(ctx context.Context, arg UpdateCustomerParams)
 		&i.Address,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
+	)
+	return i, err
+}
+
+const updateCustomerAttributes = `-- name: UpdateCustomerAttributes :one
+UPDATE customers
+SET
+    birthday = $2,
+    anniversary = $3,
+    ring_size = $4,
+    preferred_gold_type = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, phone, email, address, created_at, updated_at, birthday, anniversary, ring_size, preferred_gold_type, tags, erased_at, uuid
+`
+
+type UpdateCustomerAttributesParams struct {
+	ID                int32       `json:"id"`
+	Birthday          pgtype.Date `json:"birthday"`
+	Anniversary       pgtype.Date `json:"anniversary"`
+	RingSize          pgtype.Text `json:"ring_size"`
+	PreferredGoldType pgtype.Int4 `json:"preferred_gold_type"`
+}
+
+func (q *Queries) UpdateCustomerAttributes(ctx context.Context, arg UpdateCustomerAttributesParams) (Customer, error) {
+	row := q.db.QueryRow(ctx, updateCustomerAttributes,
+		arg.ID,
+		arg.Birthday,
+		arg.Anniversary,
+		arg.RingSize,
+		arg.PreferredGoldType,
+	)
+	var i Customer
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Phone,
+		&i.Email,
+		&i.Address,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Birthday,
+		&i.Anniversary,
+		&i.RingSize,
+		&i.PreferredGoldType,
+		&i.Tags,
+		&i.ErasedAt,
+		&i.Uuid,
 	)
 	return i, err
 }
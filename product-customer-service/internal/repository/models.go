@@ -5,27 +5,104 @@
 package repository
 
 import (
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Customer struct {
-	ID        int32            `json:"id"`
-	Name      string           `json:"name"`
-	Phone     string           `json:"phone"`
-	Email     pgtype.Text      `json:"email"`
-	Address   pgtype.Text      `json:"address"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
-	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	ID                int32            `json:"id"`
+	Name              string           `json:"name"`
+	Phone             pgtype.Text      `json:"phone"`
+	Email             pgtype.Text      `json:"email"`
+	Address           pgtype.Text      `json:"address"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	Birthday          pgtype.Date      `json:"birthday"`
+	Anniversary       pgtype.Date      `json:"anniversary"`
+	RingSize          pgtype.Text      `json:"ring_size"`
+	PreferredGoldType pgtype.Int4      `json:"preferred_gold_type"`
+	Tags              []string         `json:"tags"`
+	ErasedAt          pgtype.Timestamp `json:"erased_at"`
+	Uuid              uuid.UUID        `json:"uuid"`
 }
 
-type OrderRecord struct {
-	CustomerID string           `json:"customer_id"`
-	ProductID  int32            `json:"product_id"`
-	OrderID    int32            `json:"order_id"`
-	Quantity   int32            `json:"quantity"`
-	Status     string           `json:"status"`
+type CustomerNote struct {
+	ID         int32            `json:"id"`
+	CustomerID int32            `json:"customer_id"`
+	Note       string           `json:"note"`
+	CreatedBy  string           `json:"created_by"`
 	CreatedAt  pgtype.Timestamp `json:"created_at"`
-	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+type CustomerSegment struct {
+	ID          int32            `json:"id"`
+	Name        string           `json:"name"`
+	Description pgtype.Text      `json:"description"`
+	Rules       []byte           `json:"rules"`
+	VoucherID   pgtype.Int4      `json:"voucher_id"`
+	MemberCount int32            `json:"member_count"`
+	ComputedAt  pgtype.Timestamp `json:"computed_at"`
+	CreatedBy   string           `json:"created_by"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type CustomerSegmentMember struct {
+	SegmentID  int32            `json:"segment_id"`
+	CustomerID int32            `json:"customer_id"`
+	Spend      pgtype.Numeric   `json:"spend"`
+	LastVisit  pgtype.Timestamp `json:"last_visit"`
+	AddedAt    pgtype.Timestamp `json:"added_at"`
+}
+
+type GoodsReceipt struct {
+	ID              int32            `json:"id"`
+	PurchaseOrderID int32            `json:"purchase_order_id"`
+	ExtraCost       pgtype.Numeric   `json:"extra_cost"`
+	Note            pgtype.Text      `json:"note"`
+	ReceivedBy      string           `json:"received_by"`
+	ReceivedAt      pgtype.Timestamp `json:"received_at"`
+}
+
+type GoodsReceiptLine struct {
+	ID                  int32          `json:"id"`
+	ReceiptID           int32          `json:"receipt_id"`
+	PurchaseOrderLineID int32          `json:"purchase_order_line_id"`
+	ProductID           int32          `json:"product_id"`
+	Quantity            int32          `json:"quantity"`
+	LandedCost          pgtype.Numeric `json:"landed_cost"`
+}
+
+type OrderRecord struct {
+	ProductID     int32            `json:"product_id"`
+	OrderID       int32            `json:"order_id"`
+	Quantity      int32            `json:"quantity"`
+	Status        string           `json:"status"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	SerialNumbers []string         `json:"serial_numbers"`
+	UnitPrice     pgtype.Numeric   `json:"unit_price"`
+	CustomerID    uuid.UUID        `json:"customer_id"`
+}
+
+type PrivacyRequest struct {
+	ID          int32            `json:"id"`
+	Kind        string           `json:"kind"`
+	CustomerID  int32            `json:"customer_id"`
+	Status      string           `json:"status"`
+	RequestedBy string           `json:"requested_by"`
+	Payload     []byte           `json:"payload"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	CompletedAt pgtype.Timestamp `json:"completed_at"`
+}
+
+type PrivacyRequestAck struct {
+	RequestID      int32            `json:"request_id"`
+	Service        string           `json:"service"`
+	Success        bool             `json:"success"`
+	Error          pgtype.Text      `json:"error"`
+	Data           []byte           `json:"data"`
+	Records        int32            `json:"records"`
+	AcknowledgedAt pgtype.Timestamp `json:"acknowledged_at"`
 }
 
 type Product struct {
@@ -45,9 +122,146 @@ type Product struct {
 	Image           pgtype.Text      `json:"image"`
 	CreatedAt       pgtype.Timestamp `json:"created_at"`
 	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	GoldType        pgtype.Int4      `json:"gold_type"`
+	ParentID        pgtype.Int4      `json:"parent_id"`
+	Size            pgtype.Text      `json:"size"`
+	Status          string           `json:"status"`
+	ArchivedAt      pgtype.Timestamp `json:"archived_at"`
+	ReorderPoint    pgtype.Int4      `json:"reorder_point"`
+	ReorderQuantity pgtype.Int4      `json:"reorder_quantity"`
+	AlloyID         pgtype.Int4      `json:"alloy_id"`
 }
 
 type ProductCategory struct {
-	ID   int32  `json:"id"`
-	Name string `json:"name"`
+	ID                    int32          `json:"id"`
+	Name                  string         `json:"name"`
+	ParentID              pgtype.Int4    `json:"parent_id"`
+	DefaultMarkupRate     pgtype.Numeric `json:"default_markup_rate"`
+	DefaultWarrantyPeriod pgtype.Int4    `json:"default_warranty_period"`
+}
+
+type ProductImage struct {
+	ID               int32            `json:"id"`
+	ProductID        pgtype.Int4      `json:"product_id"`
+	FileID           string           `json:"file_id"`
+	OriginalFilename pgtype.Text      `json:"original_filename"`
+	SourceFormat     string           `json:"source_format"`
+	Width            int32            `json:"width"`
+	Height           int32            `json:"height"`
+	SortOrder        int32            `json:"sort_order"`
+	IsPrimary        bool             `json:"is_primary"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
+type ProductImageRendition struct {
+	ID         int32  `json:"id"`
+	ImageID    int32  `json:"image_id"`
+	Size       string `json:"size"`
+	Format     string `json:"format"`
+	Width      int32  `json:"width"`
+	Height     int32  `json:"height"`
+	StorageKey string `json:"storage_key"`
+	Url        string `json:"url"`
+}
+
+type ProductSerial struct {
+	ID                int32            `json:"id"`
+	SerialNumber      string           `json:"serial_number"`
+	ProductID         int32            `json:"product_id"`
+	Weight            pgtype.Numeric   `json:"weight"`
+	StoneDetails      pgtype.Text      `json:"stone_details"`
+	CertificateNumber pgtype.Text      `json:"certificate_number"`
+	Status            string           `json:"status"`
+	OrderID           pgtype.Int4      `json:"order_id"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+type ProductSerialEvent struct {
+	ID          int32            `json:"id"`
+	SerialID    int32            `json:"serial_id"`
+	EventType   string           `json:"event_type"`
+	ReferenceID pgtype.Int4      `json:"reference_id"`
+	Note        pgtype.Text      `json:"note"`
+	CreatedBy   pgtype.Text      `json:"created_by"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+}
+
+type ProductStone struct {
+	ID                int32            `json:"id"`
+	ProductID         int32            `json:"product_id"`
+	StoneType         string           `json:"stone_type"`
+	Carat             pgtype.Numeric   `json:"carat"`
+	Cut               pgtype.Text      `json:"cut"`
+	Color             pgtype.Text      `json:"color"`
+	Clarity           pgtype.Text      `json:"clarity"`
+	StoneCount        int32            `json:"stone_count"`
+	CertificateLab    pgtype.Text      `json:"certificate_lab"`
+	CertificateNumber pgtype.Text      `json:"certificate_number"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+}
+
+type PurchaseOrder struct {
+	ID         int32            `json:"id"`
+	SupplierID int32            `json:"supplier_id"`
+	Status     string           `json:"status"`
+	Note       pgtype.Text      `json:"note"`
+	ExpectedAt pgtype.Timestamp `json:"expected_at"`
+	CreatedBy  string           `json:"created_by"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+}
+
+type PurchaseOrderLine struct {
+	ID               int32          `json:"id"`
+	PurchaseOrderID  int32          `json:"purchase_order_id"`
+	ProductID        int32          `json:"product_id"`
+	Quantity         int32          `json:"quantity"`
+	ReceivedQuantity int32          `json:"received_quantity"`
+	GoldCostPerGram  pgtype.Numeric `json:"gold_cost_per_gram"`
+	LaborCost        pgtype.Numeric `json:"labor_cost"`
+}
+
+type StockMovement struct {
+	ID           int32            `json:"id"`
+	ProductID    int32            `json:"product_id"`
+	Quantity     int32            `json:"quantity"`
+	MovementType string           `json:"movement_type"`
+	ReferenceID  pgtype.Int4      `json:"reference_id"`
+	Note         pgtype.Text      `json:"note"`
+	CreatedBy    pgtype.Text      `json:"created_by"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+}
+
+type StocktakeCount struct {
+	SessionID       int32            `json:"session_id"`
+	ProductID       int32            `json:"product_id"`
+	CountedQuantity int32            `json:"counted_quantity"`
+	CountedBy       pgtype.Text      `json:"counted_by"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
+
+type StocktakeSession struct {
+	ID          int32            `json:"id"`
+	CategoryID  pgtype.Int4      `json:"category_id"`
+	Status      string           `json:"status"`
+	Note        pgtype.Text      `json:"note"`
+	OpenedBy    string           `json:"opened_by"`
+	ApprovedBy  pgtype.Text      `json:"approved_by"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	SubmittedAt pgtype.Timestamp `json:"submitted_at"`
+	ApprovedAt  pgtype.Timestamp `json:"approved_at"`
+}
+
+type Supplier struct {
+	ID        int32            `json:"id"`
+	Name      string           `json:"name"`
+	Phone     pgtype.Text      `json:"phone"`
+	Email     pgtype.Text      `json:"email"`
+	Address   pgtype.Text      `json:"address"`
+	TaxCode   pgtype.Text      `json:"tax_code"`
+	Note      pgtype.Text      `json:"note"`
+	IsActive  bool             `json:"is_active"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
 }
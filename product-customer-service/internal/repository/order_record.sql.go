@@ -7,28 +7,34 @@ package repository
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createOrderRecord = `-- name: CreateOrderRecord :one
 INSERT INTO order_record (
-  customer_id, product_id, order_id, quantity, status
+  customer_id, product_id, order_id, quantity, status, serial_numbers, unit_price
 ) VALUES (
-  $1, $2, $3, $4, COALESCE($5, 'pending')
+  $1, $2, $3, $4, COALESCE($5, 'pending'), $6, $7
 )
 ON CONFLICT (customer_id, product_id, order_id)
 DO UPDATE SET
-  quantity   = order_record.quantity + EXCLUDED.quantity,
-  status     = COALESCE(EXCLUDED.status, order_record.status),
-  updated_at = NOW()
-RETURNING customer_id, product_id, order_id, quantity, status, created_at, updated_at
+  quantity       = order_record.quantity + EXCLUDED.quantity,
+  status         = COALESCE(EXCLUDED.status, order_record.status),
+  serial_numbers = order_record.serial_numbers || EXCLUDED.serial_numbers,
+  updated_at     = NOW()
+RETURNING product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
 `
 
 type CreateOrderRecordParams struct {
-	CustomerID string      `json:"customer_id"`
-	ProductID  int32       `json:"product_id"`
-	OrderID    int32       `json:"order_id"`
-	Quantity   int32       `json:"quantity"`
-	Column5    interface{} `json:"column_5"`
+	CustomerID    uuid.UUID      `json:"customer_id"`
+	ProductID     int32          `json:"product_id"`
+	OrderID       int32          `json:"order_id"`
+	Quantity      int32          `json:"quantity"`
+	Column5       interface{}    `json:"column_5"`
+	SerialNumbers []string       `json:"serial_numbers"`
+	UnitPrice     pgtype.Numeric `json:"unit_price"`
 }
 
 func (q *Queries) CreateOrderRecord(ctx context.Context, arg CreateOrderRecordParams) (OrderRecord, error) {
@@ -38,16 +44,20 @@ func (q *Queries) CreateOrderRecord(ctx context.Context, arg CreateOrderRecordPa
 		arg.OrderID,
 		arg.Quantity,
 		arg.Column5,
+		arg.SerialNumbers,
+		arg.UnitPrice,
 	)
 	var i OrderRecord
 	err := row.Scan(
-		&i.CustomerID,
 		&i.ProductID,
 		&i.OrderID,
 		&i.Quantity,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SerialNumbers,
+		&i.UnitPrice,
+		&i.CustomerID,
 	)
 	return i, err
 }
@@ -57,32 +67,69 @@ DELETE FROM order_record
 WHERE customer_id = $1
   AND product_id  = $2
   AND order_id    = $3
-RETURNING customer_id, product_id, order_id, quantity, status, created_at, updated_at
+RETURNING product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
 `
 
 type DeleteOrderRecordParams struct {
-	CustomerID string `json:"customer_id"`
-	ProductID  int32  `json:"product_id"`
-	OrderID    int32  `json:"order_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	ProductID  int32     `json:"product_id"`
+	OrderID    int32     `json:"order_id"`
 }
 
 func (q *Queries) DeleteOrderRecord(ctx context.Context, arg DeleteOrderRecordParams) (OrderRecord, error) {
 	row := q.db.QueryRow(ctx, deleteOrderRecord, arg.CustomerID, arg.ProductID, arg.OrderID)
 	var i OrderRecord
 	err := row.Scan(
-		&i.CustomerID,
 		&i.ProductID,
 		&i.OrderID,
 		&i.Quantity,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SerialNumbers,
+		&i.UnitPrice,
+		&i.CustomerID,
+	)
+	return i, err
+}
+
+const getCustomerPurchaseStats = `-- name: GetCustomerPurchaseStats :one
+SELECT
+  COUNT(DISTINCT order_id)::int AS order_count,
+  COALESCE(SUM(quantity), 0)::int AS piece_count,
+  COALESCE(SUM(quantity * unit_price), 0)::decimal AS lifetime_spend,
+  MAX(created_at)::timestamp AS last_visit,
+  COUNT(*) AS purchase_count,
+  COUNT(*) FILTER (WHERE unit_price IS NULL)::int AS unpriced_count
+FROM order_record
+WHERE customer_id = $1
+`
+
+type GetCustomerPurchaseStatsRow struct {
+	OrderCount    int32            `json:"order_count"`
+	PieceCount    int32            `json:"piece_count"`
+	LifetimeSpend pgtype.Numeric   `json:"lifetime_spend"`
+	LastVisit     pgtype.Timestamp `json:"last_visit"`
+	PurchaseCount int64            `json:"purchase_count"`
+	UnpricedCount int32            `json:"unpriced_count"`
+}
+
+func (q *Queries) GetCustomerPurchaseStats(ctx context.Context, customerID uuid.UUID) (GetCustomerPurchaseStatsRow, error) {
+	row := q.db.QueryRow(ctx, getCustomerPurchaseStats, customerID)
+	var i GetCustomerPurchaseStatsRow
+	err := row.Scan(
+		&i.OrderCount,
+		&i.PieceCount,
+		&i.LifetimeSpend,
+		&i.LastVisit,
+		&i.PurchaseCount,
+		&i.UnpricedCount,
 	)
 	return i, err
 }
 
 const getOrderRecord = `-- name: GetOrderRecord :one
-SELECT customer_id, product_id, order_id, quantity, status, created_at, updated_at
+SELECT product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
 FROM order_record
 WHERE customer_id = $1
   AND product_id  = $2
@@ -90,28 +137,190 @@ WHERE customer_id = $1
 `
 
 type GetOrderRecordParams struct {
-	CustomerID string `json:"customer_id"`
-	ProductID  int32  `json:"product_id"`
-	OrderID    int32  `json:"order_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	ProductID  int32     `json:"product_id"`
+	OrderID    int32     `json:"order_id"`
 }
 
 func (q *Queries) GetOrderRecord(ctx context.Context, arg GetOrderRecordParams) (OrderRecord, error) {
 	row := q.db.QueryRow(ctx, getOrderRecord, arg.CustomerID, arg.ProductID, arg.OrderID)
 	var i OrderRecord
 	err := row.Scan(
+		&i.ProductID,
+		&i.OrderID,
+		&i.Quantity,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SerialNumbers,
+		&i.UnitPrice,
 		&i.CustomerID,
+	)
+	return i, err
+}
+
+const getOrderRecordByOrderAndProduct = `-- name: GetOrderRecordByOrderAndProduct :one
+SELECT product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
+FROM order_record
+WHERE order_id = $1
+  AND product_id = $2
+LIMIT 1
+`
+
+type GetOrderRecordByOrderAndProductParams struct {
+	OrderID   int32 `json:"order_id"`
+	ProductID int32 `json:"product_id"`
+}
+
+func (q *Queries) GetOrderRecordByOrderAndProduct(ctx context.Context, arg GetOrderRecordByOrderAndProductParams) (OrderRecord, error) {
+	row := q.db.QueryRow(ctx, getOrderRecordByOrderAndProduct, arg.OrderID, arg.ProductID)
+	var i OrderRecord
+	err := row.Scan(
 		&i.ProductID,
 		&i.OrderID,
 		&i.Quantity,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SerialNumbers,
+		&i.UnitPrice,
+		&i.CustomerID,
 	)
 	return i, err
 }
 
+const listCustomerFavouriteCategories = `-- name: ListCustomerFavouriteCategories :many
+SELECT
+  c.id AS category_id,
+  c.name AS category_name,
+  SUM(r.quantity)::int AS piece_count,
+  COALESCE(SUM(r.quantity * r.unit_price), 0)::decimal AS spend
+FROM order_record r
+JOIN products p ON p.id = r.product_id
+JOIN product_categories c ON c.id = p.category_id
+WHERE r.customer_id = $1
+GROUP BY c.id, c.name
+ORDER BY piece_count DESC, spend DESC
+LIMIT $2
+`
+
+type ListCustomerFavouriteCategoriesParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Limit      int32     `json:"limit"`
+}
+
+type ListCustomerFavouriteCategoriesRow struct {
+	CategoryID   int32          `json:"category_id"`
+	CategoryName string         `json:"category_name"`
+	PieceCount   int32          `json:"piece_count"`
+	Spend        pgtype.Numeric `json:"spend"`
+}
+
+func (q *Queries) ListCustomerFavouriteCategories(ctx context.Context, arg ListCustomerFavouriteCategoriesParams) ([]ListCustomerFavouriteCategoriesRow, error) {
+	rows, err := q.db.Query(ctx, listCustomerFavouriteCategories, arg.CustomerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCustomerFavouriteCategoriesRow{}
+	for rows.Next() {
+		var i ListCustomerFavouriteCategoriesRow
+		if err := rows.Scan(
+			&i.CategoryID,
+			&i.CategoryName,
+			&i.PieceCount,
+			&i.Spend,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCustomerPurchases = `-- name: ListCustomerPurchases :many
+SELECT
+  r.order_id,
+  r.product_id,
+  p.code,
+  p.name,
+  p.image,
+  p.category_id,
+  p.gold_type,
+  p.weight,
+  r.quantity,
+  r.unit_price,
+  r.serial_numbers,
+  r.status,
+  r.created_at
+FROM order_record r
+JOIN products p ON p.id = r.product_id
+WHERE r.customer_id = $1
+ORDER BY r.created_at DESC, r.order_id DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListCustomerPurchasesParams struct {
+	CustomerID uuid.UUID `json:"customer_id"`
+	Limit      int32     `json:"limit"`
+	Offset     int32     `json:"offset"`
+}
+
+type ListCustomerPurchasesRow struct {
+	OrderID       int32            `json:"order_id"`
+	ProductID     int32            `json:"product_id"`
+	Code          string           `json:"code"`
+	Name          pgtype.Text      `json:"name"`
+	Image         pgtype.Text      `json:"image"`
+	CategoryID    pgtype.Int4      `json:"category_id"`
+	GoldType      pgtype.Int4      `json:"gold_type"`
+	Weight        pgtype.Numeric   `json:"weight"`
+	Quantity      int32            `json:"quantity"`
+	UnitPrice     pgtype.Numeric   `json:"unit_price"`
+	SerialNumbers []string         `json:"serial_numbers"`
+	Status        string           `json:"status"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) ListCustomerPurchases(ctx context.Context, arg ListCustomerPurchasesParams) ([]ListCustomerPurchasesRow, error) {
+	rows, err := q.db.Query(ctx, listCustomerPurchases, arg.CustomerID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCustomerPurchasesRow{}
+	for rows.Next() {
+		var i ListCustomerPurchasesRow
+		if err := rows.Scan(
+			&i.OrderID,
+			&i.ProductID,
+			&i.Code,
+			&i.Name,
+			&i.Image,
+			&i.CategoryID,
+			&i.GoldType,
+			&i.Weight,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.SerialNumbers,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrderRecords = `-- name: ListOrderRecords :many
-SELECT customer_id, product_id, order_id, quantity, status, created_at, updated_at
+SELECT product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
 FROM order_record
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
@@ -132,13 +341,15 @@ func (q *Queries) ListOrderRecords(ctx context.Context, arg ListOrderRecordsPara
 	for rows.Next() {
 		var i OrderRecord
 		if err := rows.Scan(
-			&i.CustomerID,
 			&i.ProductID,
 			&i.OrderID,
 			&i.Quantity,
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SerialNumbers,
+			&i.UnitPrice,
+			&i.CustomerID,
 		); err != nil {
 			return nil, err
 		}
@@ -150,6 +361,28 @@ func (q *Queries) ListOrderRecords(ctx context.Context, arg ListOrderRecordsPara
 	return items, nil
 }
 
+const moveOrderRecords = `-- name: MoveOrderRecords :execrows
+UPDATE order_record
+SET
+  customer_id = $1,
+  updated_at  = NOW()
+WHERE customer_id = $2
+`
+
+type MoveOrderRecordsParams struct {
+	ToCustomerID   uuid.UUID `json:"to_customer_id"`
+	FromCustomerID uuid.UUID `json:"from_customer_id"`
+}
+
+// Re-points the orders of a merged customer.
+func (q *Queries) MoveOrderRecords(ctx context.Context, arg MoveOrderRecordsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveOrderRecords, arg.ToCustomerID, arg.FromCustomerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateOrderRecord = `-- name: UpdateOrderRecord :one
 UPDATE order_record
 SET 
@@ -158,14 +391,14 @@ SET
 WHERE customer_id = $1
   AND product_id  = $2
   AND order_id    = $3
-RETURNING customer_id, product_id, order_id, quantity, status, created_at, updated_at
+RETURNING product_id, order_id, quantity, status, created_at, updated_at, serial_numbers, unit_price, customer_id
 `
 
 type UpdateOrderRecordParams struct {
-	CustomerID string `json:"customer_id"`
-	ProductID  int32  `json:"product_id"`
-	OrderID    int32  `json:"order_id"`
-	Status     string `json:"status"`
+	CustomerID uuid.UUID `json:"customer_id"`
+	ProductID  int32     `json:"product_id"`
+	OrderID    int32     `json:"order_id"`
+	Status     string    `json:"status"`
 }
 
 func (q *Queries) UpdateOrderRecord(ctx context.Context, arg UpdateOrderRecordParams) (OrderRecord, error) {
@@ -177,13 +410,15 @@ func (q *Queries) UpdateOrderRecord(ctx context.Context, arg UpdateOrderRecordPa
 	)
 	var i OrderRecord
 	err := row.Scan(
-		&i.CustomerID,
 		&i.ProductID,
 		&i.OrderID,
 		&i.Quantity,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SerialNumbers,
+		&i.UnitPrice,
+		&i.CustomerID,
 	)
 	return i, err
 }
@@ -11,14 +11,146 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countLowStockProducts = `-- name: CountLowStockProducts :one
+SELECT count(*) FROM products
+WHERE reorder_point IS NOT NULL
+  AND COALESCE(stock, 0) <= reorder_point
+  AND status = 'active'
+  AND ($1::int IS NULL OR category_id = $1)
+`
+
+func (q *Queries) CountLowStockProducts(ctx context.Context, categoryID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countLowStockProducts, categoryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countProductReferences = `-- name: CountProductReferences :one
+SELECT
+  (SELECT count(*) FROM order_record o WHERE o.product_id = ANY($1::int[]))::int AS orders,
+  (SELECT count(*) FROM product_serials ps WHERE ps.product_id = ANY($1::int[]))::int AS serials,
+  (SELECT count(*) FROM stocktake_counts sc WHERE sc.product_id = ANY($1::int[]))::int AS stocktake_counts,
+  (SELECT count(*) FROM purchase_order_lines pol WHERE pol.product_id = ANY($1::int[]))::int AS purchase_order_lines,
+  (SELECT count(*) FROM goods_receipt_lines grl WHERE grl.product_id = ANY($1::int[]))::int AS goods_receipt_lines
+`
+
+type CountProductReferencesRow struct {
+	Orders             int32 `json:"orders"`
+	Serials            int32 `json:"serials"`
+	StocktakeCounts    int32 `json:"stocktake_counts"`
+	PurchaseOrderLines int32 `json:"purchase_order_lines"`
+	GoodsReceiptLines  int32 `json:"goods_receipt_lines"`
+}
+
+// History that prevents a hard delete of the products.
+func (q *Queries) CountProductReferences(ctx context.Context, ids []int32) (CountProductReferencesRow, error) {
+	row := q.db.QueryRow(ctx, countProductReferences, ids)
+	var i CountProductReferencesRow
+	err := row.Scan(
+		&i.Orders,
+		&i.Serials,
+		&i.StocktakeCounts,
+		&i.PurchaseOrderLines,
+		&i.GoodsReceiptLines,
+	)
+	return i, err
+}
+
+const countProducts = `-- name: CountProducts :one
+SELECT count(*) FROM products
+WHERE parent_id IS NULL
+AND ($1::int IS NULL OR category_id = $1)
+AND ($2::int IS NULL OR gold_type = $2)
+AND ($3::decimal IS NULL OR selling_price >= $3)
+AND ($4::decimal IS NULL OR selling_price <= $4)
+AND ($5::decimal IS NULL OR weight >= $5)
+AND ($6::decimal IS NULL OR weight <= $6)
+AND (
+  NOT $7::bool
+  OR stock > 0
+  OR EXISTS (SELECT 1 FROM products v WHERE v.parent_id = products.id AND v.stock > 0)
+)
+AND (
+  $8::text IS NULL
+  OR f_unaccent(coalesce(name, '') || ' ' || code) LIKE '%' || f_unaccent($8) || '%'
+  OR f_unaccent($8) <% f_unaccent(coalesce(name, '') || ' ' || code)
+)
+AND (
+  CASE WHEN $9::text IS NOT NULL THEN status = $9
+  ELSE $10::bool OR status <> 'archived' END
+)
+AND (
+  NOT $11::bool
+  OR EXISTS (
+    SELECT 1 FROM product_stones s
+    WHERE (s.product_id = products.id
+      OR s.product_id IN (SELECT v.id FROM products v WHERE v.parent_id = products.id))
+      AND ($12::text IS NULL OR s.stone_type = $12)
+      AND ($13::decimal IS NULL OR s.carat >= $13)
+      AND ($14::decimal IS NULL OR s.carat <= $14)
+      AND ($15::text IS NULL OR s.color = $15)
+      AND ($16::text IS NULL OR s.clarity = $16)
+      AND ($17::text IS NULL OR s.certificate_lab = $17)
+  )
+)
+`
+
+type CountProductsParams struct {
+	CategoryID      pgtype.Int4    `json:"category_id"`
+	GoldType        pgtype.Int4    `json:"gold_type"`
+	MinPrice        pgtype.Numeric `json:"min_price"`
+	MaxPrice        pgtype.Numeric `json:"max_price"`
+	MinWeight       pgtype.Numeric `json:"min_weight"`
+	MaxWeight       pgtype.Numeric `json:"max_weight"`
+	InStock         bool           `json:"in_stock"`
+	Search          pgtype.Text    `json:"search"`
+	FilterStones    bool           `json:"filter_stones"`
+	StoneType       pgtype.Text    `json:"stone_type"`
+	MinCarat        pgtype.Numeric `json:"min_carat"`
+	MaxCarat        pgtype.Numeric `json:"max_carat"`
+	StoneColor      pgtype.Text    `json:"stone_color"`
+	StoneClarity    pgtype.Text    `json:"stone_clarity"`
+	CertificateLab  pgtype.Text    `json:"certificate_lab"`
+	Status          pgtype.Text    `json:"status"`
+	IncludeArchived bool           `json:"include_archived"`
+}
+
+// Same filters as ListProducts.
+func (q *Queries) CountProducts(ctx context.Context, arg CountProductsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countProducts,
+		arg.CategoryID,
+		arg.GoldType,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MinWeight,
+		arg.MaxWeight,
+		arg.InStock,
+		arg.Search,
+		arg.FilterStones,
+		arg.StoneType,
+		arg.MinCarat,
+		arg.MaxCarat,
+		arg.StoneColor,
+		arg.StoneClarity,
+		arg.CertificateLab,
+		arg.Status,
+		arg.IncludeArchived,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProduct = `-- name: CreateProduct :one
 INSERT INTO products (
   name, code, category_id, weight, gold_price_at_time, labor_cost, stone_cost, stock,
-  markup_rate, selling_price, warranty_period, image, created_at, updated_at
+  markup_rate, selling_price, warranty_period, image, gold_type, parent_id, size,
+  reorder_point, reorder_quantity, alloy_id, created_at, updated_at
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW()
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NOW(), NOW()
 )
-RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at
+RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id
 `
 
 type CreateProductParams struct {
@@ -34,6 +166,12 @@ type CreateProductParams struct {
 	SellingPrice    pgtype.Numeric `json:"selling_price"`
 	WarrantyPeriod  pgtype.Int4    `json:"warranty_period"`
 	Image           pgtype.Text    `json:"image"`
+	GoldType        pgtype.Int4    `json:"gold_type"`
+	ParentID        pgtype.Int4    `json:"parent_id"`
+	Size            pgtype.Text    `json:"size"`
+	ReorderPoint    pgtype.Int4    `json:"reorder_point"`
+	ReorderQuantity pgtype.Int4    `json:"reorder_quantity"`
+	AlloyID         pgtype.Int4    `json:"alloy_id"`
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error) {
@@ -50,6 +188,12 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		arg.SellingPrice,
 		arg.WarrantyPeriod,
 		arg.Image,
+		arg.GoldType,
+		arg.ParentID,
+		arg.Size,
+		arg.ReorderPoint,
+		arg.ReorderQuantity,
+		arg.AlloyID,
 	)
 	var i Product
 	err := row.Scan(
@@ -69,21 +213,66 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (P
 		&i.Image,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
 	)
 	return i, err
 }
 
 const deleteProduct = `-- name: DeleteProduct :exec
-DELETE FROM products WHERE id = $1
+DELETE FROM products WHERE id = $1 OR parent_id = $1
 `
 
+// Removes the product with its variants.
 func (q *Queries) DeleteProduct(ctx context.Context, id int32) error {
 	_, err := q.db.Exec(ctx, deleteProduct, id)
 	return err
 }
 
+const getProductByCode = `-- name: GetProductByCode :one
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products WHERE code = $1
+`
+
+func (q *Queries) GetProductByCode(ctx context.Context, code string) (Product, error) {
+	row := q.db.QueryRow(ctx, getProductByCode, code)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.CategoryID,
+		&i.Stock,
+		&i.BuyTurn,
+		&i.Weight,
+		&i.GoldPriceAtTime,
+		&i.LaborCost,
+		&i.StoneCost,
+		&i.MarkupRate,
+		&i.SellingPrice,
+		&i.WarrantyPeriod,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
+	)
+	return i, err
+}
+
 const getProductByID = `-- name: GetProductByID :one
-SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at FROM products WHERE id = $1
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products WHERE id = $1
 `
 
 func (q *Queries) GetProductByID(ctx context.Context, id int32) (Product, error) {
@@ -106,12 +295,106 @@ func (q *Queries) GetProductByID(ctx context.Context, id int32) (Product, error)
 		&i.Image,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
+	)
+	return i, err
+}
+
+const getProductForUpdate = `-- name: GetProductForUpdate :one
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetProductForUpdate(ctx context.Context, id int32) (Product, error) {
+	row := q.db.QueryRow(ctx, getProductForUpdate, id)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.CategoryID,
+		&i.Stock,
+		&i.BuyTurn,
+		&i.Weight,
+		&i.GoldPriceAtTime,
+		&i.LaborCost,
+		&i.StoneCost,
+		&i.MarkupRate,
+		&i.SellingPrice,
+		&i.WarrantyPeriod,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
 	)
 	return i, err
 }
 
+const getProductsByCodes = `-- name: GetProductsByCodes :many
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products
+WHERE code = ANY($1::text[])
+`
+
+func (q *Queries) GetProductsByCodes(ctx context.Context, dollar_1 []string) ([]Product, error) {
+	rows, err := q.db.Query(ctx, getProductsByCodes, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.CategoryID,
+			&i.Stock,
+			&i.BuyTurn,
+			&i.Weight,
+			&i.GoldPriceAtTime,
+			&i.LaborCost,
+			&i.StoneCost,
+			&i.MarkupRate,
+			&i.SellingPrice,
+			&i.WarrantyPeriod,
+			&i.Image,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getProductsById = `-- name: GetProductsById :many
-SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at FROM products WHERE id = ANY($1::int[])
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products WHERE id = ANY($1::int[])
 `
 
 func (q *Queries) GetProductsById(ctx context.Context, dollar_1 []int32) ([]Product, error) {
@@ -140,6 +423,127 @@ func (q *Queries) GetProductsById(ctx context.Context, dollar_1 []int32) ([]Prod
 			&i.Image,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLowStockProducts = `-- name: ListLowStockProducts :many
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products
+WHERE reorder_point IS NOT NULL
+  AND COALESCE(stock, 0) <= reorder_point
+  AND status = 'active'
+  AND ($1::int IS NULL OR category_id = $1)
+ORDER BY COALESCE(stock, 0) - reorder_point, id
+LIMIT $2 OFFSET $3
+`
+
+type ListLowStockProductsParams struct {
+	CategoryID pgtype.Int4 `json:"category_id"`
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+}
+
+// Sellable products at or below their reorder point, emptiest first.
+func (q *Queries) ListLowStockProducts(ctx context.Context, arg ListLowStockProductsParams) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listLowStockProducts, arg.CategoryID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.CategoryID,
+			&i.Stock,
+			&i.BuyTurn,
+			&i.Weight,
+			&i.GoldPriceAtTime,
+			&i.LaborCost,
+			&i.StoneCost,
+			&i.MarkupRate,
+			&i.SellingPrice,
+			&i.WarrantyPeriod,
+			&i.Image,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductVariantsByParentIDs = `-- name: ListProductVariantsByParentIDs :many
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products
+WHERE parent_id = ANY($1::int[])
+ORDER BY parent_id, size, id
+`
+
+func (q *Queries) ListProductVariantsByParentIDs(ctx context.Context, dollar_1 []int32) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listProductVariantsByParentIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.CategoryID,
+			&i.Stock,
+			&i.BuyTurn,
+			&i.Weight,
+			&i.GoldPriceAtTime,
+			&i.LaborCost,
+			&i.StoneCost,
+			&i.MarkupRate,
+			&i.SellingPrice,
+			&i.WarrantyPeriod,
+			&i.Image,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
 		); err != nil {
 			return nil, err
 		}
@@ -152,16 +556,104 @@ func (q *Queries) GetProductsById(ctx context.Context, dollar_1 []int32) ([]Prod
 }
 
 const listProducts = `-- name: ListProducts :many
-SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at FROM products ORDER BY id LIMIT $1 OFFSET $2
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products
+WHERE parent_id IS NULL
+AND ($1::int IS NULL OR category_id = $1)
+AND ($2::int IS NULL OR gold_type = $2)
+AND ($3::decimal IS NULL OR selling_price >= $3)
+AND ($4::decimal IS NULL OR selling_price <= $4)
+AND ($5::decimal IS NULL OR weight >= $5)
+AND ($6::decimal IS NULL OR weight <= $6)
+AND (
+  NOT $7::bool
+  OR stock > 0
+  OR EXISTS (SELECT 1 FROM products v WHERE v.parent_id = products.id AND v.stock > 0)
+)
+AND (
+  $8::text IS NULL
+  OR f_unaccent(coalesce(name, '') || ' ' || code) LIKE '%' || f_unaccent($8) || '%'
+  OR f_unaccent($8) <% f_unaccent(coalesce(name, '') || ' ' || code)
+)
+AND (
+  CASE WHEN $9::text IS NOT NULL THEN status = $9
+  ELSE $10::bool OR status <> 'archived' END
+)
+AND (
+  NOT $11::bool
+  OR EXISTS (
+    SELECT 1 FROM product_stones s
+    WHERE (s.product_id = products.id
+      OR s.product_id IN (SELECT v.id FROM products v WHERE v.parent_id = products.id))
+      AND ($12::text IS NULL OR s.stone_type = $12)
+      AND ($13::decimal IS NULL OR s.carat >= $13)
+      AND ($14::decimal IS NULL OR s.carat <= $14)
+      AND ($15::text IS NULL OR s.color = $15)
+      AND ($16::text IS NULL OR s.clarity = $16)
+      AND ($17::text IS NULL OR s.certificate_lab = $17)
+  )
+)
+ORDER BY
+  CASE WHEN $18::text = 'price_asc' THEN selling_price END ASC NULLS LAST,
+  CASE WHEN $18::text = 'price_desc' THEN selling_price END DESC NULLS LAST,
+  CASE WHEN $18::text = 'newest' THEN created_at END DESC NULLS LAST,
+  CASE WHEN $18::text = 'best_selling' THEN buy_turn END DESC NULLS LAST,
+  CASE WHEN $8::text IS NOT NULL
+    THEN word_similarity(f_unaccent($8), f_unaccent(coalesce(name, '') || ' ' || code))
+  END DESC,
+  id
+LIMIT $19 OFFSET $20
 `
 
 type ListProductsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	CategoryID      pgtype.Int4    `json:"category_id"`
+	GoldType        pgtype.Int4    `json:"gold_type"`
+	MinPrice        pgtype.Numeric `json:"min_price"`
+	MaxPrice        pgtype.Numeric `json:"max_price"`
+	MinWeight       pgtype.Numeric `json:"min_weight"`
+	MaxWeight       pgtype.Numeric `json:"max_weight"`
+	InStock         bool           `json:"in_stock"`
+	Search          pgtype.Text    `json:"search"`
+	FilterStones    bool           `json:"filter_stones"`
+	StoneType       pgtype.Text    `json:"stone_type"`
+	MinCarat        pgtype.Numeric `json:"min_carat"`
+	MaxCarat        pgtype.Numeric `json:"max_carat"`
+	StoneColor      pgtype.Text    `json:"stone_color"`
+	StoneClarity    pgtype.Text    `json:"stone_clarity"`
+	CertificateLab  pgtype.Text    `json:"certificate_lab"`
+	Status          pgtype.Text    `json:"status"`
+	IncludeArchived bool           `json:"include_archived"`
+	Sort            string         `json:"sort"`
+	Limit           int32          `json:"limit"`
+	Offset          int32          `json:"offset"`
 }
 
+// Top level products only, variants are loaded under their parent.
+// Text search matches name/code without diacritics, by substring or trigram similarity.
+// Archived products are hidden unless asked for.
+// Stone filters match the stones of the product or of any of its variants.
 func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]Product, error) {
-	rows, err := q.db.Query(ctx, listProducts, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listProducts,
+		arg.CategoryID,
+		arg.GoldType,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.MinWeight,
+		arg.MaxWeight,
+		arg.InStock,
+		arg.Search,
+		arg.FilterStones,
+		arg.StoneType,
+		arg.MinCarat,
+		arg.MaxCarat,
+		arg.StoneColor,
+		arg.StoneClarity,
+		arg.CertificateLab,
+		arg.Status,
+		arg.IncludeArchived,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +678,14 @@ func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]P
 			&i.Image,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
 		); err != nil {
 			return nil, err
 		}
@@ -197,6 +697,346 @@ func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]P
 	return items, nil
 }
 
+const listProductsByCategory = `-- name: ListProductsByCategory :many
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products WHERE category_id = $1 ORDER BY id
+`
+
+func (q *Queries) ListProductsByCategory(ctx context.Context, categoryID pgtype.Int4) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listProductsByCategory, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.CategoryID,
+			&i.Stock,
+			&i.BuyTurn,
+			&i.Weight,
+			&i.GoldPriceAtTime,
+			&i.LaborCost,
+			&i.StoneCost,
+			&i.MarkupRate,
+			&i.SellingPrice,
+			&i.WarrantyPeriod,
+			&i.Image,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsForExport = `-- name: ListProductsForExport :many
+SELECT id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id FROM products
+WHERE status <> 'archived'
+AND ($1::int IS NULL OR category_id = $1)
+ORDER BY COALESCE(parent_id, id), id
+`
+
+// Variants are listed right after their parent, archived products are left out.
+func (q *Queries) ListProductsForExport(ctx context.Context, categoryID pgtype.Int4) ([]Product, error) {
+	rows, err := q.db.Query(ctx, listProductsForExport, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.CategoryID,
+			&i.Stock,
+			&i.BuyTurn,
+			&i.Weight,
+			&i.GoldPriceAtTime,
+			&i.LaborCost,
+			&i.StoneCost,
+			&i.MarkupRate,
+			&i.SellingPrice,
+			&i.WarrantyPeriod,
+			&i.Image,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.GoldType,
+			&i.ParentID,
+			&i.Size,
+			&i.Status,
+			&i.ArchivedAt,
+			&i.ReorderPoint,
+			&i.ReorderQuantity,
+			&i.AlloyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const receiveProductStock = `-- name: ReceiveProductStock :one
+UPDATE products
+SET
+  stock              = COALESCE(stock, 0) + $1::int,
+  gold_price_at_time = $2,
+  labor_cost         = $3,
+  selling_price      = $4,
+  updated_at         = NOW()
+WHERE id = $5
+RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id
+`
+
+type ReceiveProductStockParams struct {
+	Quantity        int32          `json:"quantity"`
+	GoldPriceAtTime pgtype.Numeric `json:"gold_price_at_time"`
+	LaborCost       pgtype.Numeric `json:"labor_cost"`
+	SellingPrice    pgtype.Numeric `json:"selling_price"`
+	ID              int32          `json:"id"`
+}
+
+// Goods receipt: adds the stock and stores the averaged landed cost.
+func (q *Queries) ReceiveProductStock(ctx context.Context, arg ReceiveProductStockParams) (Product, error) {
+	row := q.db.QueryRow(ctx, receiveProductStock,
+		arg.Quantity,
+		arg.GoldPriceAtTime,
+		arg.LaborCost,
+		arg.SellingPrice,
+		arg.ID,
+	)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.CategoryID,
+		&i.Stock,
+		&i.BuyTurn,
+		&i.Weight,
+		&i.GoldPriceAtTime,
+		&i.LaborCost,
+		&i.StoneCost,
+		&i.MarkupRate,
+		&i.SellingPrice,
+		&i.WarrantyPeriod,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
+	)
+	return i, err
+}
+
+const sellProductStock = `-- name: SellProductStock :one
+UPDATE products
+SET
+  stock      = stock - $1::int,
+  buy_turn   = COALESCE(buy_turn, 0) + 1,
+  updated_at = NOW()
+WHERE id = $2 AND stock >= $1::int
+RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id
+`
+
+type SellProductStockParams struct {
+	Quantity int32 `json:"quantity"`
+	ID       int32 `json:"id"`
+}
+
+// No row when the product holds less than the quantity sold.
+func (q *Queries) SellProductStock(ctx context.Context, arg SellProductStockParams) (Product, error) {
+	row := q.db.QueryRow(ctx, sellProductStock, arg.Quantity, arg.ID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.CategoryID,
+		&i.Stock,
+		&i.BuyTurn,
+		&i.Weight,
+		&i.GoldPriceAtTime,
+		&i.LaborCost,
+		&i.StoneCost,
+		&i.MarkupRate,
+		&i.SellingPrice,
+		&i.WarrantyPeriod,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
+	)
+	return i, err
+}
+
+const setProductImageUrl = `-- name: SetProductImageUrl :exec
+UPDATE products
+SET image = $2, updated_at = NOW()
+WHERE id = $1 OR parent_id = $1
+`
+
+type SetProductImageUrlParams struct {
+	ID    int32       `json:"id"`
+	Image pgtype.Text `json:"image"`
+}
+
+// Variants share the image of their parent.
+func (q *Queries) SetProductImageUrl(ctx context.Context, arg SetProductImageUrlParams) error {
+	_, err := q.db.Exec(ctx, setProductImageUrl, arg.ID, arg.Image)
+	return err
+}
+
+const setProductPrice = `-- name: SetProductPrice :one
+UPDATE products
+SET
+  gold_price_at_time = $1,
+  selling_price      = $2,
+  updated_at         = NOW()
+WHERE id = $3
+RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id
+`
+
+type SetProductPriceParams struct {
+	GoldPriceAtTime pgtype.Numeric `json:"gold_price_at_time"`
+	SellingPrice    pgtype.Numeric `json:"selling_price"`
+	ID              int32          `json:"id"`
+}
+
+// Reprices the product off the given gold price.
+func (q *Queries) SetProductPrice(ctx context.Context, arg SetProductPriceParams) (Product, error) {
+	row := q.db.QueryRow(ctx, setProductPrice, arg.GoldPriceAtTime, arg.SellingPrice, arg.ID)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Code,
+		&i.CategoryID,
+		&i.Stock,
+		&i.BuyTurn,
+		&i.Weight,
+		&i.GoldPriceAtTime,
+		&i.LaborCost,
+		&i.StoneCost,
+		&i.MarkupRate,
+		&i.SellingPrice,
+		&i.WarrantyPeriod,
+		&i.Image,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
+	)
+	return i, err
+}
+
+const setProductStatus = `-- name: SetProductStatus :execrows
+UPDATE products
+SET
+  status      = $1,
+  archived_at = CASE WHEN $1 = 'archived' THEN COALESCE(archived_at, NOW()) END,
+  updated_at  = NOW()
+WHERE id = $2 OR parent_id = $2
+`
+
+type SetProductStatusParams struct {
+	Status string `json:"status"`
+	ID     int32  `json:"id"`
+}
+
+// Variants follow the status of their parent.
+func (q *Queries) SetProductStatus(ctx context.Context, arg SetProductStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setProductStatus, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const syncProductVariants = `-- name: SyncProductVariants :exec
+UPDATE products
+SET
+  name        = $2,
+  category_id = $3,
+  image       = $4,
+  labor_cost  = $5,
+  stone_cost  = $6,
+  markup_rate = $7,
+  gold_type   = $8,
+  alloy_id    = $9,
+  updated_at  = NOW()
+WHERE parent_id = $1
+`
+
+type SyncProductVariantsParams struct {
+	ParentID   pgtype.Int4    `json:"parent_id"`
+	Name       pgtype.Text    `json:"name"`
+	CategoryID pgtype.Int4    `json:"category_id"`
+	Image      pgtype.Text    `json:"image"`
+	LaborCost  pgtype.Numeric `json:"labor_cost"`
+	StoneCost  pgtype.Numeric `json:"stone_cost"`
+	MarkupRate pgtype.Numeric `json:"markup_rate"`
+	GoldType   pgtype.Int4    `json:"gold_type"`
+	AlloyID    pgtype.Int4    `json:"alloy_id"`
+}
+
+func (q *Queries) SyncProductVariants(ctx context.Context, arg SyncProductVariantsParams) error {
+	_, err := q.db.Exec(ctx, syncProductVariants,
+		arg.ParentID,
+		arg.Name,
+		arg.CategoryID,
+		arg.Image,
+		arg.LaborCost,
+		arg.StoneCost,
+		arg.MarkupRate,
+		arg.GoldType,
+		arg.AlloyID,
+	)
+	return err
+}
+
 const updateProductByCode = `-- name: UpdateProductByCode :one
 UPDATE products
 SET
@@ -212,27 +1052,39 @@ SET
   warranty_period   = COALESCE($10, warranty_period),
   image             = COALESCE($11, image),
   stock             = COALESCE($12, stock),
+  alloy_id          = CASE WHEN $13::int IS NULL THEN alloy_id
+                      ELSE NULLIF($13::int, 0) END,
+  reorder_point     = CASE WHEN $14::bool THEN NULL
+                      ELSE COALESCE($15::int, reorder_point) END,
+  reorder_quantity  = CASE WHEN $14::bool THEN NULL
+                      ELSE COALESCE($16::int, reorder_quantity) END,
   updated_at        = NOW()
-WHERE code = $13
-RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at
+WHERE code = $17
+RETURNING id, name, code, category_id, stock, buy_turn, weight, gold_price_at_time, labor_cost, stone_cost, markup_rate, selling_price, warranty_period, image, created_at, updated_at, gold_type, parent_id, size, status, archived_at, reorder_point, reorder_quantity, alloy_id
 `
 
 type UpdateProductByCodeParams struct {
-	Name            pgtype.Text    `json:"name"`
-	CategoryID      pgtype.Int4    `json:"category_id"`
-	Weight          pgtype.Numeric `json:"weight"`
-	GoldPriceAtTime pgtype.Numeric `json:"gold_price_at_time"`
-	LaborCost       pgtype.Numeric `json:"labor_cost"`
-	StoneCost       pgtype.Numeric `json:"stone_cost"`
-	BuyTurn         pgtype.Int4    `json:"buy_turn"`
-	MarkupRate      pgtype.Numeric `json:"markup_rate"`
-	SellingPrice    pgtype.Numeric `json:"selling_price"`
-	WarrantyPeriod  pgtype.Int4    `json:"warranty_period"`
-	Image           pgtype.Text    `json:"image"`
-	Stock           pgtype.Int4    `json:"stock"`
-	Code            string         `json:"code"`
+	Name              pgtype.Text    `json:"name"`
+	CategoryID        pgtype.Int4    `json:"category_id"`
+	Weight            pgtype.Numeric `json:"weight"`
+	GoldPriceAtTime   pgtype.Numeric `json:"gold_price_at_time"`
+	LaborCost         pgtype.Numeric `json:"labor_cost"`
+	StoneCost         pgtype.Numeric `json:"stone_cost"`
+	BuyTurn           pgtype.Int4    `json:"buy_turn"`
+	MarkupRate        pgtype.Numeric `json:"markup_rate"`
+	SellingPrice      pgtype.Numeric `json:"selling_price"`
+	WarrantyPeriod    pgtype.Int4    `json:"warranty_period"`
+	Image             pgtype.Text    `json:"image"`
+	Stock             pgtype.Int4    `json:"stock"`
+	AlloyID           pgtype.Int4    `json:"alloy_id"`
+	ClearReorderPoint bool           `json:"clear_reorder_point"`
+	ReorderPoint      pgtype.Int4    `json:"reorder_point"`
+	ReorderQuantity   pgtype.Int4    `json:"reorder_quantity"`
+	Code              string         `json:"code"`
 }
 
+// NULL keeps the current value, clear_reorder_point removes the low stock alert
+// and an alloy_id of 0 removes the alloy.
 func (q *Queries) UpdateProductByCode(ctx context.Context, arg UpdateProductByCodeParams) (Product, error) {
 	row := q.db.QueryRow(ctx, updateProductByCode,
 		arg.Name,
@@ -247,6 +1099,10 @@ func (q *Queries) UpdateProductByCode(ctx context.Context, arg UpdateProductByCo
 		arg.WarrantyPeriod,
 		arg.Image,
 		arg.Stock,
+		arg.AlloyID,
+		arg.ClearReorderPoint,
+		arg.ReorderPoint,
+		arg.ReorderQuantity,
 		arg.Code,
 	)
 	var i Product
@@ -267,6 +1123,14 @@ func (q *Queries) UpdateProductByCode(ctx context.Context, arg UpdateProductByCo
 		&i.Image,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.GoldType,
+		&i.ParentID,
+		&i.Size,
+		&i.Status,
+		&i.ArchivedAt,
+		&i.ReorderPoint,
+		&i.ReorderQuantity,
+		&i.AlloyID,
 	)
 	return i, err
 }
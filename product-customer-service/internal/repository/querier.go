@@ -6,32 +6,171 @@ package repository
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddPurchaseOrderLineReceived(ctx context.Context, arg AddPurchaseOrderLineReceivedParams) (PurchaseOrderLine, error)
+	AddStocktakeCount(ctx context.Context, arg AddStocktakeCountParams) (StocktakeCount, error)
+	// Keeps the row so that financial records still point to a customer.
+	AnonymizeCustomer(ctx context.Context, arg AnonymizeCustomerParams) (Customer, error)
+	ApproveStocktakeSession(ctx context.Context, arg ApproveStocktakeSessionParams) (StocktakeSession, error)
+	ClearPrimaryProductImage(ctx context.Context, productID pgtype.Int4) error
+	CountAvailableProductSerials(ctx context.Context, productID int32) (int64, error)
+	CountChildCategories(ctx context.Context, parentID pgtype.Int4) (int64, error)
+	CountCustomerNotes(ctx context.Context, customerID int32) (int64, error)
+	CountCustomers(ctx context.Context, arg CountCustomersParams) (int64, error)
+	CountLowStockProducts(ctx context.Context, categoryID pgtype.Int4) (int64, error)
+	// History that prevents a hard delete of the products.
+	CountProductReferences(ctx context.Context, ids []int32) (CountProductReferencesRow, error)
+	// Same filters as ListProducts.
+	CountProducts(ctx context.Context, arg CountProductsParams) (int64, error)
+	CountProductsInCategory(ctx context.Context, categoryID pgtype.Int4) (int64, error)
+	CountPurchaseOrders(ctx context.Context, arg CountPurchaseOrdersParams) (int64, error)
+	CountSuppliers(ctx context.Context, activeOnly bool) (int64, error)
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error)
+	CreateCustomerNote(ctx context.Context, arg CreateCustomerNoteParams) (CustomerNote, error)
+	CreateGoodsReceipt(ctx context.Context, arg CreateGoodsReceiptParams) (GoodsReceipt, error)
+	CreateGoodsReceiptLine(ctx context.Context, arg CreateGoodsReceiptLineParams) (GoodsReceiptLine, error)
 	CreateOrderRecord(ctx context.Context, arg CreateOrderRecordParams) (OrderRecord, error)
+	CreatePrivacyRequest(ctx context.Context, arg CreatePrivacyRequestParams) (PrivacyRequest, error)
 	CreateProduct(ctx context.Context, arg CreateProductParams) (Product, error)
-	CreateProductCategory(ctx context.Context, name string) (ProductCategory, error)
+	CreateProductCategory(ctx context.Context, arg CreateProductCategoryParams) (ProductCategory, error)
+	CreateProductImage(ctx context.Context, arg CreateProductImageParams) (ProductImage, error)
+	CreateProductImageRendition(ctx context.Context, arg CreateProductImageRenditionParams) (ProductImageRendition, error)
+	CreateProductSerial(ctx context.Context, arg CreateProductSerialParams) (ProductSerial, error)
+	CreateProductSerialEvent(ctx context.Context, arg CreateProductSerialEventParams) (ProductSerialEvent, error)
+	CreateProductStone(ctx context.Context, arg CreateProductStoneParams) (ProductStone, error)
+	CreatePurchaseOrder(ctx context.Context, arg CreatePurchaseOrderParams) (PurchaseOrder, error)
+	CreatePurchaseOrderLine(ctx context.Context, arg CreatePurchaseOrderLineParams) (PurchaseOrderLine, error)
+	CreateSegment(ctx context.Context, arg CreateSegmentParams) (CustomerSegment, error)
+	CreateStockMovement(ctx context.Context, arg CreateStockMovementParams) (StockMovement, error)
+	CreateStocktakeSession(ctx context.Context, arg CreateStocktakeSessionParams) (StocktakeSession, error)
+	CreateSupplier(ctx context.Context, arg CreateSupplierParams) (Supplier, error)
 	DeleteCustomer(ctx context.Context, id int32) error
+	DeleteCustomerNote(ctx context.Context, id int32) error
+	DeleteCustomerNotes(ctx context.Context, customerID int32) (int64, error)
+	// Services that failed answer again when the request is sent again.
+	DeleteFailedPrivacyRequestAcks(ctx context.Context, requestID int32) error
 	DeleteOrderRecord(ctx context.Context, arg DeleteOrderRecordParams) (OrderRecord, error)
+	// Removes the product with its variants.
 	DeleteProduct(ctx context.Context, id int32) error
 	DeleteProductCategory(ctx context.Context, id int32) error
+	// Removes the images left out of the gallery, returns their renditions so the
+	// stored files can be deleted too.
+	DeleteProductImagesExcept(ctx context.Context, arg DeleteProductImagesExceptParams) ([]ProductImageRendition, error)
+	DeleteProductStones(ctx context.Context, productID int32) error
+	// Removes the members left out of keep_ids and returns their uuid.
+	DeleteSegmentMembers(ctx context.Context, arg DeleteSegmentMembersParams) ([]uuid.UUID, error)
+	DeleteStockMovementsByProducts(ctx context.Context, dollar_1 []int32) error
+	// Spend and categories count the purchases of the last window_days, the
+	// last visit looks at the whole history. Categories include their sub
+	// categories.
+	EvaluateSegment(ctx context.Context, arg EvaluateSegmentParams) ([]EvaluateSegmentRow, error)
 	GetCustomerByID(ctx context.Context, id int32) (Customer, error)
+	// Matches any format of the number, e.g. +84912345678 finds 0912345678.
 	GetCustomerByPhone(ctx context.Context, phone string) (Customer, error)
+	GetCustomerByUUID(ctx context.Context, uuid uuid.UUID) (Customer, error)
+	GetCustomerNote(ctx context.Context, id int32) (CustomerNote, error)
+	GetCustomerPurchaseStats(ctx context.Context, customerID uuid.UUID) (GetCustomerPurchaseStatsRow, error)
+	GetLatestPrivacyRequestForUpdate(ctx context.Context, arg GetLatestPrivacyRequestForUpdateParams) (PrivacyRequest, error)
+	GetNextProductImageSortOrder(ctx context.Context, productID pgtype.Int4) (int32, error)
 	GetOrderRecord(ctx context.Context, arg GetOrderRecordParams) (OrderRecord, error)
+	GetOrderRecordByOrderAndProduct(ctx context.Context, arg GetOrderRecordByOrderAndProductParams) (OrderRecord, error)
+	GetPrivacyRequest(ctx context.Context, id int32) (PrivacyRequest, error)
+	GetPrivacyRequestForUpdate(ctx context.Context, id int32) (PrivacyRequest, error)
+	GetProductByCode(ctx context.Context, code string) (Product, error)
 	GetProductByID(ctx context.Context, id int32) (Product, error)
 	GetProductCategoryByID(ctx context.Context, id int32) (ProductCategory, error)
 	GetProductCategoryByName(ctx context.Context, name string) (ProductCategory, error)
+	GetProductForUpdate(ctx context.Context, id int32) (Product, error)
+	GetProductLedgerBalance(ctx context.Context, productID int32) (int32, error)
+	GetProductSerialByNumber(ctx context.Context, serialNumber string) (ProductSerial, error)
+	GetProductsByCodes(ctx context.Context, dollar_1 []string) ([]Product, error)
 	GetProductsById(ctx context.Context, dollar_1 []int32) ([]Product, error)
+	GetPurchaseOrder(ctx context.Context, id int32) (PurchaseOrder, error)
+	GetPurchaseOrderForUpdate(ctx context.Context, id int32) (PurchaseOrder, error)
+	GetSegment(ctx context.Context, id int32) (CustomerSegment, error)
+	GetSegmentForUpdate(ctx context.Context, id int32) (CustomerSegment, error)
+	GetStocktakeSession(ctx context.Context, id int32) (StocktakeSession, error)
+	GetSupplierByID(ctx context.Context, id int32) (Supplier, error)
+	ListCustomerFavouriteCategories(ctx context.Context, arg ListCustomerFavouriteCategoriesParams) ([]ListCustomerFavouriteCategoriesRow, error)
+	ListCustomerNotes(ctx context.Context, arg ListCustomerNotesParams) ([]CustomerNote, error)
+	ListCustomerPurchases(ctx context.Context, arg ListCustomerPurchasesParams) ([]ListCustomerPurchasesRow, error)
+	// Search matches name/email without diacritics, or part of the phone number.
+	// A customer must carry all the given tags, occasion_month matches the
+	// birthday or the anniversary.
 	ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Customer, error)
+	ListCustomersByIDs(ctx context.Context, ids []int32) ([]Customer, error)
+	ListGoodsReceiptLines(ctx context.Context, dollar_1 []int32) ([]GoodsReceiptLine, error)
+	ListGoodsReceipts(ctx context.Context, purchaseOrderID int32) ([]GoodsReceipt, error)
+	// Sellable products at or below their reorder point, emptiest first.
+	ListLowStockProducts(ctx context.Context, arg ListLowStockProductsParams) ([]Product, error)
 	ListOrderRecords(ctx context.Context, arg ListOrderRecordsParams) ([]OrderRecord, error)
+	ListPrivacyRequestAcks(ctx context.Context, requestID int32) ([]PrivacyRequestAck, error)
 	ListProductCategories(ctx context.Context) ([]ProductCategory, error)
+	ListProductImageRenditions(ctx context.Context, dollar_1 []int32) ([]ProductImageRendition, error)
+	ListProductImages(ctx context.Context, productID pgtype.Int4) ([]ProductImage, error)
+	ListProductSerialEvents(ctx context.Context, serialID int32) ([]ProductSerialEvent, error)
+	ListProductSerials(ctx context.Context, productID int32) ([]ProductSerial, error)
+	ListProductStonesByProductIDs(ctx context.Context, dollar_1 []int32) ([]ProductStone, error)
+	ListProductVariantsByParentIDs(ctx context.Context, dollar_1 []int32) ([]Product, error)
+	// Top level products only, variants are loaded under their parent.
+	// Text search matches name/code without diacritics, by substring or trigram similarity.
+	// Archived products are hidden unless asked for.
+	// Stone filters match the stones of the product or of any of its variants.
 	ListProducts(ctx context.Context, arg ListProductsParams) ([]Product, error)
+	ListProductsByCategory(ctx context.Context, categoryID pgtype.Int4) ([]Product, error)
+	// Variants are listed right after their parent, archived products are left out.
+	ListProductsForExport(ctx context.Context, categoryID pgtype.Int4) ([]Product, error)
+	ListPurchaseOrderLines(ctx context.Context, purchaseOrderID int32) ([]PurchaseOrderLine, error)
+	ListPurchaseOrders(ctx context.Context, arg ListPurchaseOrdersParams) ([]PurchaseOrder, error)
+	ListSegmentMemberIDs(ctx context.Context, segmentID int32) ([]int32, error)
+	ListSegmentMembers(ctx context.Context, arg ListSegmentMembersParams) ([]CustomerSegmentMember, error)
+	ListSegments(ctx context.Context) ([]CustomerSegment, error)
+	ListStockMovementsByProduct(ctx context.Context, arg ListStockMovementsByProductParams) ([]StockMovement, error)
+	// Only counted products are reconciled, anything nobody scanned keeps its stock.
+	ListStocktakeVariances(ctx context.Context, sessionID int32) ([]ListStocktakeVariancesRow, error)
+	ListSuppliers(ctx context.Context, arg ListSuppliersParams) ([]Supplier, error)
+	// No row when the piece was not sold or is already bought back.
+	MarkProductSerialBoughtBack(ctx context.Context, serialNumber string) (ProductSerial, error)
+	MarkProductSerialSold(ctx context.Context, arg MarkProductSerialSoldParams) (ProductSerial, error)
+	MoveCustomerNotes(ctx context.Context, arg MoveCustomerNotesParams) (int64, error)
+	// Re-points the orders of a merged customer.
+	MoveOrderRecords(ctx context.Context, arg MoveOrderRecordsParams) (int64, error)
+	// Goods receipt: adds the stock and stores the averaged landed cost.
+	ReceiveProductStock(ctx context.Context, arg ReceiveProductStockParams) (Product, error)
+	RejectStocktakeSession(ctx context.Context, arg RejectStocktakeSessionParams) (StocktakeSession, error)
+	// No row when the product holds less than the quantity sold.
+	SellProductStock(ctx context.Context, arg SellProductStockParams) (Product, error)
+	SetCustomerTags(ctx context.Context, arg SetCustomerTagsParams) (Customer, error)
+	// The payload is only kept while the request may still be sent again.
+	SetPrivacyRequestStatus(ctx context.Context, arg SetPrivacyRequestStatusParams) (PrivacyRequest, error)
+	// Variants share the image of their parent.
+	SetProductImageUrl(ctx context.Context, arg SetProductImageUrlParams) error
+	// Reprices the product off the given gold price.
+	SetProductPrice(ctx context.Context, arg SetProductPriceParams) (Product, error)
+	// Variants follow the status of their parent.
+	SetProductStatus(ctx context.Context, arg SetProductStatusParams) (int64, error)
+	SetSegmentComputed(ctx context.Context, arg SetSegmentComputedParams) (CustomerSegment, error)
+	SubmitStocktakeSession(ctx context.Context, id int32) (StocktakeSession, error)
+	SyncProductVariants(ctx context.Context, arg SyncProductVariantsParams) error
 	UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) (Customer, error)
+	UpdateCustomerAttributes(ctx context.Context, arg UpdateCustomerAttributesParams) (Customer, error)
 	UpdateOrderRecord(ctx context.Context, arg UpdateOrderRecordParams) (OrderRecord, error)
+	// NULL keeps the current value, clear_reorder_point removes the low stock alert
+	// and an alloy_id of 0 removes the alloy.
 	UpdateProductByCode(ctx context.Context, arg UpdateProductByCodeParams) (Product, error)
 	UpdateProductCategory(ctx context.Context, arg UpdateProductCategoryParams) (ProductCategory, error)
+	// Attaches a free image or moves an image of the same product.
+	UpdateProductImagePosition(ctx context.Context, arg UpdateProductImagePositionParams) (int64, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, arg UpdatePurchaseOrderStatusParams) (PurchaseOrder, error)
+	UpdateSupplier(ctx context.Context, arg UpdateSupplierParams) (Supplier, error)
+	// A service may answer again after a redelivery, the last answer wins.
+	UpsertPrivacyRequestAck(ctx context.Context, arg UpsertPrivacyRequestAckParams) (PrivacyRequestAck, error)
+	UpsertSegmentMembers(ctx context.Context, arg UpsertSegmentMembersParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorize validates the bearer token of the incoming request and checks
+// that the caller has one of the given roles. It returns the caller's user ID.
+func (s *Service) authorize(ctx context.Context, roles ...string) (string, error) {
+	log := s.logger.With(zap.String("func", "authorize"))
+
+	token, err := bearerFromMD(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	valid, userID, role, err := s.adapter.authClient.Validate(ctx, token)
+	if err != nil || !valid {
+		log.Error("invalid access token", zap.Error(err))
+		return "", status.Error(codes.Unauthenticated, "unauthorised")
+	}
+	if !slices.Contains(roles, role) {
+		return "", status.Errorf(codes.PermissionDenied, "unauthorised role: %s", role)
+	}
+	return userID, nil
+}
+
+func bearerFromMD(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	vals := md["authorization"]
+	if len(vals) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	authHeader := vals[0]
+	if !strings.HasPrefix(strings.ToLower(authHeader), "bearer ") {
+		return "", status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	return strings.TrimSpace(authHeader[7:]), nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	"go.uber.org/zap"
+)
+
+// ConsumeBuybacks marks the serials bought back by market-service until ctx
+// is done
+func (s *Service) ConsumeBuybacks(ctx context.Context) {
+	log := s.logger.With(zap.String("func", "ConsumeBuybacks"))
+
+	subscriber, err := mq.NewSubscriber(mqconfig.RabbitMQConfig{
+		ConnStr:       s.cfg.RabbitMQURL,
+		ExchangeName:  mqconsts.EXCHANGE_MARKET_SERVICE,
+		ExchangeType:  mqconsts.EXCHANGE_TYPE_TOPIC,
+		SubscribeKeys: []string{mqconsts.TOPIC_BUYBACK_EXECUTED},
+		QueueName:     mqconsts.QUEUE_BUYBACK_EXECUTED_PRODUCT,
+	}, s.logger)
+	if err != nil {
+		log.Error("failed to init mq subscriber", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+
+	err = subscriber.Consume(func(body []byte) error {
+		var evt events.BuybackExecutedEvent
+		if err := mq.UnwrapEvent(body, &evt); err != nil {
+			// a malformed message will never succeed, drop it
+			log.Error("failed to decode buyback", zap.Error(err))
+			return nil
+		}
+		return s.recordBuyback(ctx, &evt)
+	})
+	if err != nil {
+		log.Error("mq subscriber exited", zap.Error(err))
+	}
+}
+
+// recordBuyback moves the serial of a buyback to bought_back, so it cannot
+// be bought back again. A redelivered event finds the serial already moved.
+func (s *Service) recordBuyback(ctx context.Context, evt *events.BuybackExecutedEvent) error {
+	log := s.logger.With(zap.String("func", "recordBuyback"), zap.Int32("buyback_id", evt.BuybackId))
+	if evt.SerialNumber == "" {
+		return nil
+	}
+
+	return s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		serial, err := q.MarkProductSerialBoughtBack(ctx, evt.SerialNumber)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				log.Warn("serial is not sold or already bought back", zap.String("serial", evt.SerialNumber))
+				return nil
+			}
+			return err
+		}
+		_, err = q.CreateProductSerialEvent(ctx, db.CreateProductSerialEventParams{
+			SerialID:    serial.ID,
+			EventType:   consts.SERIAL_EVENT_BOUGHT_BACK,
+			ReferenceID: pgtype.Int4{Int32: evt.BuybackId, Valid: true},
+			Note:        optionalText(evt.Disposition),
+			CreatedBy:   optionalText(evt.StaffId),
+		})
+		return err
+	})
+}
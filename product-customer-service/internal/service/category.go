@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// max depth of the category tree, also guards the parent walk against bad data
+const maxCategoryDepth = 10
+
+func (s *Service) ListProductCategories(ctx context.Context, req *api.ListProductCategoriesRequest) (*api.ListProductCategoriesResponse, error) {
+	// Call the sqlc query
+	categories, err := s.queries.ListProductCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+
+	// Map db models to API models
+	var res []*api.ProductCategory
+	for _, c := range categories {
+		res = append(res, s.categoryToProto(c))
+	}
+
+	return &api.ListProductCategoriesResponse{
+		Categories: res,
+	}, nil
+}
+
+func (s *Service) CreateProductCategory(ctx context.Context, req *api.CreateProductCategoryRequest) (*api.ProductCategoryResponse, error) {
+	log := s.logger.With(zap.String("func", "CreateProductCategory"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if err := validateCategory(req.Name, req.DefaultMarkupRate, req.DefaultWarrantyPeriod); err != nil {
+		return nil, err
+	}
+
+	parentID := pgtype.Int4{}
+	if req.ParentId != 0 {
+		if err := s.checkCategoryParent(ctx, 0, req.ParentId); err != nil {
+			return nil, err
+		}
+		parentID = utils.Int32(req.ParentId)
+	}
+
+	category, err := s.queries.CreateProductCategory(ctx, db.CreateProductCategoryParams{
+		Name:                  req.Name,
+		ParentID:              parentID,
+		DefaultMarkupRate:     optionalNumeric(req.DefaultMarkupRate),
+		DefaultWarrantyPeriod: optionalInt32(req.DefaultWarrantyPeriod),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category %s already exists", req.Name)
+		}
+		log.Error("failed to create category", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create category: %v", err)
+	}
+
+	return &api.ProductCategoryResponse{Category: s.categoryToProto(category)}, nil
+}
+
+func (s *Service) GetProductCategory(ctx context.Context, req *api.GetProductCategoryRequest) (*api.ProductCategoryResponse, error) {
+	category, err := s.queries.GetProductCategoryByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	return &api.ProductCategoryResponse{Category: s.categoryToProto(category)}, nil
+}
+
+func (s *Service) UpdateProductCategory(ctx context.Context, req *api.UpdateProductCategoryRequest) (*api.ProductCategoryResponse, error) {
+	log := s.logger.With(zap.String("func", "UpdateProductCategory"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if err := validateCategory(req.Name, req.DefaultMarkupRate, req.DefaultWarrantyPeriod); err != nil {
+		return nil, err
+	}
+
+	parentID := pgtype.Int4{}
+	if req.ParentId != 0 {
+		if err := s.checkCategoryParent(ctx, req.Id, req.ParentId); err != nil {
+			return nil, err
+		}
+		parentID = utils.Int32(req.ParentId)
+	}
+
+	category, err := s.queries.UpdateProductCategory(ctx, db.UpdateProductCategoryParams{
+		ID:                    req.Id,
+		Name:                  req.Name,
+		ParentID:              parentID,
+		DefaultMarkupRate:     optionalNumeric(req.DefaultMarkupRate),
+		DefaultWarrantyPeriod: optionalInt32(req.DefaultWarrantyPeriod),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "category %s already exists", req.Name)
+		}
+		log.Error("failed to update category", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update category: %v", err)
+	}
+
+	return &api.ProductCategoryResponse{Category: s.categoryToProto(category)}, nil
+}
+
+func (s *Service) DeleteProductCategory(ctx context.Context, req *api.DeleteProductCategoryRequest) (*api.DeleteProductCategoryResponse, error) {
+	log := s.logger.With(zap.String("func", "DeleteProductCategory"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.GetProductCategoryByID(ctx, req.Id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
+	}
+
+	products, err := s.queries.CountProductsInCategory(ctx, utils.Int32(req.Id))
+	if err != nil {
+		log.Error("failed to count products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count products: %v", err)
+	}
+	if products > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "category still has %d products", products)
+	}
+	children, err := s.queries.CountChildCategories(ctx, utils.Int32(req.Id))
+	if err != nil {
+		log.Error("failed to count sub categories", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count sub categories: %v", err)
+	}
+	if children > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "category still has %d sub categories", children)
+	}
+
+	if err := s.queries.DeleteProductCategory(ctx, req.Id); err != nil {
+		log.Error("failed to delete category", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete category: %v", err)
+	}
+
+	return &api.DeleteProductCategoryResponse{Success: true}, nil
+}
+
+// checkCategoryParent makes sure the parent exists and is not the category
+// itself or one of its descendants
+func (s *Service) checkCategoryParent(ctx context.Context, categoryID, parentID int32) error {
+	id := parentID
+	for depth := 0; ; depth++ {
+		if id == categoryID {
+			return status.Error(codes.InvalidArgument, "a category cannot be its own parent")
+		}
+		if depth >= maxCategoryDepth {
+			return status.Errorf(codes.InvalidArgument, "category tree is deeper than %d levels", maxCategoryDepth)
+		}
+		parent, err := s.queries.GetProductCategoryByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.InvalidArgument, "parent category not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get parent category: %v", err)
+		}
+		if !parent.ParentID.Valid {
+			return nil
+		}
+		id = parent.ParentID.Int32
+	}
+}
+
+func validateCategory(name string, markupRate float64, warrantyPeriod int32) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	if markupRate < 0 || markupRate >= 1000 {
+		return status.Error(codes.InvalidArgument, "invalid default markup rate")
+	}
+	if warrantyPeriod < 0 {
+		return status.Error(codes.InvalidArgument, "invalid default warranty period")
+	}
+	return nil
+}
+
+// applyCategoryDefaults fills the markup rate and warranty left empty on a new product
+func applyCategoryDefaults(category db.ProductCategory, markupRate *float64, warrantyPeriod *int32) {
+	if *markupRate == 0 && category.DefaultMarkupRate.Valid {
+		*markupRate = utils.NumericToFloat64(category.DefaultMarkupRate)
+	}
+	if *warrantyPeriod == 0 && category.DefaultWarrantyPeriod.Valid {
+		*warrantyPeriod = category.DefaultWarrantyPeriod.Int32
+	}
+}
+
+func (s *Service) categoryToProto(c db.ProductCategory) *api.ProductCategory {
+	return &api.ProductCategory{
+		Id:                    c.ID,
+		Name:                  c.Name,
+		ParentId:              c.ParentID.Int32,
+		DefaultMarkupRate:     utils.NumericToFloat64(c.DefaultMarkupRate),
+		DefaultWarrantyPeriod: c.DefaultWarrantyPeriod.Int32,
+	}
+}
+
+func optionalNumeric(v float64) pgtype.Numeric {
+	if v == 0 {
+		return pgtype.Numeric{}
+	}
+	return utils.ToNumeric(v)
+}
+
+func optionalInt32(v int32) pgtype.Int4 {
+	return pgtype.Int4{Int32: v, Valid: v != 0}
+}
+
+// nullableInt32 maps an optional proto field, 0 is a value and nil is NULL
+func nullableInt32(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func int32Ptr(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int32
+}
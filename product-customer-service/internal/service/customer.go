@@ -1,37 +1,55 @@
 package service
 
 import (
+	"cmp"
 	"context"
-	"database/sql"
-	"fmt"
+	"errors"
+	"strings"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
 	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
 	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// customers published per query by BackfillCustomerKeys
+const backfillBatchSize = 500
+
 // ----- CreateCustomer -----
 func (s *Service) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
+	phone := normalizePhone(req.Phone)
+	if phone == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone is required")
+	}
+	if err := s.checkDuplicateCustomer(ctx, 0, phone); err != nil {
+		return nil, err
+	}
+
 	customer, err := s.queries.CreateCustomer(ctx, db.CreateCustomerParams{
 		Name:    req.Name,
-		Phone:   req.Phone,
-		Email:   pgtype.Text{String: req.Email},
-		Address: pgtype.Text{String: req.Address},
+		Phone:   pgtype.Text{String: phone, Valid: true},
+		Email:   optionalText(req.Email),
+		Address: optionalText(req.Address),
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.publishCustomer(mqconsts.TOPIC_CREATE_CUSTOMER, customer)
 
 	return &pb.CustomerResponse{Customer: s.mapCustomerToProto(customer)}, nil
 }
 
 // ----- GetCustomer -----
 func (s *Service) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (*pb.CustomerResponse, error) {
-	customer, err := s.queries.GetCustomerByPhone(ctx, req.Phone)
+	customer, err := findCustomer(ctx, s.queries, req.Phone)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("customer not found")
-		}
 		return nil, err
 	}
 	return &pb.CustomerResponse{Customer: s.mapCustomerToProto(customer)}, nil
@@ -39,34 +57,91 @@ func (s *Service) GetCustomer(ctx context.Context, req *pb.GetCustomerRequest) (
 
 // ----- ListCustomers -----
 func (s *Service) ListCustomers(ctx context.Context, req *pb.ListCustomersRequest) (*pb.ListCustomersResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if req.OccasionMonth < 0 || req.OccasionMonth > 12 {
+		return nil, status.Error(codes.InvalidArgument, "occasion month must be between 1 and 12")
+	}
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	filter := db.CountCustomersParams{
+		Search:            optionalText(req.Search),
+		Tags:              tags,
+		PreferredGoldType: optionalInt32(req.PreferredGoldType),
+		RingSize:          optionalText(strings.TrimSpace(req.RingSize)),
+		OccasionMonth:     optionalInt32(req.OccasionMonth),
+	}
+
 	customers, err := s.queries.ListCustomers(ctx, db.ListCustomersParams{
-		Limit:  req.Limit,
-		Offset: req.Page * req.Limit,
+		Search:            filter.Search,
+		Tags:              filter.Tags,
+		PreferredGoldType: filter.PreferredGoldType,
+		RingSize:          filter.RingSize,
+		OccasionMonth:     filter.OccasionMonth,
+		Limit:             limit,
+		Offset:            req.Page * limit,
 	})
 	if err != nil {
 		return nil, err
 	}
+	total, err := s.queries.CountCustomers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
 	pbCustomers := make([]*pb.Customer, 0, len(customers))
 	for _, c := range customers {
 		pbCustomers = append(pbCustomers, s.mapCustomerToProto(c))
 	}
 
-	return &pb.ListCustomersResponse{Customers: pbCustomers}, nil
+	return &pb.ListCustomersResponse{
+		Customers: pbCustomers,
+		Pagination: &pb.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}, nil
 }
 
 // ----- UpdateCustomer -----
+// The history of the customer is keyed by uuid and survives a phone change.
 func (s *Service) UpdateCustomer(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
+	phone := normalizePhone(req.Phone)
+	if phone == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone is required")
+	}
+	if err := s.checkDuplicateCustomer(ctx, req.Id, phone); err != nil {
+		return nil, err
+	}
+	previous, err := getCustomer(ctx, s.queries, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if previous.ErasedAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "customer is erased")
+	}
+
 	customer, err := s.queries.UpdateCustomer(ctx, db.UpdateCustomerParams{
 		ID:      req.Id,
 		Name:    req.Name,
-		Phone:   req.Phone,
-		Email:   pgtype.Text{String: req.Email},
-		Address: pgtype.Text{String: req.Address},
+		Phone:   pgtype.Text{String: phone, Valid: true},
+		Email:   optionalText(req.Email),
+		Address: optionalText(req.Address),
 	})
 	if err != nil {
 		return nil, err
 	}
+	s.publishCustomer(mqconsts.TOPIC_UPDATE_CUSTOMER, customer)
+	if previous.Phone != customer.Phone {
+		// records not re-keyed yet would be lost with the old phone
+		s.publishRekey(customer, previous.Phone.String)
+	}
 	return &pb.CustomerResponse{Customer: s.mapCustomerToProto(customer)}, nil
 }
 
@@ -76,5 +151,202 @@ func (s *Service) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomerRequ
 	if err != nil {
 		return nil, err
 	}
+	s.publish(&events.CustomerDeletedEvent{CustomerId: req.Id}, mqconsts.TOPIC_DELETE_CUSTOMER)
 	return &pb.DeleteCustomerResponse{Success: true}, nil
 }
+
+// ----- MergeCustomers -----
+// Orders are keyed by the customer uuid, loyalty-service re-points its
+// points and vouchers when it receives customer.merge_customer.
+func (s *Service) MergeCustomers(ctx context.Context, req *pb.MergeCustomersRequest) (*pb.MergeCustomersResponse, error) {
+	log := s.logger.With(zap.String("func", "MergeCustomers"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if req.SurvivorId == req.DuplicateId {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge a customer into itself")
+	}
+
+	var survivor, duplicate db.Customer
+	var moved int64
+	err := s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		var err error
+		if survivor, err = getCustomer(ctx, q, req.SurvivorId); err != nil {
+			return err
+		}
+		if duplicate, err = getCustomer(ctx, q, req.DuplicateId); err != nil {
+			return err
+		}
+
+		moved, err = q.MoveOrderRecords(ctx, db.MoveOrderRecordsParams{
+			ToCustomerID:   survivor.Uuid,
+			FromCustomerID: duplicate.Uuid,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to move order records: %v", err)
+		}
+
+		// keep what the survivor is missing
+		email, address := survivor.Email, survivor.Address
+		if email.String == "" {
+			email = duplicate.Email
+		}
+		if address.String == "" {
+			address = duplicate.Address
+		}
+		survivor, err = q.UpdateCustomer(ctx, db.UpdateCustomerParams{
+			ID:      survivor.ID,
+			Name:    survivor.Name,
+			Phone:   survivor.Phone,
+			Email:   email,
+			Address: address,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update customer: %v", err)
+		}
+		survivor, err = q.UpdateCustomerAttributes(ctx, db.UpdateCustomerAttributesParams{
+			ID:                survivor.ID,
+			Birthday:          cmp.Or(survivor.Birthday, duplicate.Birthday),
+			Anniversary:       cmp.Or(survivor.Anniversary, duplicate.Anniversary),
+			RingSize:          cmp.Or(survivor.RingSize, duplicate.RingSize),
+			PreferredGoldType: cmp.Or(survivor.PreferredGoldType, duplicate.PreferredGoldType),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update customer attributes: %v", err)
+		}
+		tags, err := normalizeTags(append(survivor.Tags, duplicate.Tags...))
+		if err != nil {
+			return err
+		}
+		survivor, err = q.SetCustomerTags(ctx, db.SetCustomerTagsParams{
+			ID:   survivor.ID,
+			Tags: tags,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update customer tags: %v", err)
+		}
+		_, err = q.MoveCustomerNotes(ctx, db.MoveCustomerNotesParams{
+			ToCustomerID:   survivor.ID,
+			FromCustomerID: duplicate.ID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to move customer notes: %v", err)
+		}
+
+		if err := q.DeleteCustomer(ctx, duplicate.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete customer: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to merge customers", zap.Error(err))
+		return nil, err
+	}
+	log.Info("customers merged",
+		zap.Int32("survivor_id", survivor.ID),
+		zap.Int32("merged_id", duplicate.ID),
+		zap.Int64("order_records", moved))
+
+	s.publish(&events.CustomersMergedEvent{
+		SurvivorId:    survivor.ID,
+		SurvivorPhone: survivor.Phone.String,
+		SurvivorUuid:  survivor.Uuid.String(),
+		MergedId:      duplicate.ID,
+		MergedPhone:   duplicate.Phone.String,
+		MergedUuid:    duplicate.Uuid.String(),
+	}, mqconsts.TOPIC_MERGE_CUSTOMER)
+	s.publishCustomer(mqconsts.TOPIC_UPDATE_CUSTOMER, survivor)
+	s.publish(&events.CustomerDeletedEvent{CustomerId: duplicate.ID}, mqconsts.TOPIC_DELETE_CUSTOMER)
+
+	return &pb.MergeCustomersResponse{
+		Customer:          s.mapCustomerToProto(survivor),
+		MovedOrderRecords: moved,
+	}, nil
+}
+
+// ----- BackfillCustomerKeys -----
+// Publishes customer.rekey_customer for every customer so that order-service
+// and loyalty-service move the records keyed by phone to the customer uuid.
+func (s *Service) BackfillCustomerKeys(ctx context.Context, req *pb.BackfillCustomerKeysRequest) (*pb.BackfillCustomerKeysResponse, error) {
+	log := s.logger.With(zap.String("func", "BackfillCustomerKeys"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if s.publisher() == nil {
+		return nil, status.Error(codes.Unavailable, "message bus is not available")
+	}
+
+	var published int64
+	for offset := int32(0); ; offset += backfillBatchSize {
+		customers, err := s.queries.ListCustomers(ctx, db.ListCustomersParams{
+			Tags:   []string{},
+			Limit:  backfillBatchSize,
+			Offset: offset,
+		})
+		if err != nil {
+			log.Error("failed to list customers", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to list customers: %v", err)
+		}
+		for _, c := range customers {
+			if c.ErasedAt.Valid {
+				continue
+			}
+			s.publishRekey(c, c.Phone.String)
+		}
+		published += int64(len(customers))
+		if len(customers) < backfillBatchSize {
+			break
+		}
+	}
+	log.Info("customer keys published", zap.Int64("customers", published))
+
+	return &pb.BackfillCustomerKeysResponse{Customers: published}, nil
+}
+
+// checkDuplicateCustomer rejects a phone number already used by another
+// customer in any format
+func (s *Service) checkDuplicateCustomer(ctx context.Context, id int32, phone string) error {
+	existing, err := s.queries.GetCustomerByPhone(ctx, phone)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return status.Errorf(codes.Internal, "failed to check phone: %v", err)
+	}
+	if existing.ID != id {
+		return status.Errorf(codes.AlreadyExists, "phone %s is already used by customer %d (%s)", phone, existing.ID, existing.Name)
+	}
+	return nil
+}
+
+// findCustomer looks a customer up by uuid or by phone number in any format
+func findCustomer(ctx context.Context, q db.Querier, id string) (db.Customer, error) {
+	var customer db.Customer
+	var err error
+	if uid, parseErr := uuid.Parse(id); parseErr == nil {
+		customer, err = q.GetCustomerByUUID(ctx, uid)
+	} else {
+		customer, err = q.GetCustomerByPhone(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.Customer{}, status.Errorf(codes.NotFound, "customer %s not found", id)
+		}
+		return db.Customer{}, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+	return customer, nil
+}
+
+func getCustomer(ctx context.Context, q db.Querier, id int32) (db.Customer, error) {
+	customer, err := q.GetCustomerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.Customer{}, status.Errorf(codes.NotFound, "customer %d not found", id)
+		}
+		return db.Customer{}, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+	return customer, nil
+}
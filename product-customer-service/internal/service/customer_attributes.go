@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dateLayout      = "2006-01-02"
+	maxRingSizeLen  = 10
+	maxTagLen       = 32
+	maxCustomerTags = 20
+)
+
+// UpdateCustomerAttributes sets the birthday, anniversary, ring size and
+// preferred gold type of a customer
+func (s *Service) UpdateCustomerAttributes(ctx context.Context, req *pb.UpdateCustomerAttributesRequest) (*pb.CustomerResponse, error) {
+	log := s.logger.With(zap.String("func", "UpdateCustomerAttributes"))
+	log.Info("req", zap.Any("req", req))
+
+	birthday, err := parseDate(req.Birthday)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid birthday: %v", err)
+	}
+	anniversary, err := parseDate(req.Anniversary)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid anniversary: %v", err)
+	}
+	ringSize := strings.TrimSpace(req.RingSize)
+	if len(ringSize) > maxRingSizeLen {
+		return nil, status.Errorf(codes.InvalidArgument, "ring size must be at most %d characters", maxRingSizeLen)
+	}
+
+	customer, err := s.queries.UpdateCustomerAttributes(ctx, db.UpdateCustomerAttributesParams{
+		ID:                req.Id,
+		Birthday:          birthday,
+		Anniversary:       anniversary,
+		RingSize:          optionalText(ringSize),
+		PreferredGoldType: optionalInt32(req.PreferredGoldType),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		log.Error("failed to update customer attributes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update customer attributes: %v", err)
+	}
+	s.publishCustomer(mqconsts.TOPIC_UPDATE_CUSTOMER, customer)
+
+	return &pb.CustomerResponse{Customer: s.mapCustomerToProto(customer)}, nil
+}
+
+// SetCustomerTags replaces the tags of a customer
+func (s *Service) SetCustomerTags(ctx context.Context, req *pb.SetCustomerTagsRequest) (*pb.CustomerResponse, error) {
+	log := s.logger.With(zap.String("func", "SetCustomerTags"))
+	log.Info("req", zap.Any("req", req))
+
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := s.queries.SetCustomerTags(ctx, db.SetCustomerTagsParams{
+		ID:   req.Id,
+		Tags: tags,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		log.Error("failed to set customer tags", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to set customer tags: %v", err)
+	}
+	s.publishCustomer(mqconsts.TOPIC_UPDATE_CUSTOMER, customer)
+
+	return &pb.CustomerResponse{Customer: s.mapCustomerToProto(customer)}, nil
+}
+
+// AddCustomerNote records a note by the calling staff member
+func (s *Service) AddCustomerNote(ctx context.Context, req *pb.AddCustomerNoteRequest) (*pb.CustomerNoteResponse, error) {
+	log := s.logger.With(zap.String("func", "AddCustomerNote"))
+
+	userID, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(req.Note)
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "note is required")
+	}
+	if _, err := getCustomer(ctx, s.queries, req.CustomerId); err != nil {
+		return nil, err
+	}
+
+	note, err := s.queries.CreateCustomerNote(ctx, db.CreateCustomerNoteParams{
+		CustomerID: req.CustomerId,
+		Note:       text,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		log.Error("failed to create customer note", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create customer note: %v", err)
+	}
+
+	return &pb.CustomerNoteResponse{Note: customerNoteToProto(note)}, nil
+}
+
+// ListCustomerNotes returns the notes of a customer, newest first
+func (s *Service) ListCustomerNotes(ctx context.Context, req *pb.ListCustomerNotesRequest) (*pb.ListCustomerNotesResponse, error) {
+	log := s.logger.With(zap.String("func", "ListCustomerNotes"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	notes, err := s.queries.ListCustomerNotes(ctx, db.ListCustomerNotesParams{
+		CustomerID: req.CustomerId,
+		Limit:      limit,
+		Offset:     req.Page * limit,
+	})
+	if err != nil {
+		log.Error("failed to list customer notes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list customer notes: %v", err)
+	}
+	total, err := s.queries.CountCustomerNotes(ctx, req.CustomerId)
+	if err != nil {
+		log.Error("failed to count customer notes", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count customer notes: %v", err)
+	}
+
+	resp := &pb.ListCustomerNotesResponse{
+		Pagination: &pb.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}
+	for _, n := range notes {
+		resp.Notes = append(resp.Notes, customerNoteToProto(n))
+	}
+	return resp, nil
+}
+
+// DeleteCustomerNote removes a note, only its author or a manager can
+func (s *Service) DeleteCustomerNote(ctx context.Context, req *pb.DeleteCustomerNoteRequest) (*pb.DeleteCustomerNoteResponse, error) {
+	log := s.logger.With(zap.String("func", "DeleteCustomerNote"))
+
+	userID, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.queries.GetCustomerNote(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "note not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get note: %v", err)
+	}
+	if note.CustomerID != req.CustomerId {
+		return nil, status.Error(codes.NotFound, "note not found")
+	}
+	if note.CreatedBy != userID {
+		if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "only the author or a manager can delete the note")
+		}
+	}
+
+	if err := s.queries.DeleteCustomerNote(ctx, note.ID); err != nil {
+		log.Error("failed to delete customer note", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to delete customer note: %v", err)
+	}
+	return &pb.DeleteCustomerNoteResponse{Success: true}, nil
+}
+
+// normalizeTags lower-cases, trims and de-duplicates tags
+func normalizeTags(tags []string) ([]string, error) {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if len([]rune(t)) > maxTagLen {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q must be at most %d characters", t, maxTagLen)
+		}
+		out = append(out, t)
+	}
+	slices.Sort(out)
+	out = slices.Compact(out)
+	if len(out) > maxCustomerTags {
+		return nil, status.Errorf(codes.InvalidArgument, "a customer can have at most %d tags", maxCustomerTags)
+	}
+	return out, nil
+}
+
+func parseDate(s string) (pgtype.Date, error) {
+	if s == "" {
+		return pgtype.Date{}, nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return pgtype.Date{}, err
+	}
+	return pgtype.Date{Time: t, Valid: true}, nil
+}
+
+func formatDate(d pgtype.Date) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Time.Format(dateLayout)
+}
+
+func customerNoteToProto(n db.CustomerNote) *pb.CustomerNote {
+	return &pb.CustomerNote{
+		Id:         n.ID,
+		CustomerId: n.CustomerID,
+		Note:       n.Note,
+		CreatedBy:  n.CreatedBy,
+		CreatedAt:  formatTimestamp(n.CreatedAt),
+	}
+}
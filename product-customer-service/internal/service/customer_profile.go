@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	loyalty "github.com/linhhuynhcoding/jss-microservices/rpc/gen/loyalty"
+	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	favouriteCategoryLimit = 3
+	// the counter screen still opens when loyalty-service is slow
+	loyaltyTimeout = 2 * time.Second
+)
+
+// GetCustomerProfile aggregates the purchase history of a customer from
+// order_record with the loyalty balance from loyalty-service
+func (s *Service) GetCustomerProfile(ctx context.Context, req *pb.GetCustomerProfileRequest) (*pb.GetCustomerProfileResponse, error) {
+	log := s.logger.With(zap.String("func", "GetCustomerProfile"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	customer, err := s.queries.GetCustomerByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "customer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get customer: %v", err)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	stats, err := s.queries.GetCustomerPurchaseStats(ctx, customer.Uuid)
+	if err != nil {
+		log.Error("failed to get purchase stats", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get purchase stats: %v", err)
+	}
+	categories, err := s.queries.ListCustomerFavouriteCategories(ctx, db.ListCustomerFavouriteCategoriesParams{
+		CustomerID: customer.Uuid,
+		Limit:      favouriteCategoryLimit,
+	})
+	if err != nil {
+		log.Error("failed to list favourite categories", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list favourite categories: %v", err)
+	}
+	purchases, err := s.queries.ListCustomerPurchases(ctx, db.ListCustomerPurchasesParams{
+		CustomerID: customer.Uuid,
+		Limit:      limit,
+		Offset:     req.Page * limit,
+	})
+	if err != nil {
+		log.Error("failed to list purchases", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list purchases: %v", err)
+	}
+
+	resp := &pb.GetCustomerProfileResponse{
+		Customer:      s.mapCustomerToProto(customer),
+		LifetimeSpend: utils.NumericToFloat64(stats.LifetimeSpend),
+		OrderCount:    stats.OrderCount,
+		PieceCount:    stats.PieceCount,
+		LastVisit:     formatTimestamp(stats.LastVisit),
+		UnpricedCount: stats.UnpricedCount,
+		// purchases are paged by order_record row, one per product of an order
+		Pagination: &pb.Pagination{
+			Total:   stats.PurchaseCount,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < stats.PurchaseCount,
+		},
+	}
+	for _, c := range categories {
+		resp.FavouriteCategories = append(resp.FavouriteCategories, &pb.CustomerCategoryStat{
+			CategoryId:   c.CategoryID,
+			CategoryName: c.CategoryName,
+			PieceCount:   c.PieceCount,
+			Spend:        utils.NumericToFloat64(c.Spend),
+		})
+	}
+	for _, p := range purchases {
+		resp.Purchases = append(resp.Purchases, customerPurchaseToProto(p))
+	}
+
+	loyaltyCtx, cancel := context.WithTimeout(ctx, loyaltyTimeout)
+	defer cancel()
+	points, err := s.adapter.loyaltyClient.GetCustomerTotalPoints(loyaltyCtx, &loyalty.GetCustomerTotalPointsRequest{
+		CustomerId: customer.Uuid.String(),
+	})
+	if err != nil {
+		log.Warn("loyalty balance unavailable", zap.Error(err))
+	} else {
+		resp.LoyaltyPoints = points.TotalPoints
+		resp.LoyaltyAvailable = true
+	}
+
+	return resp, nil
+}
+
+func customerPurchaseToProto(p db.ListCustomerPurchasesRow) *pb.CustomerPurchase {
+	serialNumbers := p.SerialNumbers
+	if serialNumbers == nil {
+		serialNumbers = []string{}
+	}
+	purchase := &pb.CustomerPurchase{
+		OrderId:       p.OrderID,
+		ProductId:     p.ProductID,
+		Code:          p.Code,
+		Name:          p.Name.String,
+		Image:         p.Image.String,
+		CategoryId:    p.CategoryID.Int32,
+		GoldType:      p.GoldType.Int32,
+		Weight:        utils.NumericToFloat64(p.Weight),
+		Quantity:      p.Quantity,
+		SerialNumbers: serialNumbers,
+		Status:        p.Status,
+		PurchasedAt:   formatTimestamp(p.CreatedAt),
+	}
+	if p.UnitPrice.Valid {
+		purchase.UnitPrice = proto.Float64(utils.NumericToFloat64(p.UnitPrice))
+	}
+	return purchase
+}
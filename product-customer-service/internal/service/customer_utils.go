@@ -1,6 +1,8 @@
 package service
 
 import (
+	"strings"
+
 	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
 	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
 )
@@ -9,10 +11,36 @@ func (s *Service) mapCustomerToProto(c db.Customer) *pb.Customer {
 	return &pb.Customer{
 		Id:        int32(c.ID),
 		Name:      c.Name,
-		Phone:     c.Phone,
+		Phone:     c.Phone.String,
 		Email:     c.Email.String,
 		Address:   c.Address.String,
 		CreatedAt: c.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt: c.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+
+		Birthday:          formatDate(c.Birthday),
+		Anniversary:       formatDate(c.Anniversary),
+		RingSize:          c.RingSize.String,
+		PreferredGoldType: c.PreferredGoldType.Int32,
+		Tags:              c.Tags,
+		Uuid:              c.Uuid.String(),
+	}
+}
+
+// normalizePhone reduces a phone number to the local form stored in
+// customers.phone, e.g. "+84 912 345 678" -> "0912345678". It must stay in
+// sync with f_normalize_phone() in the database.
+func normalizePhone(phone string) string {
+	p := strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '+' {
+			return r
+		}
+		return -1
+	}, phone)
+	switch {
+	case strings.HasPrefix(p, "+84"):
+		return "0" + strings.TrimPrefix(p[3:], "0")
+	case strings.HasPrefix(p, "84") && len(p) == 11:
+		return "0" + p[2:]
 	}
+	return p
 }
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// stockChange is a ledger movement with the product stock after it
+type stockChange struct {
+	product  db.Product
+	movement db.StockMovement
+}
+
+// publish sends the event on EXCHANGE_PRODUCT_SERVICE. Events are best effort:
+// they are sent after the change is committed and a failure is only logged.
+func (s *Service) publish(evt proto.Message, topic string) {
+	publisher := s.publisher()
+	if publisher == nil {
+		return
+	}
+	if err := publisher.SendMessage(evt, topic); err != nil {
+		s.logger.Error("failed to publish event", zap.String("topic", topic), zap.Error(err))
+		s.dropPublisher(publisher)
+	}
+}
+
+// publishProducts sends product.create_product or product.update_product
+func (s *Service) publishProducts(topic string, products ...db.Product) {
+	for _, p := range products {
+		s.publish(&events.ProductChangedEvent{Product: productToEvent(p)}, topic)
+	}
+}
+
+// publishProductByID sends product.update_product after a change made through
+// another table, e.g. the primary image
+func (s *Service) publishProductByID(ctx context.Context, id int32) {
+	product, err := s.queries.GetProductByID(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get product", zap.Int32("id", id), zap.Error(err))
+		return
+	}
+	s.publishProducts(mqconsts.TOPIC_UPDATE_PRODUCT, product)
+}
+
+// publishProductTree sends the product and, for a parent, all its variants as
+// a status change applies to the whole tree
+func (s *Service) publishProductTree(ctx context.Context, topic string, product db.Product) {
+	s.publishProducts(topic, product)
+	if product.ParentID.Valid {
+		return
+	}
+	variants, err := s.queries.ListProductVariantsByParentIDs(ctx, []int32{product.ID})
+	if err != nil {
+		s.logger.Error("failed to list variants", zap.Int32("id", product.ID), zap.Error(err))
+		return
+	}
+	s.publishProducts(topic, variants...)
+}
+
+// publishProductDeleted sends product.delete_product for the product and its variants
+func (s *Service) publishProductDeleted(ctx context.Context, product db.Product, hard bool) {
+	products := []db.Product{product}
+	if !product.ParentID.Valid {
+		variants, err := s.queries.ListProductVariantsByParentIDs(ctx, []int32{product.ID})
+		if err != nil {
+			s.logger.Error("failed to list variants", zap.Int32("id", product.ID), zap.Error(err))
+		}
+		products = append(products, variants...)
+	}
+	for _, p := range products {
+		s.publish(&events.ProductDeletedEvent{ProductId: p.ID, Code: p.Code, Hard: hard}, mqconsts.TOPIC_DELETE_PRODUCT)
+	}
+}
+
+// publishStockChanges sends product.update_stock for each movement
+func (s *Service) publishStockChanges(changes ...stockChange) {
+	for _, c := range changes {
+		s.publish(&events.ProductStockChangedEvent{
+			ProductId:    c.product.ID,
+			Code:         c.product.Code,
+			Quantity:     c.movement.Quantity,
+			Stock:        c.product.Stock.Int32,
+			MovementType: c.movement.MovementType,
+			ReferenceId:  c.movement.ReferenceID.Int32,
+		}, mqconsts.TOPIC_UPDATE_STOCK)
+	}
+}
+
+// publishCustomer sends customer.create_customer or customer.update_customer
+func (s *Service) publishCustomer(topic string, c db.Customer) {
+	s.publish(&events.CustomerChangedEvent{
+		Customer: &events.Customer{
+			Id:        c.ID,
+			Name:      c.Name,
+			Phone:     c.Phone.String,
+			Email:     c.Email.String,
+			Address:   c.Address.String,
+			CreatedAt: timestamppb.New(c.CreatedAt.Time),
+			UpdatedAt: timestamppb.New(c.UpdatedAt.Time),
+
+			Birthday:          formatDate(c.Birthday),
+			Anniversary:       formatDate(c.Anniversary),
+			RingSize:          c.RingSize.String,
+			PreferredGoldType: c.PreferredGoldType.Int32,
+			Tags:              c.Tags,
+			Uuid:              c.Uuid.String(),
+		},
+	}, topic)
+}
+
+// publishRekey asks the other services to re-key the records of a customer
+// still keyed by one of the previous ids on the customer uuid
+func (s *Service) publishRekey(c db.Customer, previousIDs ...string) {
+	s.publish(&events.CustomerRekeyedEvent{
+		CustomerId:  c.ID,
+		Uuid:        c.Uuid.String(),
+		PreviousIds: previousIDs,
+	}, mqconsts.TOPIC_REKEY_CUSTOMER)
+}
+
+// stockedProducts returns the products updated by the movements
+func stockedProducts(changes []stockChange) []db.Product {
+	products := make([]db.Product, 0, len(changes))
+	for _, c := range changes {
+		products = append(products, c.product)
+	}
+	return products
+}
+
+func productToEvent(p db.Product) *events.Product {
+	return &events.Product{
+		Id:              p.ID,
+		Code:            p.Code,
+		Name:            p.Name.String,
+		CategoryId:      p.CategoryID.Int32,
+		ParentId:        p.ParentID.Int32,
+		Size:            p.Size.String,
+		GoldType:        p.GoldType.Int32,
+		Weight:          utils.NumericToFloat64(p.Weight),
+		GoldPriceAtTime: utils.NumericToFloat64(p.GoldPriceAtTime),
+		LaborCost:       utils.NumericToFloat64(p.LaborCost),
+		StoneCost:       utils.NumericToFloat64(p.StoneCost),
+		MarkupRate:      utils.NumericToFloat64(p.MarkupRate),
+		SellingPrice:    utils.NumericToFloat64(p.SellingPrice),
+		WarrantyPeriod:  p.WarrantyPeriod.Int32,
+		Image:           p.Image.String,
+		Stock:           p.Stock.Int32,
+		BuyTurn:         p.BuyTurn.Int32,
+		Status:          p.Status,
+		CreatedAt:       timestamppb.New(p.CreatedAt.Time),
+		UpdatedAt:       timestamppb.New(p.UpdatedAt.Time),
+	}
+}
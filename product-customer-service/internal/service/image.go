@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/product/pkg/imageproc"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rendition stored in products.image for clients that only read one url
+const (
+	primaryImageSize   = "large"
+	primaryImageFormat = imageproc.FormatJPEG
+)
+
+func (s *Service) ListProductImages(ctx context.Context, req *api.ListProductImagesRequest) (*api.ProductImagesResponse, error) {
+	if _, err := s.queries.GetProductByID(ctx, req.ProductId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+
+	images, err := s.productImages(ctx, s.queries, req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ProductImagesResponse{Images: images}, nil
+}
+
+// SetProductImages orders the gallery of a product, attaches uploaded images
+// and deletes the ones left out together with their stored files
+func (s *Service) SetProductImages(ctx context.Context, req *api.SetProductImagesRequest) (*api.ProductImagesResponse, error) {
+	log := s.logger.With(zap.String("func", "SetProductImages"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	primaryID := req.PrimaryImageId
+	if primaryID == 0 && len(req.ImageIds) > 0 {
+		primaryID = req.ImageIds[0]
+	}
+	seen := make(map[int32]bool, len(req.ImageIds))
+	for _, id := range req.ImageIds {
+		if seen[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "image %d is listed twice", id)
+		}
+		seen[id] = true
+	}
+	if primaryID != 0 && !seen[primaryID] {
+		return nil, status.Error(codes.InvalidArgument, "primary image must be one of the images")
+	}
+
+	var images []*api.ProductImage
+	var removed []db.ProductImageRendition
+	err := s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		product, err := q.GetProductByID(ctx, req.ProductId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "product not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get product: %v", err)
+		}
+		if product.ParentID.Valid {
+			return status.Error(codes.FailedPrecondition, "variants use the images of their parent product")
+		}
+
+		productID := utils.Int32(req.ProductId)
+		removed, err = q.DeleteProductImagesExcept(ctx, db.DeleteProductImagesExceptParams{
+			ProductID: productID,
+			KeepIds:   req.ImageIds,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete images: %v", err)
+		}
+		if err := q.ClearPrimaryProductImage(ctx, productID); err != nil {
+			return status.Errorf(codes.Internal, "failed to clear primary image: %v", err)
+		}
+		for i, id := range req.ImageIds {
+			rows, err := q.UpdateProductImagePosition(ctx, db.UpdateProductImagePositionParams{
+				ID:        id,
+				ProductID: productID,
+				SortOrder: int32(i),
+				IsPrimary: id == primaryID,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to update image %d: %v", id, err)
+			}
+			if rows == 0 {
+				return status.Errorf(codes.InvalidArgument, "image %d not found or belongs to another product", id)
+			}
+		}
+
+		var primary []db.ProductImageRendition
+		if primaryID != 0 {
+			primary, err = q.ListProductImageRenditions(ctx, []int32{primaryID})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list renditions: %v", err)
+			}
+		}
+		if err := s.setProductImageUrl(ctx, q, req.ProductId, primary); err != nil {
+			return err
+		}
+
+		images, err = s.productImages(ctx, q, req.ProductId)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to set product images", zap.Int32("product_id", req.ProductId), zap.Error(err))
+		return nil, err
+	}
+	// the files go once the rows are gone, a failure only leaves a stray file
+	s.deleteStoredRenditions(ctx, removed)
+	s.publishProductByID(ctx, req.ProductId)
+
+	return &api.ProductImagesResponse{Images: images}, nil
+}
+
+// productImages returns the ordered gallery of a product with its renditions
+func (s *Service) productImages(ctx context.Context, q db.Querier, productID int32) ([]*api.ProductImage, error) {
+	images, err := q.ListProductImages(ctx, utils.Int32(productID))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list images: %v", err)
+	}
+	if len(images) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, len(images))
+	for i, img := range images {
+		ids[i] = img.ID
+	}
+	renditions, err := q.ListProductImageRenditions(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list renditions: %v", err)
+	}
+	byImage := make(map[int32][]db.ProductImageRendition, len(images))
+	for _, r := range renditions {
+		byImage[r.ImageID] = append(byImage[r.ImageID], r)
+	}
+
+	res := make([]*api.ProductImage, len(images))
+	for i, img := range images {
+		res[i] = productImageToProto(img, byImage[img.ID])
+	}
+	return res, nil
+}
+
+// setProductImageUrl keeps products.image on the primary image, empty when
+// the product has no image left
+func (s *Service) setProductImageUrl(ctx context.Context, q db.Querier, productID int32, renditions []db.ProductImageRendition) error {
+	err := q.SetProductImageUrl(ctx, db.SetProductImageUrlParams{
+		ID:    productID,
+		Image: optionalText(primaryRenditionUrl(renditions)),
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update product image: %v", err)
+	}
+	return nil
+}
+
+// deleteStoredRenditions removes stored objects that are no longer referenced, best effort
+func (s *Service) deleteStoredRenditions(ctx context.Context, renditions []db.ProductImageRendition) {
+	for _, r := range renditions {
+		if err := s.adapter.storage.Delete(ctx, r.StorageKey); err != nil {
+			s.logger.Warn("failed to delete stored file", zap.String("key", r.StorageKey), zap.Error(err))
+		}
+	}
+}
+
+func primaryRenditionUrl(renditions []db.ProductImageRendition) string {
+	for _, r := range renditions {
+		if r.Size == primaryImageSize && r.Format == primaryImageFormat {
+			return r.Url
+		}
+	}
+	return ""
+}
+
+func renditionExt(format string) string {
+	if format == imageproc.FormatJPEG {
+		return "jpg"
+	}
+	return format
+}
+
+func productImageToProto(img db.ProductImage, renditions []db.ProductImageRendition) *api.ProductImage {
+	res := &api.ProductImage{
+		Id:        img.ID,
+		ProductId: img.ProductID.Int32,
+		SortOrder: img.SortOrder,
+		IsPrimary: img.IsPrimary,
+		Width:     img.Width,
+		Height:    img.Height,
+		CreatedAt: formatTimestamp(img.CreatedAt),
+	}
+	for _, r := range renditions {
+		res.Renditions = append(res.Renditions, &api.ImageRendition{
+			Size:   r.Size,
+			Format: r.Format,
+			Width:  r.Width,
+			Height: r.Height,
+			Url:    r.Url,
+		})
+	}
+	return res
+}
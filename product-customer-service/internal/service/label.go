@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/product/pkg/label"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	market_api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxLabelsPerRequest = 2000
+
+// GenerateLabels renders price tags for the given products, or the whole
+// category when no product is given
+func (s *Service) GenerateLabels(ctx context.Context, req *api.GenerateLabelsRequest) (*api.GenerateLabelsResponse, error) {
+	log := s.logger.With(zap.String("func", "GenerateLabels"))
+	log.Info("req", zap.Any("req", req))
+
+	var (
+		products []db.Product
+		err      error
+	)
+	switch {
+	case len(req.ProductIds) > 0:
+		products, err = s.queries.GetProductsById(ctx, req.ProductIds)
+	case req.CategoryId != 0:
+		products, err = s.queries.ListProductsByCategory(ctx, utils.Int32(req.CategoryId))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "product_ids or category_id is required")
+	}
+	if err != nil {
+		log.Error("failed to get products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get products: %v", err)
+	}
+	// archived products are off the shelf, they get no new tags
+	products = slices.DeleteFunc(products, func(p db.Product) bool {
+		return p.Status == consts.PRODUCT_ARCHIVED
+	})
+	if len(products) == 0 {
+		return nil, status.Error(codes.NotFound, "no products to print")
+	}
+
+	copies := int(req.Copies)
+	if copies <= 0 {
+		copies = 1
+	}
+	if len(products)*copies > maxLabelsPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument, "too many labels, max %d per request", maxLabelsPerRequest)
+	}
+
+	goldTypes := make(map[int32]string)
+	labels := make([]label.Label, 0, len(products)*copies)
+	for _, p := range products {
+		l := label.Label{
+			Code:     p.Code,
+			Name:     p.Name.String,
+			GoldType: s.goldTypeName(ctx, goldTypes, p.GoldType.Int32),
+			Weight:   utils.NumericToFloat64(p.Weight),
+			Price:    utils.NumericToFloat64(p.SellingPrice),
+		}
+		for i := 0; i < copies; i++ {
+			labels = append(labels, l)
+		}
+	}
+
+	fileName := fmt.Sprintf("labels_%s", time.Now().Format("20060102150405"))
+	if req.Format == api.LabelFormat_LABEL_FORMAT_ZPL {
+		return &api.GenerateLabelsResponse{
+			FileName:    fileName + ".zpl",
+			FileData:    label.RenderZPL(labels),
+			ContentType: "application/zpl",
+		}, nil
+	}
+
+	data, err := label.RenderPDF(labels)
+	if err != nil {
+		log.Error("failed to render labels", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to render labels: %v", err)
+	}
+	return &api.GenerateLabelsResponse{
+		FileName:    fileName + ".pdf",
+		FileData:    data,
+		ContentType: "application/pdf",
+	}, nil
+}
+
+// goldTypeName looks the gold type up in market-service once per request
+func (s *Service) goldTypeName(ctx context.Context, cache map[int32]string, goldType int32) string {
+	if goldType == 0 {
+		return ""
+	}
+	if name, ok := cache[goldType]; ok {
+		return name
+	}
+
+	name := ""
+	resp, err := s.adapter.marketClient.GetGoldPrice(ctx, &market_api.GetGoldPriceRequest{
+		Id: int64(goldType),
+	})
+	if err != nil {
+		s.logger.Warn("cannot get gold type", zap.Int32("gold_type", goldType), zap.Error(err))
+	} else {
+		name = resp.GetGoldPrice().GetGoldType()
+	}
+	cache[goldType] = name
+	return name
+}
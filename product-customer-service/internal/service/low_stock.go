@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ListLowStockProducts(ctx context.Context, req *api.ListLowStockProductsRequest) (*api.ListProductsResponse, error) {
+	log := s.logger.With(zap.String("func", "ListLowStockProducts"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	categoryID := optionalInt32(req.CategoryId)
+
+	products, err := s.queries.ListLowStockProducts(ctx, db.ListLowStockProductsParams{
+		CategoryID: categoryID,
+		Limit:      limit,
+		Offset:     req.Page * limit,
+	})
+	if err != nil {
+		log.Error("failed to list low stock products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list low stock products: %v", err)
+	}
+	total, err := s.queries.CountLowStockProducts(ctx, categoryID)
+	if err != nil {
+		log.Error("failed to count low stock products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count low stock products: %v", err)
+	}
+
+	resp := &api.ListProductsResponse{
+		Pagination: &api.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}
+	for _, p := range products {
+		resp.Products = append(resp.Products, s.productToProto(p))
+	}
+	return resp, nil
+}
+
+// lowStockCrossed reports whether the stock went from above the reorder point
+// to at or below it, so the alert fires once per crossing and not on every sale
+func lowStockCrossed(p db.Product, before int32) bool {
+	if !p.ReorderPoint.Valid || p.Status != consts.PRODUCT_ACTIVE {
+		return false
+	}
+	return before > p.ReorderPoint.Int32 && p.Stock.Int32 <= p.ReorderPoint.Int32
+}
+
+// publishLowStock sends product.low_stock for each product, call it after the
+// stock change is committed
+func (s *Service) publishLowStock(products ...db.Product) {
+	for _, p := range products {
+		s.publish(&events.ProductLowStockEvent{
+			ProductId:       p.ID,
+			Code:            p.Code,
+			Name:            p.Name.String,
+			Stock:           p.Stock.Int32,
+			ReorderPoint:    p.ReorderPoint.Int32,
+			ReorderQuantity: p.ReorderQuantity.Int32,
+		}, mqconsts.TOPIC_PRODUCT_LOW_STOCK)
+	}
+}
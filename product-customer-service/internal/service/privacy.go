@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// privacyServices must acknowledge a privacy request before it completes,
+// this service answers synchronously
+var privacyServices = []string{
+	mqconsts.SERVICE_PRODUCT_CUSTOMER,
+	mqconsts.SERVICE_ORDER,
+	mqconsts.SERVICE_LOYALTY,
+	mqconsts.SERVICE_NOTIFICATION,
+}
+
+// ExportCustomerData starts an export of everything held about a customer.
+// The data of this service is collected right away, the other services
+// answer over the message bus.
+func (s *Service) ExportCustomerData(ctx context.Context, req *pb.ExportCustomerDataRequest) (*pb.PrivacyRequestResponse, error) {
+	log := s.logger.With(zap.String("func", "ExportCustomerData"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	if s.publisher() == nil {
+		return nil, status.Error(codes.Unavailable, "message bus is not available")
+	}
+
+	customer, err := getCustomer(ctx, s.queries, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	data, records, err := s.exportCustomer(ctx, customer)
+	if err != nil {
+		log.Error("failed to export customer", zap.Error(err))
+		return nil, err
+	}
+	payload, err := privacyPayload(customer)
+	if err != nil {
+		return nil, err
+	}
+
+	var request db.PrivacyRequest
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		request, err = q.CreatePrivacyRequest(ctx, db.CreatePrivacyRequestParams{
+			Kind:        consts.PRIVACY_EXPORT,
+			CustomerID:  customer.ID,
+			RequestedBy: userID,
+			Payload:     payload,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create privacy request: %v", err)
+		}
+		_, err = q.UpsertPrivacyRequestAck(ctx, db.UpsertPrivacyRequestAckParams{
+			RequestID: request.ID,
+			Service:   mqconsts.SERVICE_PRODUCT_CUSTOMER,
+			Success:   true,
+			Data:      data,
+			Records:   records,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record export: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to create privacy request", zap.Error(err))
+		return nil, err
+	}
+
+	return s.sendPrivacyRequest(ctx, request)
+}
+
+// EraseCustomer anonymizes a customer. The customer row is kept without its
+// phone and the orders stay keyed by its uuid so that financial records stay
+// intact. The identifiers the other services need are stored on the request
+// before the customer is anonymized, a failed erasure is sent again from
+// there when the customer is erased again.
+func (s *Service) EraseCustomer(ctx context.Context, req *pb.EraseCustomerRequest) (*pb.PrivacyRequestResponse, error) {
+	log := s.logger.With(zap.String("func", "EraseCustomer"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	if s.publisher() == nil {
+		return nil, status.Error(codes.Unavailable, "message bus is not available")
+	}
+
+	var erased db.Customer
+	var request db.PrivacyRequest
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		erased = db.Customer{}
+		customer, err := getCustomer(ctx, q, req.Id)
+		if err != nil {
+			return err
+		}
+		if customer.ErasedAt.Valid {
+			request, err = retryPrivacyRequest(ctx, q, customer.ID, consts.PRIVACY_ERASE)
+			return err
+		}
+
+		payload, err := privacyPayload(customer)
+		if err != nil {
+			return err
+		}
+		request, err = q.CreatePrivacyRequest(ctx, db.CreatePrivacyRequestParams{
+			Kind:        consts.PRIVACY_ERASE,
+			CustomerID:  customer.ID,
+			RequestedBy: userID,
+			Payload:     payload,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create privacy request: %v", err)
+		}
+
+		notes, err := q.DeleteCustomerNotes(ctx, customer.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to delete customer notes: %v", err)
+		}
+		// order_record is keyed by uuid and keeps pointing to the customer
+		erased, err = q.AnonymizeCustomer(ctx, db.AnonymizeCustomerParams{
+			ID:   customer.ID,
+			Name: consts.ERASED_CUSTOMER_NAME,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to anonymize customer: %v", err)
+		}
+		_, err = q.UpsertPrivacyRequestAck(ctx, db.UpsertPrivacyRequestAckParams{
+			RequestID: request.ID,
+			Service:   mqconsts.SERVICE_PRODUCT_CUSTOMER,
+			Success:   true,
+			Records:   int32(notes) + 1,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to record erasure: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to erase customer", zap.Error(err))
+		return nil, err
+	}
+	if erased.ID != 0 {
+		log.Info("customer erased", zap.Int32("id", erased.ID), zap.Int32("request_id", request.ID))
+		s.publishCustomer(mqconsts.TOPIC_UPDATE_CUSTOMER, erased)
+	} else {
+		log.Info("privacy request sent again", zap.Int32("id", req.Id), zap.Int32("request_id", request.ID))
+	}
+
+	return s.sendPrivacyRequest(ctx, request)
+}
+
+// GetPrivacyRequest returns the progress of a privacy request and, for a
+// completed export, the JSON archive
+func (s *Service) GetPrivacyRequest(ctx context.Context, req *pb.GetPrivacyRequestRequest) (*pb.PrivacyRequestResponse, error) {
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	request, err := s.queries.GetPrivacyRequest(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "privacy request not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get privacy request: %v", err)
+	}
+	return s.privacyRequestResponse(ctx, s.queries, request)
+}
+
+// ConsumePrivacyAcks records the answers of the other services until ctx is done
+func (s *Service) ConsumePrivacyAcks(ctx context.Context) {
+	log := s.logger.With(zap.String("func", "ConsumePrivacyAcks"))
+
+	subscriber, err := mq.NewSubscriber(mqconfig.RabbitMQConfig{
+		ConnStr:       s.cfg.RabbitMQURL,
+		ExchangeName:  mqconsts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:  mqconsts.EXCHANGE_TYPE_TOPIC,
+		SubscribeKeys: []string{mqconsts.TOPIC_CUSTOMER_PRIVACY_ACK},
+		QueueName:     mqconsts.QUEUE_PRIVACY_ACK,
+	}, s.logger)
+	if err != nil {
+		log.Error("failed to init mq subscriber", zap.Error(err))
+		return
+	}
+	defer subscriber.Close()
+
+	err = subscriber.Consume(func(body []byte) error {
+		var ack events.CustomerPrivacyAckEvent
+		if err := mq.UnwrapEvent(body, &ack); err != nil {
+			// a malformed message will never succeed, drop it
+			log.Error("failed to decode privacy ack", zap.Error(err))
+			return nil
+		}
+		return s.recordPrivacyAck(ctx, &ack)
+	})
+	if err != nil {
+		log.Error("mq subscriber exited", zap.Error(err))
+	}
+}
+
+// recordPrivacyAck stores the answer of a service and closes the request
+// once every service answered
+func (s *Service) recordPrivacyAck(ctx context.Context, ack *events.CustomerPrivacyAckEvent) error {
+	log := s.logger.With(zap.String("func", "recordPrivacyAck"))
+
+	err := s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		request, err := q.GetPrivacyRequestForUpdate(ctx, ack.RequestId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				log.Warn("unknown privacy request", zap.Int32("request_id", ack.RequestId))
+				return nil
+			}
+			return err
+		}
+
+		var data []byte
+		if request.Kind == consts.PRIVACY_EXPORT && ack.Success && json.Valid(ack.Data) {
+			data = ack.Data
+		}
+		_, err = q.UpsertPrivacyRequestAck(ctx, db.UpsertPrivacyRequestAckParams{
+			RequestID: request.ID,
+			Service:   ack.Service,
+			Success:   ack.Success,
+			Error:     optionalText(ack.Error),
+			Data:      data,
+			Records:   ack.Records,
+		})
+		if err != nil {
+			return err
+		}
+
+		acks, err := q.ListPrivacyRequestAcks(ctx, request.ID)
+		if err != nil {
+			return err
+		}
+		if len(pendingPrivacyServices(acks)) > 0 {
+			return nil
+		}
+		newStatus := consts.PRIVACY_COMPLETED
+		for _, a := range acks {
+			if !a.Success {
+				newStatus = consts.PRIVACY_FAILED
+			}
+		}
+		_, err = q.SetPrivacyRequestStatus(ctx, db.SetPrivacyRequestStatusParams{
+			ID:     request.ID,
+			Status: newStatus,
+		})
+		return err
+	})
+	if err != nil {
+		log.Error("failed to record privacy ack",
+			zap.Int32("request_id", ack.RequestId),
+			zap.String("service", ack.Service),
+			zap.Error(err))
+		return err
+	}
+	log.Info("privacy ack recorded",
+		zap.Int32("request_id", ack.RequestId),
+		zap.String("service", ack.Service),
+		zap.Bool("success", ack.Success))
+	return nil
+}
+
+// sendPrivacyRequest publishes the request from its stored payload. The
+// request fails when it cannot be published and may be sent again.
+func (s *Service) sendPrivacyRequest(ctx context.Context, request db.PrivacyRequest) (*pb.PrivacyRequestResponse, error) {
+	var evt events.CustomerPrivacyRequestEvent
+	err := protojson.Unmarshal(request.Payload, &evt)
+	if err == nil {
+		evt.RequestId, evt.Kind, evt.CustomerId = request.ID, request.Kind, request.CustomerID
+		err = errors.New("message bus is not available")
+		if publisher := s.publisher(); publisher != nil {
+			if err = publisher.SendMessage(&evt, mqconsts.TOPIC_CUSTOMER_PRIVACY_REQUEST); err != nil {
+				s.dropPublisher(publisher)
+			}
+		}
+	}
+	if err != nil {
+		s.logger.Error("failed to publish privacy request", zap.Int32("request_id", request.ID), zap.Error(err))
+		request, _ = s.queries.SetPrivacyRequestStatus(ctx, db.SetPrivacyRequestStatusParams{
+			ID:     request.ID,
+			Status: consts.PRIVACY_FAILED,
+		})
+	}
+	return s.privacyRequestResponse(ctx, s.queries, request)
+}
+
+// retryPrivacyRequest reopens the last failed request of the customer so it
+// can be sent again. The services that failed are asked to answer again.
+func retryPrivacyRequest(ctx context.Context, q *db.Queries, customerID int32, kind string) (db.PrivacyRequest, error) {
+	request, err := q.GetLatestPrivacyRequestForUpdate(ctx, db.GetLatestPrivacyRequestForUpdateParams{
+		CustomerID: customerID,
+		Kind:       kind,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return request, status.Errorf(codes.Internal, "failed to get privacy request: %v", err)
+	}
+	if err != nil || request.Status != consts.PRIVACY_FAILED || len(request.Payload) == 0 {
+		return request, status.Error(codes.FailedPrecondition, "customer is already erased")
+	}
+
+	if err := q.DeleteFailedPrivacyRequestAcks(ctx, request.ID); err != nil {
+		return request, status.Errorf(codes.Internal, "failed to reset privacy request: %v", err)
+	}
+	request, err = q.SetPrivacyRequestStatus(ctx, db.SetPrivacyRequestStatusParams{
+		ID:     request.ID,
+		Status: consts.PRIVACY_PENDING,
+	})
+	if err != nil {
+		return request, status.Errorf(codes.Internal, "failed to reset privacy request: %v", err)
+	}
+	return request, nil
+}
+
+// privacyPayload returns the identifiers the services find the customer by
+func privacyPayload(customer db.Customer) ([]byte, error) {
+	payload, err := protojson.Marshal(&events.CustomerPrivacyRequestEvent{
+		CustomerId: customer.ID,
+		Uuid:       customer.Uuid.String(),
+		Phone:      customer.Phone.String,
+		Name:       customer.Name,
+		Email:      customer.Email.String,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode privacy request: %v", err)
+	}
+	return payload, nil
+}
+
+// exportCustomer returns the data of this service as a JSON document
+func (s *Service) exportCustomer(ctx context.Context, customer db.Customer) ([]byte, int32, error) {
+	notes, err := s.queries.ListCustomerNotes(ctx, db.ListCustomerNotesParams{
+		CustomerID: customer.ID,
+		Limit:      math.MaxInt32,
+	})
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to list customer notes: %v", err)
+	}
+	purchases, err := s.queries.ListCustomerPurchases(ctx, db.ListCustomerPurchasesParams{
+		CustomerID: customer.Uuid,
+		Limit:      math.MaxInt32,
+	})
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to list purchases: %v", err)
+	}
+
+	export := struct {
+		Customer  json.RawMessage   `json:"customer"`
+		Notes     []json.RawMessage `json:"notes"`
+		Purchases []json.RawMessage `json:"purchases"`
+	}{
+		Notes:     []json.RawMessage{},
+		Purchases: []json.RawMessage{},
+	}
+	if export.Customer, err = protojson.Marshal(s.mapCustomerToProto(customer)); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to encode customer: %v", err)
+	}
+	for _, n := range notes {
+		b, err := protojson.Marshal(customerNoteToProto(n))
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "failed to encode note: %v", err)
+		}
+		export.Notes = append(export.Notes, b)
+	}
+	for _, p := range purchases {
+		b, err := protojson.Marshal(customerPurchaseToProto(p))
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "failed to encode purchase: %v", err)
+		}
+		export.Purchases = append(export.Purchases, b)
+	}
+
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to encode export: %v", err)
+	}
+	return data, int32(1 + len(notes) + len(purchases)), nil
+}
+
+func (s *Service) privacyRequestResponse(ctx context.Context, q db.Querier, request db.PrivacyRequest) (*pb.PrivacyRequestResponse, error) {
+	acks, err := q.ListPrivacyRequestAcks(ctx, request.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list privacy request acks: %v", err)
+	}
+
+	res := &pb.PrivacyRequest{
+		Id:              request.ID,
+		Kind:            request.Kind,
+		CustomerId:      request.CustomerID,
+		Status:          request.Status,
+		RequestedBy:     request.RequestedBy,
+		CreatedAt:       formatTimestamp(request.CreatedAt),
+		CompletedAt:     formatTimestamp(request.CompletedAt),
+		PendingServices: pendingPrivacyServices(acks),
+	}
+	for _, a := range acks {
+		res.Acks = append(res.Acks, &pb.PrivacyRequestAck{
+			Service:        a.Service,
+			Success:        a.Success,
+			Error:          a.Error.String,
+			Records:        a.Records,
+			AcknowledgedAt: formatTimestamp(a.AcknowledgedAt),
+		})
+	}
+	if request.Kind == consts.PRIVACY_EXPORT && request.Status == consts.PRIVACY_COMPLETED {
+		archive, err := privacyArchive(request, acks)
+		if err != nil {
+			return nil, err
+		}
+		res.Archive = archive
+	}
+	return &pb.PrivacyRequestResponse{Request: res}, nil
+}
+
+// privacyArchive merges the exports of every service into one document
+func privacyArchive(request db.PrivacyRequest, acks []db.PrivacyRequestAck) (string, error) {
+	archive := struct {
+		RequestID   int32                      `json:"request_id"`
+		CustomerID  int32                      `json:"customer_id"`
+		GeneratedAt string                     `json:"generated_at"`
+		Services    map[string]json.RawMessage `json:"services"`
+	}{
+		RequestID:   request.ID,
+		CustomerID:  request.CustomerID,
+		GeneratedAt: formatTimestamp(request.CompletedAt),
+		Services:    make(map[string]json.RawMessage, len(acks)),
+	}
+	for _, a := range acks {
+		if len(a.Data) > 0 {
+			archive.Services[a.Service] = a.Data
+		}
+	}
+	b, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to encode archive: %v", err)
+	}
+	return string(b), nil
+}
+
+func pendingPrivacyServices(acks []db.PrivacyRequestAck) []string {
+	pending := []string{}
+	for _, service := range privacyServices {
+		if !slices.ContainsFunc(acks, func(a db.PrivacyRequestAck) bool { return a.Service == service }) {
+			pending = append(pending, service)
+		}
+	}
+	return pending
+}
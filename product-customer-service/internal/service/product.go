@@ -3,13 +3,17 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
 	"github.com/linhhuynhcoding/jss-microservices/product/consts"
 	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
 	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
-	market_api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
 	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -20,18 +24,26 @@ func (s *Service) CreateProduct(ctx context.Context, req *api.CreateProductReque
 	log := s.logger.With(zap.String("func", "CreateProduct"))
 	log.Info("req", zap.Any("req", req))
 
-	goldPrice, err := s.adapter.marketClient.GetGoldPrice(ctx, &market_api.GetGoldPriceRequest{
-		Id: int64(req.GoldType),
-	})
+	if req.GetReorderPoint() < 0 || req.GetReorderQuantity() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid reorder point or quantity")
+	}
+
+	if req.CategoryId != 0 && (req.MarkupRate == 0 || req.WarrantyPeriod == 0) {
+		category, err := s.queries.GetProductCategoryByID(ctx, req.CategoryId)
+		if err != nil {
+			log.Error("failed to get category", zap.Error(err))
+			return nil, status.Error(codes.InvalidArgument, "category not found")
+		}
+		applyCategoryDefaults(category, &req.MarkupRate, &req.WarrantyPeriod)
+	}
+
+	goldBuyPrice, err := s.goldBuyPrice(ctx, req.GoldType, req.AlloyId)
 	if err != nil {
 		log.Error("cannot get gold price", zap.Error(err))
 		return nil, fmt.Errorf("cannot get gold price")
 	}
-	goldBuyPrice := goldPrice.GoldPrice.BuyPrice
 
-	// Giá bán = giá vốn sản phẩm * tỉ lệ áp giá,
-	// Giá vốn sản phẩm = [giá vàng thời điểm * trọng lượng sản phẩm] + tiền công + tiền đá
-	sellingPrice := (1 + req.MarkupRate) * (float64(goldBuyPrice)*req.Weight/consts.MACE_OF_GOLD_WEIGHT + req.LaborCost + req.StoneCost)
+	sellingPrice := calcSellingPrice(goldBuyPrice, req.Weight, req.LaborCost, req.StoneCost, req.MarkupRate)
 
 	arg := db.CreateProductParams{
 		Name:            pgtype.Text{String: req.Name, Valid: true},
@@ -45,17 +57,50 @@ func (s *Service) CreateProduct(ctx context.Context, req *api.CreateProductReque
 		WarrantyPeriod:  utils.Int32(req.WarrantyPeriod),
 		Stock:           utils.Int32(req.Stock),
 		Image:           pgtype.Text{String: req.Image, Valid: true},
-		GoldPriceAtTime: utils.ToNumeric(float64(goldPrice.GoldPrice.BuyPrice)),
+		GoldPriceAtTime: utils.ToNumeric(goldBuyPrice),
+		GoldType:        utils.Int32(req.GoldType),
+		ReorderPoint:    nullableInt32(req.ReorderPoint),
+		ReorderQuantity: nullableInt32(req.ReorderQuantity),
+		AlloyID:         optionalInt32(req.AlloyId),
 	}
 	log.Info("args", zap.Any("args", arg))
 
-	product, err := s.queries.CreateProduct(ctx, arg)
+	var product db.Product
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		product, err = s.insertProduct(ctx, q, arg, req.Stones)
+		return err
+	})
 	if err != nil {
 		log.Error("failed to create product", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
 	}
+	s.publishProducts(mqconsts.TOPIC_CREATE_PRODUCT, product)
 
-	return &api.ProductResponse{Product: s.productToProto(product)}, nil
+	resp := &api.ProductResponse{Product: s.productToProto(product)}
+	if err := s.withStones(ctx, s.queries, resp.Product); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// insertProduct creates the product with its stones and opening stock movement
+func (s *Service) insertProduct(ctx context.Context, q *db.Queries, arg db.CreateProductParams, stones []*api.ProductStone) (db.Product, error) {
+	product, err := q.CreateProduct(ctx, arg)
+	if err != nil {
+		return product, err
+	}
+	if err := s.saveProductStones(ctx, q, product.ID, stones); err != nil {
+		return product, err
+	}
+	if arg.Stock.Int32 == 0 {
+		return product, nil
+	}
+	_, err = q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+		ProductID:    product.ID,
+		Quantity:     arg.Stock.Int32,
+		MovementType: consts.MOVEMENT_OPENING,
+	})
+	return product, err
 }
 
 func (s *Service) GetProduct(ctx context.Context, req *api.GetProductRequest) (*api.ProductResponse, error) {
@@ -67,79 +112,373 @@ func (s *Service) GetProduct(ctx context.Context, req *api.GetProductRequest) (*
 		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
 	}
 
-	return &api.ProductResponse{Product: s.productToProto(product)}, nil
+	resp := &api.ProductResponse{Product: s.productToProto(product)}
+	if err := s.withStones(ctx, s.queries, resp.Product); err != nil {
+		return nil, err
+	}
+	if !product.ParentID.Valid {
+		if err := s.withVariants(ctx, s.queries, resp.Product); err != nil {
+			return nil, err
+		}
+	}
+	// variants share the gallery of their parent
+	owner := product.ID
+	if product.ParentID.Valid {
+		owner = product.ParentID.Int32
+	}
+	if resp.Product.Images, err = s.productImages(ctx, s.queries, owner); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (s *Service) ListProducts(ctx context.Context, req *api.ListProductsRequest) (*api.ListProductsResponse, error) {
+	log := s.logger.With(zap.String("func", "ListProducts"))
+
+	if (req.MaxPrice > 0 && req.MinPrice > req.MaxPrice) || (req.MaxWeight > 0 && req.MinWeight > req.MaxWeight) {
+		return nil, status.Error(codes.InvalidArgument, "invalid price or weight range")
+	}
+	if req.Status != "" && !validProductStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	filter := db.CountProductsParams{
+		InStock:         req.InStock,
+		Search:          optionalText(strings.TrimSpace(req.Search)),
+		StoneType:       optionalText(req.StoneType),
+		StoneColor:      optionalText(req.StoneColor),
+		StoneClarity:    optionalText(req.StoneClarity),
+		CertificateLab:  optionalText(req.CertificateLab),
+		Status:          optionalText(req.Status),
+		IncludeArchived: req.IncludeArchived,
+	}
+	if req.CategoryId != 0 {
+		filter.CategoryID = utils.Int32(req.CategoryId)
+	}
+	if req.GoldType != 0 {
+		filter.GoldType = utils.Int32(req.GoldType)
+	}
+	if req.MinPrice > 0 {
+		filter.MinPrice = utils.ToNumeric(req.MinPrice)
+	}
+	if req.MaxPrice > 0 {
+		filter.MaxPrice = utils.ToNumeric(req.MaxPrice)
+	}
+	if req.MinWeight > 0 {
+		filter.MinWeight = utils.ToNumeric(req.MinWeight)
+	}
+	if req.MaxWeight > 0 {
+		filter.MaxWeight = utils.ToNumeric(req.MaxWeight)
+	}
+	if req.MinCarat > 0 {
+		filter.MinCarat = utils.ToNumeric(req.MinCarat)
+	}
+	if req.MaxCarat > 0 {
+		filter.MaxCarat = utils.ToNumeric(req.MaxCarat)
+	}
+	filter.FilterStones = filter.StoneType.Valid || filter.StoneColor.Valid || filter.StoneClarity.Valid ||
+		filter.CertificateLab.Valid || filter.MinCarat.Valid || filter.MaxCarat.Valid
+
 	products, err := s.queries.ListProducts(ctx, db.ListProductsParams{
-		Limit:  req.Limit,
-		Offset: req.Page * req.Limit,
+		CategoryID:      filter.CategoryID,
+		GoldType:        filter.GoldType,
+		MinPrice:        filter.MinPrice,
+		MaxPrice:        filter.MaxPrice,
+		MinWeight:       filter.MinWeight,
+		MaxWeight:       filter.MaxWeight,
+		InStock:         filter.InStock,
+		Search:          filter.Search,
+		FilterStones:    filter.FilterStones,
+		StoneType:       filter.StoneType,
+		MinCarat:        filter.MinCarat,
+		MaxCarat:        filter.MaxCarat,
+		StoneColor:      filter.StoneColor,
+		StoneClarity:    filter.StoneClarity,
+		CertificateLab:  filter.CertificateLab,
+		Status:          filter.Status,
+		IncludeArchived: filter.IncludeArchived,
+		Sort:            productSortKey(req.Sort),
+		Limit:           limit,
+		Offset:          req.Page * limit,
 	})
 	if err != nil {
+		log.Error("failed to list products", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
 	}
+	total, err := s.queries.CountProducts(ctx, filter)
+	if err != nil {
+		log.Error("failed to count products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count products: %v", err)
+	}
 
-	resp := &api.ListProductsResponse{}
+	resp := &api.ListProductsResponse{
+		Pagination: &api.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}
 	for _, p := range products {
 		resp.Products = append(resp.Products, s.productToProto(p))
 	}
+	if err := s.withStones(ctx, s.queries, resp.Products...); err != nil {
+		return nil, err
+	}
+	if err := s.withVariants(ctx, s.queries, resp.Products...); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
-// TODO: tính lại giá
+// UpdateProduct saves the product and reprices it, with its variants, off the
+// current gold price so a new markup or labor cost shows up in the prices.
+// Attributes a variant shares with its parent are only edited on the parent.
 func (s *Service) UpdateProduct(ctx context.Context, req *api.UpdateProductRequest) (*api.ProductResponse, error) {
+	if req.Status == consts.PRODUCT_ARCHIVED {
+		return nil, status.Error(codes.InvalidArgument, "use DeleteProduct to archive a product")
+	}
+	if req.Status != "" && !validProductStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+	}
+	if req.GetReorderPoint() < 0 || req.GetReorderQuantity() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid reorder point or quantity")
+	}
+
+	if req.ClearReorderPoint && (req.ReorderPoint != nil || req.ReorderQuantity != nil) {
+		return nil, status.Error(codes.InvalidArgument, "cannot set and clear the reorder point at once")
+	}
+	if req.GetAlloyId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid alloy id")
+	}
+
 	arg := db.UpdateProductByCodeParams{
-		Name:           pgtype.Text{String: req.Name, Valid: true},
-		Code:           req.Code,
-		CategoryID:     utils.Int32(req.CategoryId),
-		Weight:         utils.ToNumeric(req.Weight),
-		LaborCost:      utils.ToNumeric(req.LaborCost),
-		StoneCost:      utils.ToNumeric(req.StoneCost),
-		MarkupRate:     utils.ToNumeric(req.MarkupRate),
-		WarrantyPeriod: utils.Int32(req.WarrantyPeriod),
-		Stock:          utils.Int32(req.Stock),
-		Image:          pgtype.Text{String: req.Image, Valid: true},
-	}
-
-	product, err := s.queries.UpdateProductByCode(ctx, arg)
+		Name:              pgtype.Text{String: req.Name, Valid: true},
+		Code:              req.Code,
+		CategoryID:        utils.Int32(req.CategoryId),
+		Weight:            utils.ToNumeric(req.Weight),
+		LaborCost:         utils.ToNumeric(req.LaborCost),
+		StoneCost:         utils.ToNumeric(req.StoneCost),
+		MarkupRate:        utils.ToNumeric(req.MarkupRate),
+		WarrantyPeriod:    utils.Int32(req.WarrantyPeriod),
+		Stock:             utils.Int32(req.Stock),
+		Image:             pgtype.Text{String: req.Image, Valid: true},
+		ReorderPoint:      nullableInt32(req.ReorderPoint),
+		ReorderQuantity:   nullableInt32(req.ReorderQuantity),
+		ClearReorderPoint: req.ClearReorderPoint,
+		AlloyID:           nullableInt32(req.AlloyId),
+	}
+
+	current, err := s.queries.GetProductByCode(ctx, req.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "product not found: %v", err)
+	}
+	if err := checkVariantUpdate(current, req); err != nil {
+		return nil, err
+	}
+
+	// the product is repriced off its new alloy
+	alloyID := current.AlloyID.Int32
+	if req.AlloyId != nil {
+		alloyID = *req.AlloyId
+	}
+	goldBuyPrice, err := s.goldBuyPrice(ctx, current.GoldType.Int32, alloyID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.InvalidArgument, "alloy %d not found", alloyID)
+		}
+		s.logger.Error("cannot get gold price", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "cannot get gold price")
+	}
+
+	var product db.Product
+	var changes []stockChange
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		changes = nil
+		product, err = q.UpdateProductByCode(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if req.ReplaceStones || len(req.Stones) > 0 {
+			if err := s.saveProductStones(ctx, q, product.ID, req.Stones); err != nil {
+				return err
+			}
+		}
+		if err := s.syncProductVariants(ctx, q, product); err != nil {
+			return err
+		}
+		if product, err = s.repriceProductTree(ctx, q, product, goldBuyPrice); err != nil {
+			return err
+		}
+		if req.Status != "" && req.Status != product.Status {
+			if _, err := q.SetProductStatus(ctx, db.SetProductStatusParams{Status: req.Status, ID: product.ID}); err != nil {
+				return err
+			}
+			product.Status, product.ArchivedAt = req.Status, pgtype.Timestamp{}
+		}
+		// keep the stock ledger in line with manual stock edits
+		delta := product.Stock.Int32 - current.Stock.Int32
+		if delta == 0 {
+			return nil
+		}
+		movement, err := q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+			ProductID:    product.ID,
+			Quantity:     delta,
+			MovementType: consts.MOVEMENT_ADJUSTMENT,
+			Note:         pgtype.Text{String: "manual update", Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		changes = append(changes, stockChange{product: product, movement: movement})
+		return nil
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update product: %v", err)
 	}
+	s.publishProductTree(ctx, mqconsts.TOPIC_UPDATE_PRODUCT, product)
+	s.publishStockChanges(changes...)
+	if lowStockCrossed(product, current.Stock.Int32) {
+		s.publishLowStock(product)
+	}
 
-	return &api.ProductResponse{Product: s.productToProto(product)}, nil
+	resp := &api.ProductResponse{Product: s.productToProto(product)}
+	if err := s.withStones(ctx, s.queries, resp.Product); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
+// DeleteProduct archives the product and its variants. A hard delete is only
+// allowed for products without history, sold items must stay for the orders.
 func (s *Service) DeleteProduct(ctx context.Context, req *api.DeleteProductRequest) (*api.DeleteProductResponse, error) {
-	err := s.queries.DeleteProduct(ctx, req.Id)
+	log := s.logger.With(zap.String("func", "DeleteProduct"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	product, err := s.queries.GetProductByID(ctx, req.Id)
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, status.Error(codes.NotFound, "product not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to delete product: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+
+	if !req.Hard {
+		if _, err := s.queries.SetProductStatus(ctx, db.SetProductStatusParams{
+			Status: consts.PRODUCT_ARCHIVED,
+			ID:     product.ID,
+		}); err != nil {
+			log.Error("failed to archive product", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to archive product: %v", err)
+		}
+		log.Info("product archived", zap.Int32("id", product.ID))
+		s.publishProductDeleted(ctx, product, false)
+		return &api.DeleteProductResponse{Success: true}, nil
+	}
+
+	var variants []db.Product
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		ids := []int32{product.ID}
+		variants, err = q.ListProductVariantsByParentIDs(ctx, ids)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list variants: %v", err)
+		}
+		for _, v := range variants {
+			ids = append(ids, v.ID)
+		}
+
+		refs, err := q.CountProductReferences(ctx, ids)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check product history: %v", err)
+		}
+		switch {
+		case refs.Orders > 0:
+			return status.Errorf(codes.FailedPrecondition, "product is referenced by %d orders, archive it instead", refs.Orders)
+		case refs.Serials > 0:
+			return status.Errorf(codes.FailedPrecondition, "product has %d serials, archive it instead", refs.Serials)
+		case refs.StocktakeCounts > 0:
+			return status.Errorf(codes.FailedPrecondition, "product was counted in %d stocktakes, archive it instead", refs.StocktakeCounts)
+		case refs.PurchaseOrderLines > 0:
+			return status.Errorf(codes.FailedPrecondition, "product is on %d purchase order lines, archive it instead", refs.PurchaseOrderLines)
+		case refs.GoodsReceiptLines > 0:
+			return status.Errorf(codes.FailedPrecondition, "product was received on %d goods receipt lines, archive it instead", refs.GoodsReceiptLines)
+		}
+
+		// without sales the ledger only holds openings and manual adjustments
+		if err := q.DeleteStockMovementsByProducts(ctx, ids); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete stock movements: %v", err)
+		}
+		if err := q.DeleteProduct(ctx, product.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete product: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to delete product", zap.Int32("id", product.ID), zap.Error(err))
+		return nil, err
+	}
+	log.Info("product deleted", zap.Int32("id", product.ID))
+	for _, p := range append([]db.Product{product}, variants...) {
+		s.publish(&events.ProductDeletedEvent{ProductId: p.ID, Code: p.Code, Hard: true}, mqconsts.TOPIC_DELETE_PRODUCT)
 	}
 
 	return &api.DeleteProductResponse{Success: true}, nil
 }
 
-func (s *Service) ListProductCategories(ctx context.Context, req *api.ListProductCategoriesRequest) (*api.ListProductCategoriesResponse, error) {
-	// Call the sqlc query
-	categories, err := s.queries.ListProductCategories(ctx)
+// RestoreProduct brings an archived or discontinued product back to active
+func (s *Service) RestoreProduct(ctx context.Context, req *api.RestoreProductRequest) (*api.ProductResponse, error) {
+	log := s.logger.With(zap.String("func", "RestoreProduct"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	product, err := s.queries.GetProductByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+	if product.ParentID.Valid {
+		parent, err := s.queries.GetProductByID(ctx, product.ParentID.Int32)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get parent product: %v", err)
+		}
+		if parent.Status == consts.PRODUCT_ARCHIVED {
+			return nil, status.Error(codes.FailedPrecondition, "restore the parent product first")
+		}
 	}
 
-	// Map db models to API models
-	var res []*api.ProductCategory
-	for _, c := range categories {
-		res = append(res, &api.ProductCategory{
-			Id:   int32(c.ID),
-			Name: c.Name,
-		})
+	if product.Status != consts.PRODUCT_ACTIVE {
+		if _, err := s.queries.SetProductStatus(ctx, db.SetProductStatusParams{
+			Status: consts.PRODUCT_ACTIVE,
+			ID:     product.ID,
+		}); err != nil {
+			log.Error("failed to restore product", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to restore product: %v", err)
+		}
+		product.Status, product.ArchivedAt = consts.PRODUCT_ACTIVE, pgtype.Timestamp{}
+		s.publishProductTree(ctx, mqconsts.TOPIC_UPDATE_PRODUCT, product)
 	}
 
-	return &api.ListProductCategoriesResponse{
-		Categories: res,
-	}, nil
+	resp := &api.ProductResponse{Product: s.productToProto(product)}
+	if err := s.withStones(ctx, s.queries, resp.Product); err != nil {
+		return nil, err
+	}
+	if !product.ParentID.Valid {
+		if err := s.withVariants(ctx, s.queries, resp.Product); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 func (s *Service) PurchaseProduct(ctx context.Context, req *api.PurchaseProductRequest) (*api.PurchaseProductResponse, error) {
@@ -156,15 +495,18 @@ func (s *Service) PurchaseProduct(ctx context.Context, req *api.PurchaseProductR
 		return nil, status.Error(codes.InvalidArgument, "invalid order id")
 	}
 
-	customer, err := s.queries.GetCustomerByPhone(ctx, req.CustomerId)
+	customer, err := findCustomer(ctx, s.queries, req.CustomerId)
 	if err != nil {
 		log.Error("failed to get customer", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to get customer")
+		return nil, err
 	}
 	log.Info("customer", zap.Any("customer", customer))
 
 	productIds := make([]int32, 0)
 	for _, p := range req.Products {
+		if p.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "quantity must be positive")
+		}
 		productIds = append(productIds, p.ProductId)
 	}
 
@@ -182,37 +524,74 @@ func (s *Service) PurchaseProduct(ctx context.Context, req *api.PurchaseProductR
 		mapProductId[int32(p.ID)] = p
 	}
 
+	var lowStock []db.Product
+	var changes []stockChange
+	sold := make(map[int32]db.Product, len(req.Products))
 	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		lowStock, changes = nil, nil
 		for _, p := range req.Products {
-			product := mapProductId[p.ProductId]
-			if product.Stock.Int32 < p.Quantity {
-				log.Error("not enough stock", zap.Error(err))
-				return status.Error(codes.Internal, "not enough stock")
+			product, err := q.GetProductForUpdate(ctx, p.ProductId)
+			if err != nil {
+				log.Error("failed to lock product", zap.Error(err))
+				return status.Error(codes.Internal, "failed to get products")
 			}
-			product.Stock.Int32 -= p.Quantity
-			_, err := s.queries.UpdateProductByCode(ctx, db.UpdateProductByCodeParams{
-				Code:    product.Code,
-				Stock:   product.Stock,
-				BuyTurn: utils.Int32(product.BuyTurn.Int32 + 1),
+			if product.Status == consts.PRODUCT_ARCHIVED {
+				return status.Errorf(codes.FailedPrecondition, "product %s is archived", product.Code)
+			}
+			updated, err := q.SellProductStock(ctx, db.SellProductStockParams{
+				ID:       product.ID,
+				Quantity: p.Quantity,
 			})
 			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					log.Error("not enough stock", zap.String("code", product.Code))
+					return status.Error(codes.Internal, "not enough stock")
+				}
 				log.Error("failed to update product", zap.Error(err))
 				return status.Error(codes.Internal, "failed to update product")
 			}
+			sold[product.ID] = updated
+			if lowStockCrossed(updated, product.Stock.Int32) {
+				lowStock = append(lowStock, updated)
+			}
+			movement, err := q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+				ProductID:    product.ID,
+				Quantity:     -p.Quantity,
+				MovementType: consts.MOVEMENT_SALE,
+				ReferenceID:  utils.Int32(req.OrderId),
+			})
+			if err != nil {
+				log.Error("failed to create stock movement", zap.Error(err))
+				return status.Error(codes.Internal, "failed to create stock movement")
+			}
+			changes = append(changes, stockChange{product: updated, movement: movement})
+			if err := s.sellSerials(ctx, q, product, p, req.OrderId); err != nil {
+				log.Error("failed to sell serials", zap.Error(err))
+				return err
+			}
 		}
 		return nil
 	})
 	if err != nil {
 		log.Error("failed to update product", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to update product")
+		return nil, err
 	}
+	s.publishProducts(mqconsts.TOPIC_UPDATE_PRODUCT, stockedProducts(changes)...)
+	s.publishStockChanges(changes...)
+	s.publishLowStock(lowStock...)
 
 	for _, p := range req.Products {
+		serialNumbers := p.SerialNumbers
+		if serialNumbers == nil {
+			serialNumbers = []string{}
+		}
 		_, err := s.queries.CreateOrderRecord(ctx, db.CreateOrderRecordParams{
-			CustomerID: req.CustomerId,
-			OrderID:    req.OrderId,
-			ProductID:  int32(p.ProductId),
-			Quantity:   p.Quantity,
+			CustomerID:    customer.Uuid,
+			OrderID:       req.OrderId,
+			ProductID:     int32(p.ProductId),
+			Quantity:      p.Quantity,
+			SerialNumbers: serialNumbers,
+			UnitPrice:     mapProductId[p.ProductId].SellingPrice,
 		})
 		if err != nil {
 			log.Error("failed to create order record", zap.Error(err))
@@ -223,7 +602,10 @@ func (s *Service) PurchaseProduct(ctx context.Context, req *api.PurchaseProductR
 
 	productReps := make([]*api.Product, 0)
 	for _, p := range products {
-		productReps = append(productReps, s.productToProto(p))
+		productReps = append(productReps, s.productToProto(sold[p.ID]))
+	}
+	if err := s.withStones(ctx, s.queries, productReps...); err != nil {
+		return nil, err
 	}
 
 	return &api.PurchaseProductResponse{
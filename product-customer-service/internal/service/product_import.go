@@ -0,0 +1,554 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/product/pkg/spreadsheet"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	maxImportFileSize = 10 << 20 // 10 MB
+	maxImportRows     = 2000
+)
+
+// columns of the import/export file, selling_price and gold_price_at_time
+// are only exported. Variant rows only read code, weight, stock, parent_code
+// and size, the rest comes from the parent.
+var productFileColumns = []string{
+	"code", "name", "category", "gold_type", "alloy_id", "weight", "labor_cost", "stone_cost",
+	"markup_rate", "warranty_period", "stock", "image", "parent_code", "size",
+	"selling_price", "gold_price_at_time",
+}
+
+// markup_rate and warranty_period fall back to the category defaults
+var requiredImportColumns = []string{"code", "name", "category", "gold_type", "weight", "labor_cost"}
+
+type importRow struct {
+	row        int32
+	arg        db.CreateProductParams
+	parentCode string
+}
+
+// ImportProducts reads the streamed file and imports it in one transaction
+func (s *Service) ImportProducts(stream grpc.ClientStreamingServer[api.ImportProductsRequest, api.ImportProductsResponse]) error {
+	log := s.logger.With(zap.String("func", "ImportProducts"))
+
+	var (
+		buf      bytes.Buffer
+		filename string
+		dryRun   bool
+		first    = true
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error("failed to receive chunk", zap.Error(err))
+			return err
+		}
+		if first {
+			filename, dryRun = req.Filename, req.DryRun
+			first = false
+		}
+		if buf.Len()+len(req.Chunk) > maxImportFileSize {
+			return status.Errorf(codes.InvalidArgument, "file is larger than %d MB", maxImportFileSize>>20)
+		}
+		buf.Write(req.Chunk)
+	}
+
+	resp, err := s.importProducts(stream.Context(), filename, buf.Bytes(), dryRun)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// ImportProductsHTTP handles the multipart upload: file, dry_run
+func (s *Service) ImportProductsHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		http.Error(w, "failed to parse multipart form", http.StatusBadRequest)
+		return
+	}
+
+	file, handler, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "failed to get file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+	if err != nil {
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+		return
+	}
+	if len(data) > maxImportFileSize {
+		http.Error(w, "file is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(r.FormValue("dry_run"))
+
+	resp, err := s.importProducts(r.Context(), handler.Filename, data, dryRun)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+		return
+	}
+
+	// same JSON shape as the generated gateway handlers
+	data, err = protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// importProducts validates every row first, the file is only saved when all
+// rows are valid and it is not a dry run
+func (s *Service) importProducts(ctx context.Context, filename string, data []byte, dryRun bool) (*api.ImportProductsResponse, error) {
+	log := s.logger.With(zap.String("func", "importProducts"))
+	log.Info("req", zap.String("filename", filename), zap.Int("size", len(data)), zap.Bool("dry_run", dryRun))
+
+	rows, err := spreadsheet.Read(filename, data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(rows) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "file has no data rows")
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "too many rows, max %d per file", maxImportRows)
+	}
+
+	header := make(map[string]int, len(rows[0]))
+	for i, h := range rows[0] {
+		header[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, col := range requiredImportColumns {
+		if _, ok := header[col]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing column %q", col)
+		}
+	}
+
+	categories, err := s.queries.ListProductCategories(ctx)
+	if err != nil {
+		log.Error("failed to list categories", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+	categoryIDs := make(map[string]int32, len(categories)*2)
+	categoryByID := make(map[int32]db.ProductCategory, len(categories))
+	for _, c := range categories {
+		categoryByID[c.ID] = c
+		categoryIDs[strconv.Itoa(int(c.ID))] = c.ID
+		categoryIDs[strings.ToLower(c.Name)] = c.ID
+	}
+
+	cell := func(row []string, col string) string {
+		i, ok := header[col]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var fileCodes []string
+	for _, row := range rows[1:] {
+		fileCodes = append(fileCodes, cell(row, "code"), cell(row, "parent_code"))
+	}
+	existing, err := s.queries.GetProductsByCodes(ctx, fileCodes)
+	if err != nil {
+		log.Error("failed to get products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get products: %v", err)
+	}
+	existingByCode := make(map[string]db.Product, len(existing))
+	for _, p := range existing {
+		existingByCode[p.Code] = p
+	}
+
+	resp := &api.ImportProductsResponse{DryRun: dryRun}
+	goldPrices := make(map[[2]int32]float64)
+	inFile := make(map[string]bool)
+	var valid []importRow
+
+	for i, row := range rows[1:] {
+		rowNum := int32(i + 2)
+		if isBlankRow(row) {
+			continue
+		}
+		resp.TotalRows++
+
+		code := cell(row, "code")
+		rowErr := func(format string, args ...interface{}) {
+			resp.Errors = append(resp.Errors, &api.ImportRowError{
+				Row:     rowNum,
+				Code:    code,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+		before := len(resp.Errors)
+
+		switch {
+		case code == "":
+			rowErr("code is required")
+		case len(code) > 50:
+			rowErr("code is longer than 50 characters")
+		case inFile[code]:
+			rowErr("code is duplicated in the file")
+		default:
+			if _, ok := existingByCode[code]; ok {
+				rowErr("code already exists")
+			}
+		}
+
+		parentCode := cell(row, "parent_code")
+		size := cell(row, "size")
+
+		// a variant copies the name, category, image and pricing formula of
+		// its parent like CreateProductVariant, only its own columns are read
+		var parent *db.CreateProductParams
+		if parentCode != "" {
+			if size == "" {
+				rowErr("size is required for a variant")
+			}
+			if p, ok := existingByCode[parentCode]; ok {
+				switch {
+				case p.ParentID.Valid:
+					rowErr("parent %s is a variant", parentCode)
+				case p.Status == consts.PRODUCT_ARCHIVED:
+					rowErr("parent %s is archived", parentCode)
+				default:
+					parent = &db.CreateProductParams{
+						Name:           p.Name,
+						CategoryID:     p.CategoryID,
+						LaborCost:      p.LaborCost,
+						StoneCost:      p.StoneCost,
+						MarkupRate:     p.MarkupRate,
+						WarrantyPeriod: p.WarrantyPeriod,
+						Image:          p.Image,
+						GoldType:       p.GoldType,
+						AlloyID:        p.AlloyID,
+					}
+				}
+			} else if r := fileParent(valid, parentCode); r != nil {
+				parent = &r.arg
+			} else {
+				rowErr("parent %s not found, it must exist or come earlier in the file", parentCode)
+			}
+		}
+
+		num := func(col string, min, max float64, required bool) float64 {
+			raw := cell(row, col)
+			if raw == "" {
+				if required {
+					rowErr("%s is required", col)
+				}
+				return 0
+			}
+			v, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				rowErr("%s is not a number", col)
+				return 0
+			}
+			if v < min || v >= max {
+				rowErr("%s must be between %v and %v", col, min, max)
+			}
+			return v
+		}
+		integer := func(col string, required bool) int32 {
+			v := num(col, 0, 1<<31-1, required)
+			if v != float64(int32(v)) {
+				rowErr("%s must be a whole number", col)
+			}
+			return int32(v)
+		}
+
+		weight := num("weight", 0.01, 1e8, true)
+		stock := integer("stock", false)
+
+		var arg db.CreateProductParams
+		switch {
+		case parent != nil:
+			arg = *parent
+		case parentCode == "":
+			name := cell(row, "name")
+			if name == "" {
+				rowErr("name is required")
+			}
+			categoryID, ok := categoryIDs[strings.ToLower(cell(row, "category"))]
+			if !ok {
+				rowErr("category %q not found", cell(row, "category"))
+			}
+			goldType := integer("gold_type", true)
+			alloyID := integer("alloy_id", false)
+			laborCost := num("labor_cost", 0, 1e13, true)
+			stoneCost := num("stone_cost", 0, 1e13, false)
+			markupRate := num("markup_rate", 0, 1000, false)
+			warranty := integer("warranty_period", false)
+			if category, ok := categoryByID[categoryID]; ok {
+				applyCategoryDefaults(category, &markupRate, &warranty)
+			}
+			arg = db.CreateProductParams{
+				Name:           pgtype.Text{String: name, Valid: true},
+				CategoryID:     utils.Int32(categoryID),
+				LaborCost:      utils.ToNumeric(laborCost),
+				StoneCost:      utils.ToNumeric(stoneCost),
+				MarkupRate:     utils.ToNumeric(markupRate),
+				WarrantyPeriod: utils.Int32(warranty),
+				Image:          optionalText(cell(row, "image")),
+				GoldType:       utils.Int32(goldType),
+				AlloyID:        optionalInt32(alloyID),
+			}
+		}
+
+		// priced like CreateProduct, off the alloy when the product has one
+		goldPrice := 0.0
+		priceKey := [2]int32{arg.GoldType.Int32, arg.AlloyID.Int32}
+		if priceKey != [2]int32{} {
+			var ok bool
+			if goldPrice, ok = goldPrices[priceKey]; !ok {
+				price, err := s.goldBuyPrice(ctx, priceKey[0], priceKey[1])
+				if err != nil {
+					log.Warn("cannot get gold price", zap.Int32("gold_type", priceKey[0]), zap.Int32("alloy_id", priceKey[1]), zap.Error(err))
+				}
+				goldPrice = price
+				goldPrices[priceKey] = goldPrice
+			}
+			if goldPrice == 0 {
+				if priceKey[1] != 0 {
+					rowErr("cannot get gold price for alloy %d", priceKey[1])
+				} else {
+					rowErr("cannot get gold price for gold type %d", priceKey[0])
+				}
+			}
+		}
+
+		if code != "" {
+			inFile[code] = true
+		}
+		if len(resp.Errors) > before {
+			continue
+		}
+
+		arg.Code = code
+		arg.Weight = utils.ToNumeric(weight)
+		arg.Stock = utils.Int32(stock)
+		arg.Size = optionalText(size)
+		arg.GoldPriceAtTime = utils.ToNumeric(goldPrice)
+		arg.SellingPrice = utils.ToNumeric(calcSellingPrice(
+			goldPrice,
+			weight,
+			utils.NumericToFloat64(arg.LaborCost),
+			utils.NumericToFloat64(arg.StoneCost),
+			utils.NumericToFloat64(arg.MarkupRate),
+		))
+		valid = append(valid, importRow{
+			row:        rowNum,
+			parentCode: parentCode,
+			arg:        arg,
+		})
+	}
+
+	if resp.TotalRows == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file has no data rows")
+	}
+	if len(resp.Errors) > 0 || dryRun {
+		if len(resp.Errors) == 0 {
+			for _, r := range valid {
+				resp.Products = append(resp.Products, s.productToProto(draftProduct(r.arg)))
+			}
+		}
+		return resp, nil
+	}
+
+	var created []db.Product
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		created = nil
+		ids := make(map[string]int32, len(valid))
+		for _, r := range valid {
+			if r.parentCode != "" {
+				parentID, ok := ids[r.parentCode]
+				if !ok {
+					parentID = existingByCode[r.parentCode].ID
+				}
+				r.arg.ParentID = utils.Int32(parentID)
+			}
+			product, err := s.insertProduct(ctx, q, r.arg, nil)
+			if err != nil {
+				if isUniqueViolation(err) {
+					return status.Errorf(codes.AlreadyExists, "row %d: code %s already exists", r.row, r.arg.Code)
+				}
+				return status.Errorf(codes.Internal, "row %d: failed to create product: %v", r.row, err)
+			}
+			ids[product.Code] = product.ID
+			created = append(created, product)
+			resp.Products = append(resp.Products, s.productToProto(product))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to import products", zap.Error(err))
+		return nil, err
+	}
+	resp.Imported = int32(len(resp.Products))
+	s.publishProducts(mqconsts.TOPIC_CREATE_PRODUCT, created...)
+
+	return resp, nil
+}
+
+// ExportProducts downloads the catalog in the import file layout
+func (s *Service) ExportProducts(ctx context.Context, req *api.ExportProductsRequest) (*api.ExportProductsResponse, error) {
+	log := s.logger.With(zap.String("func", "ExportProducts"))
+	log.Info("req", zap.Any("req", req))
+
+	categoryID := pgtype.Int4{}
+	if req.CategoryId != 0 {
+		categoryID = utils.Int32(req.CategoryId)
+	}
+	products, err := s.queries.ListProductsForExport(ctx, categoryID)
+	if err != nil {
+		log.Error("failed to list products", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list products: %v", err)
+	}
+
+	categories, err := s.queries.ListProductCategories(ctx)
+	if err != nil {
+		log.Error("failed to list categories", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list categories: %v", err)
+	}
+	categoryNames := make(map[int32]string, len(categories))
+	for _, c := range categories {
+		categoryNames[c.ID] = c.Name
+	}
+
+	codeByID := make(map[int32]string, len(products))
+	for _, p := range products {
+		codeByID[p.ID] = p.Code
+	}
+
+	rows := make([][]string, 0, len(products)+1)
+	rows = append(rows, productFileColumns)
+	for _, p := range products {
+		category := categoryNames[p.CategoryID.Int32]
+		if category == "" && p.CategoryID.Valid {
+			category = strconv.Itoa(int(p.CategoryID.Int32))
+		}
+		parentCode := ""
+		if p.ParentID.Valid {
+			parentCode = codeByID[p.ParentID.Int32]
+		}
+		rows = append(rows, []string{
+			p.Code,
+			p.Name.String,
+			category,
+			formatInt(p.GoldType),
+			formatInt(p.AlloyID),
+			formatNumeric(p.Weight),
+			formatNumeric(p.LaborCost),
+			formatNumeric(p.StoneCost),
+			formatNumeric(p.MarkupRate),
+			formatInt(p.WarrantyPeriod),
+			formatInt(p.Stock),
+			p.Image.String,
+			parentCode,
+			p.Size.String,
+			formatNumeric(p.SellingPrice),
+			formatNumeric(p.GoldPriceAtTime),
+		})
+	}
+
+	format, contentType := spreadsheet.FormatCSV, spreadsheet.ContentTypeCSV
+	if req.Format == api.FileFormat_FILE_FORMAT_XLSX {
+		format, contentType = spreadsheet.FormatXLSX, spreadsheet.ContentTypeXLSX
+	}
+	data, err := spreadsheet.Write(format, "products", rows)
+	if err != nil {
+		log.Error("failed to write file", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to write file: %v", err)
+	}
+
+	return &api.ExportProductsResponse{
+		FileName:    fmt.Sprintf("products_%s.%s", time.Now().Format("20060102150405"), format),
+		FileData:    data,
+		ContentType: contentType,
+	}, nil
+}
+
+// draftProduct is the product a dry run would create
+func draftProduct(arg db.CreateProductParams) db.Product {
+	return db.Product{
+		Name:            arg.Name,
+		Code:            arg.Code,
+		CategoryID:      arg.CategoryID,
+		Stock:           arg.Stock,
+		Weight:          arg.Weight,
+		GoldPriceAtTime: arg.GoldPriceAtTime,
+		LaborCost:       arg.LaborCost,
+		StoneCost:       arg.StoneCost,
+		MarkupRate:      arg.MarkupRate,
+		SellingPrice:    arg.SellingPrice,
+		WarrantyPeriod:  arg.WarrantyPeriod,
+		Image:           arg.Image,
+		GoldType:        arg.GoldType,
+		Size:            arg.Size,
+		AlloyID:         arg.AlloyID,
+	}
+}
+
+// fileParent returns the earlier row of the file that can be the parent of a
+// variant, nil when there is none
+func fileParent(rows []importRow, code string) *importRow {
+	for i := range rows {
+		if rows[i].arg.Code == code {
+			if rows[i].parentCode != "" {
+				return nil
+			}
+			return &rows[i]
+		}
+	}
+	return nil
+}
+
+func isBlankRow(row []string) bool {
+	for _, c := range row {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func formatNumeric(n pgtype.Numeric) string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(utils.NumericToFloat64(n), 'f', -1, 64)
+}
+
+func formatInt(n pgtype.Int4) string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.Itoa(int(n.Int32))
+}
@@ -1,13 +1,19 @@
 package service
 
 import (
+	"context"
+	"fmt"
 	"time"
 
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
 	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
 	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	market_api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/market"
 	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
 )
 
+const defaultPageSize = 20
+
 func (s *Service) productToProto(p db.Product) *api.Product {
 	return &api.Product{
 		Id:              p.ID,
@@ -26,5 +32,68 @@ func (s *Service) productToProto(p db.Product) *api.Product {
 		Image:           p.Image.String,
 		CreatedAt:       p.CreatedAt.Time.Format(time.RFC3339),
 		UpdatedAt:       p.UpdatedAt.Time.Format(time.RFC3339),
+		GoldType:        p.GoldType.Int32,
+		ParentId:        p.ParentID.Int32,
+		Size:            p.Size.String,
+		Status:          p.Status,
+		ArchivedAt:      formatTimestamp(p.ArchivedAt),
+		ReorderPoint:    int32Ptr(p.ReorderPoint),
+		ReorderQuantity: int32Ptr(p.ReorderQuantity),
+		AlloyId:         p.AlloyID.Int32,
+	}
+}
+
+// goldBuyPrice returns the buy price of a mace of the product's gold. An
+// alloy is priced off its gold price at its purity, so an 18K item is not
+// valued at the 24K quote. Products without an alloy keep using gold_type
+// as the gold price id.
+func (s *Service) goldBuyPrice(ctx context.Context, goldType, alloyID int32) (float64, error) {
+	if alloyID != 0 {
+		res, err := s.adapter.marketClient.GetAlloy(ctx, &market_api.GetAlloyRequest{Id: alloyID})
+		if err != nil {
+			return 0, err
+		}
+		if res.GetAlloy().GetBuyPrice() == 0 {
+			return 0, fmt.Errorf("alloy %d has no gold price", alloyID)
+		}
+		return res.GetAlloy().GetBuyPrice(), nil
+	}
+
+	res, err := s.adapter.marketClient.GetGoldPrice(ctx, &market_api.GetGoldPriceRequest{
+		Id: int64(goldType),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return float64(res.GetGoldPrice().GetBuyPrice()), nil
+}
+
+// Giá bán = giá vốn sản phẩm * tỉ lệ áp giá,
+// Giá vốn sản phẩm = [giá vàng thời điểm * trọng lượng sản phẩm] + tiền công + tiền đá
+func calcSellingPrice(goldBuyPrice, weight, laborCost, stoneCost, markupRate float64) float64 {
+	return (1 + markupRate) * (goldBuyPrice*weight/consts.MACE_OF_GOLD_WEIGHT + laborCost + stoneCost)
+}
+
+func validProductStatus(s string) bool {
+	switch s {
+	case consts.PRODUCT_ACTIVE, consts.PRODUCT_DISCONTINUED, consts.PRODUCT_ARCHIVED:
+		return true
+	}
+	return false
+}
+
+// productSortKey maps the sort option to the key understood by ListProducts
+func productSortKey(sort api.ProductSort) string {
+	switch sort {
+	case api.ProductSort_PRODUCT_SORT_PRICE_ASC:
+		return "price_asc"
+	case api.ProductSort_PRODUCT_SORT_PRICE_DESC:
+		return "price_desc"
+	case api.ProductSort_PRODUCT_SORT_NEWEST:
+		return "newest"
+	case api.ProductSort_PRODUCT_SORT_BEST_SELLING:
+		return "best_selling"
+	default:
+		return ""
 	}
 }
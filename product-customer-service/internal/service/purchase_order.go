@@ -0,0 +1,468 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CreatePurchaseOrder(ctx context.Context, req *api.CreatePurchaseOrderRequest) (*api.PurchaseOrderResponse, error) {
+	log := s.logger.With(zap.String("func", "CreatePurchaseOrder"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "purchase order has no lines")
+	}
+	seen := make(map[int32]bool, len(req.Lines))
+	for _, l := range req.Lines {
+		if l.Quantity <= 0 || l.GoldCostPerGram < 0 || l.LaborCost < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity or cost for product %d", l.ProductId)
+		}
+		if seen[l.ProductId] {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d is listed twice", l.ProductId)
+		}
+		seen[l.ProductId] = true
+	}
+	expectedAt := pgtype.Timestamp{}
+	if req.ExpectedAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpectedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expected_at: %v", err)
+		}
+		expectedAt = pgtype.Timestamp{Time: t, Valid: true}
+	}
+
+	supplier, err := s.queries.GetSupplierByID(ctx, req.SupplierId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "supplier not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get supplier: %v", err)
+	}
+	if !supplier.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "supplier is inactive")
+	}
+
+	var resp *api.PurchaseOrderResponse
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		order, err := q.CreatePurchaseOrder(ctx, db.CreatePurchaseOrderParams{
+			SupplierID: supplier.ID,
+			Note:       optionalText(req.Note),
+			ExpectedAt: expectedAt,
+			CreatedBy:  userID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create purchase order: %v", err)
+		}
+
+		for _, l := range req.Lines {
+			product, err := q.GetProductByID(ctx, l.ProductId)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return status.Errorf(codes.NotFound, "product %d not found", l.ProductId)
+				}
+				return status.Errorf(codes.Internal, "failed to get product: %v", err)
+			}
+			if product.Status == consts.PRODUCT_ARCHIVED {
+				return status.Errorf(codes.FailedPrecondition, "product %s is archived", product.Code)
+			}
+			_, err = q.CreatePurchaseOrderLine(ctx, db.CreatePurchaseOrderLineParams{
+				PurchaseOrderID: order.ID,
+				ProductID:       product.ID,
+				Quantity:        l.Quantity,
+				GoldCostPerGram: utils.ToNumeric(l.GoldCostPerGram),
+				LaborCost:       utils.ToNumeric(l.LaborCost),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create purchase order line: %v", err)
+			}
+		}
+
+		resp, err = s.purchaseOrderResponse(ctx, q, order)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to create purchase order", zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetPurchaseOrder(ctx context.Context, req *api.GetPurchaseOrderRequest) (*api.PurchaseOrderResponse, error) {
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	order, err := s.queries.GetPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "purchase order not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get purchase order: %v", err)
+	}
+	return s.purchaseOrderResponse(ctx, s.queries, order)
+}
+
+func (s *Service) ListPurchaseOrders(ctx context.Context, req *api.ListPurchaseOrdersRequest) (*api.ListPurchaseOrdersResponse, error) {
+	log := s.logger.With(zap.String("func", "ListPurchaseOrders"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	filter := db.CountPurchaseOrdersParams{
+		SupplierID: optionalInt32(req.SupplierId),
+		Status:     optionalText(req.Status),
+	}
+	orders, err := s.queries.ListPurchaseOrders(ctx, db.ListPurchaseOrdersParams{
+		SupplierID: filter.SupplierID,
+		Status:     filter.Status,
+		Limit:      limit,
+		Offset:     req.Page * limit,
+	})
+	if err != nil {
+		log.Error("failed to list purchase orders", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list purchase orders: %v", err)
+	}
+	total, err := s.queries.CountPurchaseOrders(ctx, filter)
+	if err != nil {
+		log.Error("failed to count purchase orders", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count purchase orders: %v", err)
+	}
+
+	resp := &api.ListPurchaseOrdersResponse{
+		Pagination: &api.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}
+	for _, o := range orders {
+		resp.Orders = append(resp.Orders, purchaseOrderToProto(o))
+	}
+	return resp, nil
+}
+
+func (s *Service) CancelPurchaseOrder(ctx context.Context, req *api.CancelPurchaseOrderRequest) (*api.PurchaseOrderResponse, error) {
+	log := s.logger.With(zap.String("func", "CancelPurchaseOrder"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	var resp *api.PurchaseOrderResponse
+	err := s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		order, err := q.GetPurchaseOrderForUpdate(ctx, req.Id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "purchase order not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get purchase order: %v", err)
+		}
+		if order.Status != consts.PURCHASE_ORDER_OPEN {
+			return status.Errorf(codes.FailedPrecondition, "purchase order is %s", order.Status)
+		}
+
+		order, err = q.UpdatePurchaseOrderStatus(ctx, db.UpdatePurchaseOrderStatusParams{
+			ID:     order.ID,
+			Status: consts.PURCHASE_ORDER_CANCELLED,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to cancel purchase order: %v", err)
+		}
+		resp, err = s.purchaseOrderResponse(ctx, q, order)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to cancel purchase order", zap.Int32("id", req.Id), zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReceiveGoods books a delivery against a purchase order. Each received piece
+// costs gold + labor + an equal share of the extra cost; the product keeps the
+// stock weighted average of that landed cost in gold_price_at_time/labor_cost
+// and is repriced with it.
+func (s *Service) ReceiveGoods(ctx context.Context, req *api.ReceiveGoodsRequest) (*api.PurchaseOrderResponse, error) {
+	log := s.logger.With(zap.String("func", "ReceiveGoods"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "nothing to receive")
+	}
+	if req.ExtraCost < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid extra cost")
+	}
+	var pieces int32
+	seen := make(map[int32]bool, len(req.Lines))
+	for _, l := range req.Lines {
+		if l.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity for line %d", l.LineId)
+		}
+		if seen[l.LineId] {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d is listed twice", l.LineId)
+		}
+		seen[l.LineId] = true
+		pieces += l.Quantity
+	}
+	extraPerPiece := req.ExtraCost / float64(pieces)
+
+	var resp *api.PurchaseOrderResponse
+	var changes []stockChange
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		changes = nil
+		order, err := q.GetPurchaseOrderForUpdate(ctx, req.PurchaseOrderId)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "purchase order not found")
+			}
+			return status.Errorf(codes.Internal, "failed to get purchase order: %v", err)
+		}
+		if order.Status != consts.PURCHASE_ORDER_OPEN && order.Status != consts.PURCHASE_ORDER_PARTIALLY_RECEIVED {
+			return status.Errorf(codes.FailedPrecondition, "purchase order is %s", order.Status)
+		}
+
+		lines, err := q.ListPurchaseOrderLines(ctx, order.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list purchase order lines: %v", err)
+		}
+		byID := make(map[int32]db.PurchaseOrderLine, len(lines))
+		for _, l := range lines {
+			byID[l.ID] = l
+		}
+
+		receipt, err := q.CreateGoodsReceipt(ctx, db.CreateGoodsReceiptParams{
+			PurchaseOrderID: order.ID,
+			ExtraCost:       utils.ToNumeric(req.ExtraCost),
+			Note:            optionalText(req.Note),
+			ReceivedBy:      userID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create goods receipt: %v", err)
+		}
+
+		for _, rl := range req.Lines {
+			line, ok := byID[rl.LineId]
+			if !ok {
+				return status.Errorf(codes.InvalidArgument, "line %d is not on purchase order %d", rl.LineId, order.ID)
+			}
+			line, err = q.AddPurchaseOrderLineReceived(ctx, db.AddPurchaseOrderLineReceivedParams{
+				Quantity: rl.Quantity,
+				ID:       line.ID,
+			})
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return status.Errorf(codes.FailedPrecondition, "line %d: receiving %d exceeds the ordered quantity", rl.LineId, rl.Quantity)
+				}
+				return status.Errorf(codes.Internal, "failed to update purchase order line: %v", err)
+			}
+			byID[line.ID] = line
+
+			landedCost, change, err := s.receiveProductStock(ctx, q, line, rl.Quantity, extraPerPiece, receipt)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, change)
+			_, err = q.CreateGoodsReceiptLine(ctx, db.CreateGoodsReceiptLineParams{
+				ReceiptID:           receipt.ID,
+				PurchaseOrderLineID: line.ID,
+				ProductID:           line.ProductID,
+				Quantity:            rl.Quantity,
+				LandedCost:          utils.ToNumeric(landedCost),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create goods receipt line: %v", err)
+			}
+		}
+
+		newStatus := consts.PURCHASE_ORDER_RECEIVED
+		for _, l := range byID {
+			if l.ReceivedQuantity < l.Quantity {
+				newStatus = consts.PURCHASE_ORDER_PARTIALLY_RECEIVED
+				break
+			}
+		}
+		order, err = q.UpdatePurchaseOrderStatus(ctx, db.UpdatePurchaseOrderStatusParams{
+			ID:     order.ID,
+			Status: newStatus,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update purchase order: %v", err)
+		}
+
+		resp, err = s.purchaseOrderResponse(ctx, q, order)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to receive goods", zap.Int32("purchase_order_id", req.PurchaseOrderId), zap.Error(err))
+		return nil, err
+	}
+	s.publishProducts(mqconsts.TOPIC_UPDATE_PRODUCT, stockedProducts(changes)...)
+	s.publishStockChanges(changes...)
+
+	return resp, nil
+}
+
+// receiveProductStock adds the received pieces to the product through the
+// ledger and returns the landed cost of one piece with the posted movement
+func (s *Service) receiveProductStock(
+	ctx context.Context,
+	q *db.Queries,
+	line db.PurchaseOrderLine,
+	quantity int32,
+	extraPerPiece float64,
+	receipt db.GoodsReceipt,
+) (float64, stockChange, error) {
+	product, err := q.GetProductForUpdate(ctx, line.ProductID)
+	if err != nil {
+		return 0, stockChange{}, status.Errorf(codes.Internal, "failed to get product %d: %v", line.ProductID, err)
+	}
+
+	weight := utils.NumericToFloat64(product.Weight)
+	goldPerGram := utils.NumericToFloat64(line.GoldCostPerGram)
+	laborCost := utils.NumericToFloat64(line.LaborCost) + extraPerPiece
+	landedCost := goldPerGram*weight + laborCost
+
+	// gold_price_at_time is per mace like the market price
+	onHand := max(product.Stock.Int32, 0)
+	goldPrice := weightedAverage(product.GoldPriceAtTime, onHand, goldPerGram*consts.MACE_OF_GOLD_WEIGHT, quantity)
+	labor := weightedAverage(product.LaborCost, onHand, laborCost, quantity)
+	sellingPrice := calcSellingPrice(
+		goldPrice,
+		weight,
+		labor,
+		utils.NumericToFloat64(product.StoneCost),
+		utils.NumericToFloat64(product.MarkupRate),
+	)
+
+	product, err = q.ReceiveProductStock(ctx, db.ReceiveProductStockParams{
+		Quantity:        quantity,
+		GoldPriceAtTime: utils.ToNumeric(goldPrice),
+		LaborCost:       utils.ToNumeric(labor),
+		SellingPrice:    utils.ToNumeric(sellingPrice),
+		ID:              product.ID,
+	})
+	if err != nil {
+		return 0, stockChange{}, status.Errorf(codes.Internal, "failed to update product %s: %v", product.Code, err)
+	}
+	movement, err := q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+		ProductID:    product.ID,
+		Quantity:     quantity,
+		MovementType: consts.MOVEMENT_PURCHASE,
+		ReferenceID:  utils.Int32(receipt.ID),
+		Note:         pgtype.Text{String: fmt.Sprintf("goods receipt #%d (PO #%d)", receipt.ID, receipt.PurchaseOrderID), Valid: true},
+		CreatedBy:    pgtype.Text{String: receipt.ReceivedBy, Valid: true},
+	})
+	if err != nil {
+		return 0, stockChange{}, status.Errorf(codes.Internal, "failed to post stock movement: %v", err)
+	}
+	return landedCost, stockChange{product: product, movement: movement}, nil
+}
+
+// weightedAverage blends the cost of the pieces on hand with the received ones
+func weightedAverage(current pgtype.Numeric, onHand int32, received float64, quantity int32) float64 {
+	if onHand == 0 || !current.Valid {
+		return received
+	}
+	return (utils.NumericToFloat64(current)*float64(onHand) + received*float64(quantity)) / float64(onHand+quantity)
+}
+
+// purchaseOrderResponse maps the order with its lines and receipts
+func (s *Service) purchaseOrderResponse(ctx context.Context, q db.Querier, order db.PurchaseOrder) (*api.PurchaseOrderResponse, error) {
+	res := purchaseOrderToProto(order)
+
+	lines, err := q.ListPurchaseOrderLines(ctx, order.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list purchase order lines: %v", err)
+	}
+	for _, l := range lines {
+		res.Lines = append(res.Lines, &api.PurchaseOrderLine{
+			Id:               l.ID,
+			ProductId:        l.ProductID,
+			Quantity:         l.Quantity,
+			ReceivedQuantity: l.ReceivedQuantity,
+			GoldCostPerGram:  utils.NumericToFloat64(l.GoldCostPerGram),
+			LaborCost:        utils.NumericToFloat64(l.LaborCost),
+		})
+	}
+
+	receipts, err := q.ListGoodsReceipts(ctx, order.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list goods receipts: %v", err)
+	}
+	if len(receipts) == 0 {
+		return &api.PurchaseOrderResponse{Order: res}, nil
+	}
+	ids := make([]int32, len(receipts))
+	byReceipt := make(map[int32]*api.GoodsReceipt, len(receipts))
+	for i, r := range receipts {
+		ids[i] = r.ID
+		byReceipt[r.ID] = &api.GoodsReceipt{
+			Id:              r.ID,
+			PurchaseOrderId: r.PurchaseOrderID,
+			ExtraCost:       utils.NumericToFloat64(r.ExtraCost),
+			Note:            r.Note.String,
+			ReceivedBy:      r.ReceivedBy,
+			ReceivedAt:      r.ReceivedAt.Time.Format(time.RFC3339),
+		}
+		res.Receipts = append(res.Receipts, byReceipt[r.ID])
+	}
+	receiptLines, err := q.ListGoodsReceiptLines(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list goods receipt lines: %v", err)
+	}
+	for _, l := range receiptLines {
+		r := byReceipt[l.ReceiptID]
+		r.Lines = append(r.Lines, &api.GoodsReceiptLine{
+			PurchaseOrderLineId: l.PurchaseOrderLineID,
+			ProductId:           l.ProductID,
+			Quantity:            l.Quantity,
+			LandedCost:          utils.NumericToFloat64(l.LandedCost),
+		})
+	}
+
+	return &api.PurchaseOrderResponse{Order: res}, nil
+}
+
+func purchaseOrderToProto(o db.PurchaseOrder) *api.PurchaseOrder {
+	return &api.PurchaseOrder{
+		Id:         o.ID,
+		SupplierId: o.SupplierID,
+		Status:     o.Status,
+		Note:       o.Note.String,
+		ExpectedAt: formatTimestamp(o.ExpectedAt),
+		CreatedBy:  o.CreatedBy,
+		CreatedAt:  o.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  o.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
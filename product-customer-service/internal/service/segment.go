@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/mq/events"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	loyalty "github.com/linhhuynhcoding/jss-microservices/rpc/gen/loyalty"
+	pb "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// loyaltyBatchSize is the number of customers per GetCustomersTotalPoints call
+const loyaltyBatchSize = 1000
+
+// CreateSegment saves a segment and computes its members
+func (s *Service) CreateSegment(ctx context.Context, req *pb.CreateSegmentRequest) (*pb.SegmentResponse, error) {
+	log := s.logger.With(zap.String("func", "CreateSegment"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.VoucherId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid voucher id")
+	}
+	rules, err := normalizeSegmentRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	rulesJSON, err := protojson.Marshal(rules)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode rules: %v", err)
+	}
+
+	segment, err := s.queries.CreateSegment(ctx, db.CreateSegmentParams{
+		Name:        name,
+		Description: optionalText(strings.TrimSpace(req.Description)),
+		Rules:       rulesJSON,
+		VoucherID:   optionalInt32(req.VoucherId),
+		CreatedBy:   userID,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "segment %q already exists", name)
+		}
+		log.Error("failed to create segment", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create segment: %v", err)
+	}
+
+	computed, err := s.refreshSegment(ctx, segment.ID)
+	if err != nil {
+		// the segment is kept, the next scheduled run computes it
+		log.Warn("failed to compute segment", zap.Int32("id", segment.ID), zap.Error(err))
+	} else {
+		segment = computed
+	}
+	return &pb.SegmentResponse{Segment: segmentToProto(segment)}, nil
+}
+
+// PreviewSegment returns the number of customers matching the rules and a
+// sample of them, nothing is saved
+func (s *Service) PreviewSegment(ctx context.Context, req *pb.PreviewSegmentRequest) (*pb.PreviewSegmentResponse, error) {
+	log := s.logger.With(zap.String("func", "PreviewSegment"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	rules, err := normalizeSegmentRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	rows, err := s.evaluateSegment(ctx, rules)
+	if err != nil {
+		log.Error("failed to evaluate segment", zap.Error(err))
+		return nil, err
+	}
+
+	sample := rows[:min(len(rows), int(limit))]
+	members := make([]db.CustomerSegmentMember, len(sample))
+	for i, r := range sample {
+		members[i] = db.CustomerSegmentMember{
+			CustomerID: r.ID,
+			Spend:      r.Spend,
+			LastVisit:  r.LastVisit,
+		}
+	}
+	res, err := s.segmentMembersToProto(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PreviewSegmentResponse{
+		Total:   int64(len(rows)),
+		Members: res,
+	}, nil
+}
+
+// ListSegmentMembers returns the members as of the last computation
+func (s *Service) ListSegmentMembers(ctx context.Context, req *pb.ListSegmentMembersRequest) (*pb.ListSegmentMembersResponse, error) {
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+
+	segment, err := s.queries.GetSegment(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "segment not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get segment: %v", err)
+	}
+	members, err := s.queries.ListSegmentMembers(ctx, db.ListSegmentMembersParams{
+		SegmentID: segment.ID,
+		Limit:     limit,
+		Offset:    req.Page * limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list segment members: %v", err)
+	}
+	res, err := s.segmentMembersToProto(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int64(segment.MemberCount)
+	return &pb.ListSegmentMembersResponse{
+		Segment: segmentToProto(segment),
+		Members: res,
+		Pagination: &pb.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}, nil
+}
+
+// RefreshSegments recomputes every segment each SEGMENT_REFRESH_INTERVAL
+// until ctx is done
+func (s *Service) RefreshSegments(ctx context.Context) {
+	log := s.logger.With(zap.String("func", "RefreshSegments"))
+	if s.cfg.SegmentRefreshInterval <= 0 {
+		log.Info("segment refresh disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.SegmentRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		segments, err := s.queries.ListSegments(ctx)
+		if err != nil {
+			log.Error("failed to list segments", zap.Error(err))
+			continue
+		}
+		for _, segment := range segments {
+			computed, err := s.refreshSegment(ctx, segment.ID)
+			if err != nil {
+				log.Error("failed to refresh segment", zap.Int32("id", segment.ID), zap.Error(err))
+				continue
+			}
+			log.Info("segment refreshed", zap.Int32("id", segment.ID), zap.Int32("members", computed.MemberCount))
+		}
+	}
+}
+
+// refreshSegment replaces the members of a segment and publishes the change
+// so that loyalty-service can distribute the segment voucher
+func (s *Service) refreshSegment(ctx context.Context, id int32) (db.CustomerSegment, error) {
+	segment, err := s.queries.GetSegment(ctx, id)
+	if err != nil {
+		return db.CustomerSegment{}, status.Errorf(codes.Internal, "failed to get segment: %v", err)
+	}
+	rules := &pb.SegmentRules{}
+	if err := protojson.Unmarshal(segment.Rules, rules); err != nil {
+		return db.CustomerSegment{}, status.Errorf(codes.Internal, "invalid rules: %v", err)
+	}
+	rows, err := s.evaluateSegment(ctx, rules)
+	if err != nil {
+		return db.CustomerSegment{}, err
+	}
+
+	ids := make([]int32, len(rows))
+	spends := make([]pgtype.Numeric, len(rows))
+	lastVisits := make([]pgtype.Timestamp, len(rows))
+	for i, r := range rows {
+		ids[i], spends[i], lastVisits[i] = r.ID, r.Spend, r.LastVisit
+	}
+
+	var added, removed []string
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		// serializes concurrent refreshes of the segment
+		if _, err := q.GetSegmentForUpdate(ctx, id); err != nil {
+			return err
+		}
+		previous, err := q.ListSegmentMemberIDs(ctx, id)
+		if err != nil {
+			return err
+		}
+		left, err := q.DeleteSegmentMembers(ctx, db.DeleteSegmentMembersParams{
+			SegmentID: id,
+			KeepIds:   ids,
+		})
+		if err != nil {
+			return err
+		}
+		removed = make([]string, 0, len(left))
+		for _, u := range left {
+			removed = append(removed, u.String())
+		}
+		if err := q.UpsertSegmentMembers(ctx, db.UpsertSegmentMembersParams{
+			SegmentID:   id,
+			CustomerIds: ids,
+			Spends:      spends,
+			LastVisits:  lastVisits,
+		}); err != nil {
+			return err
+		}
+		segment, err = q.SetSegmentComputed(ctx, db.SetSegmentComputedParams{
+			ID:          id,
+			MemberCount: int32(len(rows)),
+		})
+		if err != nil {
+			return err
+		}
+
+		members := make(map[int32]bool, len(previous))
+		for _, id := range previous {
+			members[id] = true
+		}
+		added = nil
+		for _, r := range rows {
+			if !members[r.ID] {
+				added = append(added, r.Uuid.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return db.CustomerSegment{}, status.Errorf(codes.Internal, "failed to save segment members: %v", err)
+	}
+
+	if len(added) > 0 || len(removed) > 0 {
+		s.publish(&events.CustomerSegmentUpdatedEvent{
+			SegmentId:        segment.ID,
+			Name:             segment.Name,
+			VoucherId:        segment.VoucherID.Int32,
+			AddedCustomers:   added,
+			RemovedCustomers: removed,
+		}, mqconsts.TOPIC_SEGMENT_UPDATED)
+	}
+	return segment, nil
+}
+
+// evaluateSegment returns the customers matching the rules, the loyalty
+// balance is checked against loyalty-service, in batches, for the customers
+// left
+func (s *Service) evaluateSegment(ctx context.Context, rules *pb.SegmentRules) ([]db.EvaluateSegmentRow, error) {
+	arg := db.EvaluateSegmentParams{
+		CategoryIds:       rules.CategoryIds,
+		WindowDays:        optionalInt32(rules.SpendWindowDays),
+		Tags:              rules.Tags,
+		VisitedWithinDays: optionalInt32(rules.VisitedWithinDays),
+		NotVisitedForDays: optionalInt32(rules.NotVisitedForDays),
+	}
+	if arg.CategoryIds == nil {
+		arg.CategoryIds = []int32{}
+	}
+	if arg.Tags == nil {
+		arg.Tags = []string{}
+	}
+	if rules.MinSpend > 0 {
+		arg.MinSpend = utils.ToNumeric(rules.MinSpend)
+	}
+	if rules.MaxSpend > 0 {
+		arg.MaxSpend = utils.ToNumeric(rules.MaxSpend)
+	}
+
+	rows, err := s.queries.EvaluateSegment(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate segment: %v", err)
+	}
+	if rules.MinPoints == nil && rules.MaxPoints == nil {
+		return rows, nil
+	}
+
+	matched := rows[:0]
+	for batch := range slices.Chunk(rows, loyaltyBatchSize) {
+		ids := make([]string, len(batch))
+		for i, r := range batch {
+			ids[i] = r.Uuid.String()
+		}
+		loyaltyCtx, cancel := context.WithTimeout(ctx, loyaltyTimeout)
+		points, err := s.adapter.loyaltyClient.GetCustomersTotalPoints(loyaltyCtx, &loyalty.GetCustomersTotalPointsRequest{
+			CustomerIds: ids,
+		})
+		cancel()
+		if err != nil {
+			// a partial segment would remove members, fail instead
+			return nil, status.Errorf(codes.Unavailable, "loyalty balance unavailable: %v", err)
+		}
+		for i, r := range batch {
+			// customers without points are not in the map
+			total := points.TotalPoints[ids[i]]
+			if total >= rules.GetMinPoints() && (rules.MaxPoints == nil || total <= *rules.MaxPoints) {
+				matched = append(matched, r)
+			}
+		}
+	}
+	return matched, nil
+}
+
+func (s *Service) segmentMembersToProto(ctx context.Context, members []db.CustomerSegmentMember) ([]*pb.SegmentMember, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	ids := make([]int32, len(members))
+	for i, m := range members {
+		ids[i] = m.CustomerID
+	}
+	customers, err := s.queries.ListCustomersByIDs(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list customers: %v", err)
+	}
+	byID := make(map[int32]db.Customer, len(customers))
+	for _, c := range customers {
+		byID[c.ID] = c
+	}
+
+	res := make([]*pb.SegmentMember, 0, len(members))
+	for _, m := range members {
+		c, ok := byID[m.CustomerID]
+		if !ok {
+			continue
+		}
+		res = append(res, &pb.SegmentMember{
+			Customer:  s.mapCustomerToProto(c),
+			Spend:     utils.NumericToFloat64(m.Spend),
+			LastVisit: formatTimestamp(m.LastVisit),
+			AddedAt:   formatTimestamp(m.AddedAt),
+		})
+	}
+	return res, nil
+}
+
+// normalizeSegmentRules validates the rules and cleans the tags
+func normalizeSegmentRules(rules *pb.SegmentRules) (*pb.SegmentRules, error) {
+	if rules == nil {
+		return &pb.SegmentRules{}, nil
+	}
+	if rules.MinSpend < 0 || rules.MaxSpend < 0 {
+		return nil, status.Error(codes.InvalidArgument, "spend must not be negative")
+	}
+	if rules.MaxSpend > 0 && rules.MaxSpend < rules.MinSpend {
+		return nil, status.Error(codes.InvalidArgument, "max spend is lower than min spend")
+	}
+	if rules.SpendWindowDays < 0 || rules.VisitedWithinDays < 0 || rules.NotVisitedForDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "days must not be negative")
+	}
+	if rules.VisitedWithinDays > 0 && rules.NotVisitedForDays >= rules.VisitedWithinDays {
+		return nil, status.Error(codes.InvalidArgument, "visited within days must be greater than not visited for days")
+	}
+
+	if rules.MinPoints != nil && rules.MaxPoints != nil && *rules.MaxPoints < *rules.MinPoints {
+		return nil, status.Error(codes.InvalidArgument, "max points is lower than min points")
+	}
+
+	tags, err := normalizeTags(rules.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.SegmentRules{
+		MinSpend:          rules.MinSpend,
+		MaxSpend:          rules.MaxSpend,
+		SpendWindowDays:   rules.SpendWindowDays,
+		VisitedWithinDays: rules.VisitedWithinDays,
+		NotVisitedForDays: rules.NotVisitedForDays,
+		Tags:              tags,
+		MinPoints:         rules.MinPoints,
+		MaxPoints:         rules.MaxPoints,
+	}
+	for _, id := range rules.CategoryIds {
+		if !slices.Contains(res.CategoryIds, id) {
+			res.CategoryIds = append(res.CategoryIds, id)
+		}
+	}
+	return res, nil
+}
+
+func segmentToProto(segment db.CustomerSegment) *pb.Segment {
+	rules := &pb.SegmentRules{}
+	// written by CreateSegment, cannot be malformed
+	_ = protojson.Unmarshal(segment.Rules, rules)
+	return &pb.Segment{
+		Id:          segment.ID,
+		Name:        segment.Name,
+		Description: segment.Description.String,
+		Rules:       rules,
+		VoucherId:   segment.VoucherID.Int32,
+		MemberCount: segment.MemberCount,
+		ComputedAt:  formatTimestamp(segment.ComputedAt),
+		CreatedBy:   segment.CreatedBy,
+		CreatedAt:   formatTimestamp(segment.CreatedAt),
+	}
+}
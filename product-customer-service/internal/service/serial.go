@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterProductSerials tags pieces already counted in the product stock
+// with their own serial number, so it never changes the stock itself.
+func (s *Service) RegisterProductSerials(ctx context.Context, req *api.RegisterProductSerialsRequest) (*api.ProductSerialsResponse, error) {
+	log := s.logger.With(zap.String("func", "RegisterProductSerials"))
+	log.Info("req", zap.Any("req", req))
+
+	if len(req.Serials) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no serials to register")
+	}
+
+	product, err := s.queries.GetProductByID(ctx, req.ProductId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+
+	resp := &api.ProductSerialsResponse{}
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		available, err := q.CountAvailableProductSerials(ctx, product.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to count serials: %v", err)
+		}
+		if available+int64(len(req.Serials)) > int64(product.Stock.Int32) {
+			return status.Errorf(codes.FailedPrecondition, "product %s has only %d pieces in stock", product.Code, product.Stock.Int32)
+		}
+
+		for _, sr := range req.Serials {
+			if sr.SerialNumber == "" {
+				return status.Error(codes.InvalidArgument, "serial number is required")
+			}
+			serial, err := q.CreateProductSerial(ctx, db.CreateProductSerialParams{
+				SerialNumber:      sr.SerialNumber,
+				ProductID:         product.ID,
+				Weight:            utils.ToNumeric(sr.Weight),
+				StoneDetails:      pgtype.Text{String: sr.StoneDetails, Valid: sr.StoneDetails != ""},
+				CertificateNumber: pgtype.Text{String: sr.CertificateNumber, Valid: sr.CertificateNumber != ""},
+			})
+			if err != nil {
+				if isUniqueViolation(err) {
+					return status.Errorf(codes.AlreadyExists, "serial %s already exists", sr.SerialNumber)
+				}
+				return status.Errorf(codes.Internal, "failed to create serial: %v", err)
+			}
+			_, err = q.CreateProductSerialEvent(ctx, db.CreateProductSerialEventParams{
+				SerialID:  serial.ID,
+				EventType: consts.SERIAL_EVENT_REGISTERED,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create serial event: %v", err)
+			}
+			resp.Serials = append(resp.Serials, s.productSerialToProto(serial))
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to register serials", zap.Error(err))
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ListProductSerials(ctx context.Context, req *api.ListProductSerialsRequest) (*api.ProductSerialsResponse, error) {
+	serials, err := s.queries.ListProductSerials(ctx, req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list serials: %v", err)
+	}
+
+	resp := &api.ProductSerialsResponse{}
+	for _, sr := range serials {
+		resp.Serials = append(resp.Serials, s.productSerialToProto(sr))
+	}
+	return resp, nil
+}
+
+// GetSerialHistory returns a piece with its product, every recorded event
+// and, once sold, the order and customer it went out with.
+func (s *Service) GetSerialHistory(ctx context.Context, req *api.GetSerialHistoryRequest) (*api.GetSerialHistoryResponse, error) {
+	log := s.logger.With(zap.String("func", "GetSerialHistory"))
+
+	serial, err := s.queries.GetProductSerialByNumber(ctx, req.SerialNumber)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "serial not found")
+		}
+		log.Error("failed to get serial", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get serial: %v", err)
+	}
+
+	product, err := s.queries.GetProductByID(ctx, serial.ProductID)
+	if err != nil {
+		log.Error("failed to get product", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+
+	events, err := s.queries.ListProductSerialEvents(ctx, serial.ID)
+	if err != nil {
+		log.Error("failed to list serial events", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list serial events: %v", err)
+	}
+
+	resp := &api.GetSerialHistoryResponse{
+		Serial:  s.productSerialToProto(serial),
+		Product: s.productToProto(product),
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, s.productSerialEventToProto(e))
+	}
+
+	if serial.OrderID.Valid {
+		record, err := s.queries.GetOrderRecordByOrderAndProduct(ctx, db.GetOrderRecordByOrderAndProductParams{
+			OrderID:   serial.OrderID.Int32,
+			ProductID: serial.ProductID,
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			log.Error("failed to get order record", zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to get order record: %v", err)
+		}
+		resp.OrderId = serial.OrderID.Int32
+		resp.CustomerId = record.CustomerID.String()
+	}
+
+	return resp, nil
+}
+
+// sellSerials marks the selected pieces of a serialized product as sold by the order.
+// Serials are only required for the units the registered pieces account for,
+// stock received before the pieces were registered sells without one.
+func (s *Service) sellSerials(ctx context.Context, q *db.Queries, product db.Product, item *api.PurchaseProductRequest_Product, orderID int32) error {
+	available, err := q.CountAvailableProductSerials(ctx, product.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to count serials: %v", err)
+	}
+	if len(item.SerialNumbers) > int(item.Quantity) {
+		return status.Errorf(codes.InvalidArgument, "product %s got more serial numbers than pieces sold", product.Code)
+	}
+	// units beyond the registered pieces may go out without a serial
+	unserialized := max(int64(product.Stock.Int32)-available, 0)
+	required := max(int64(item.Quantity)-unserialized, 0)
+	if int64(len(item.SerialNumbers)) < required {
+		return status.Errorf(codes.InvalidArgument, "product %s requires %d serial numbers", product.Code, required)
+	}
+
+	for _, sn := range item.SerialNumbers {
+		serial, err := q.MarkProductSerialSold(ctx, db.MarkProductSerialSoldParams{
+			SerialNumber: sn,
+			OrderID:      utils.Int32(orderID),
+			ProductID:    product.ID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.FailedPrecondition, "serial %s is not available for product %s", sn, product.Code)
+			}
+			return status.Errorf(codes.Internal, "failed to update serial: %v", err)
+		}
+		_, err = q.CreateProductSerialEvent(ctx, db.CreateProductSerialEventParams{
+			SerialID:    serial.ID,
+			EventType:   consts.SERIAL_EVENT_SOLD,
+			ReferenceID: utils.Int32(orderID),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create serial event: %v", err)
+		}
+	}
+	return nil
+}
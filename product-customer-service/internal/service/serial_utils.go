@@ -0,0 +1,40 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+)
+
+func (s *Service) productSerialToProto(sr db.ProductSerial) *api.ProductSerial {
+	return &api.ProductSerial{
+		Id:                sr.ID,
+		SerialNumber:      sr.SerialNumber,
+		ProductId:         sr.ProductID,
+		Weight:            utils.NumericToFloat64(sr.Weight),
+		StoneDetails:      sr.StoneDetails.String,
+		CertificateNumber: sr.CertificateNumber.String,
+		Status:            sr.Status,
+		OrderId:           sr.OrderID.Int32,
+		CreatedAt:         formatTimestamp(sr.CreatedAt),
+		UpdatedAt:         formatTimestamp(sr.UpdatedAt),
+	}
+}
+
+func (s *Service) productSerialEventToProto(e db.ProductSerialEvent) *api.ProductSerialEvent {
+	return &api.ProductSerialEvent{
+		EventType:   e.EventType,
+		ReferenceId: e.ReferenceID.Int32,
+		Note:        e.Note.String,
+		CreatedBy:   e.CreatedBy.String,
+		CreatedAt:   formatTimestamp(e.CreatedAt),
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -2,18 +2,33 @@ package service
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/linhhuynhcoding/jss-microservices/mq"
+	mqconfig "github.com/linhhuynhcoding/jss-microservices/mq/config"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
 	"github.com/linhhuynhcoding/jss-microservices/product/config"
-	"github.com/linhhuynhcoding/jss-microservices/product/internal/adapter/cloudinary"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	auth_service "github.com/linhhuynhcoding/jss-microservices/product/internal/adapter/auth-service"
+	loyalty_service "github.com/linhhuynhcoding/jss-microservices/product/internal/adapter/loyalty-service"
 	market_service "github.com/linhhuynhcoding/jss-microservices/product/internal/adapter/market-service"
+	"github.com/linhhuynhcoding/jss-microservices/product/internal/adapter/storage"
 	"github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
 	"github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
 	"go.uber.org/zap"
 )
 
 type Adapter struct {
-	cloudinaryAdapter cloudinary.ICoundinaryClient
-	marketClient      market_service.IMarketServiceClient
+	storage       storage.IStorage
+	marketClient  market_service.IMarketServiceClient
+	authClient    auth_service.IAuthServiceClient
+	loyaltyClient loyalty_service.ILoyaltyServiceClient
+
+	// publisher is nil while the broker is unreachable, see Service.publisher
+	publisherMu       sync.Mutex
+	publisher         *mq.Publisher
+	publisherDialedAt time.Time
 }
 
 type Service struct {
@@ -27,13 +42,67 @@ type Service struct {
 }
 
 func NewService(ctx context.Context, logger *zap.Logger, cfg config.Config, store repository.Store) *Service {
-	return &Service{
+	fileStorage, err := storage.NewStorage(logger, cfg)
+	if err != nil {
+		// uploads fail until the storage is configured
+		logger.Error("failed to init file storage", zap.String("driver", cfg.StorageDriver), zap.Error(err))
+	}
+
+	svc := &Service{
 		logger:  logger,
 		cfg:     cfg,
 		queries: store,
 		adapter: &Adapter{
-			cloudinaryAdapter: cloudinary.NewCloudinaryClient(logger, cfg),
-			marketClient:      market_service.NewMarketServiceClient(logger, cfg),
+			storage:       fileStorage,
+			marketClient:  market_service.NewMarketServiceClient(logger, cfg),
+			authClient:    auth_service.NewAuthServiceClient(logger, cfg),
+			loyaltyClient: loyalty_service.NewLoyaltyServiceClient(logger, cfg),
 		},
 	}
+	if svc.publisher() == nil {
+		// events are dropped until the broker is reachable, publisher dials
+		// it again on the next event
+		logger.Warn("message broker is not reachable yet")
+	}
+	return svc
+}
+
+// publisher returns the publisher on EXCHANGE_PRODUCT_SERVICE, connecting to
+// the broker when there is none yet. A failed connect is retried after
+// PUBLISHER_RETRY_INTERVAL, nil means the broker is still unreachable.
+func (s *Service) publisher() *mq.Publisher {
+	s.adapter.publisherMu.Lock()
+	defer s.adapter.publisherMu.Unlock()
+
+	if s.adapter.publisher != nil || time.Since(s.adapter.publisherDialedAt) < consts.PUBLISHER_RETRY_INTERVAL {
+		return s.adapter.publisher
+	}
+	s.adapter.publisherDialedAt = time.Now()
+
+	publisher, err := mq.NewPublisher(mqconfig.RabbitMQConfig{
+		ConnStr:       s.cfg.RabbitMQURL,
+		ExchangeName:  mqconsts.EXCHANGE_PRODUCT_SERVICE,
+		ExchangeType:  mqconsts.EXCHANGE_TYPE_TOPIC,
+		PublisherName: mqconsts.EXCHANGE_PRODUCT_SERVICE,
+	}, s.logger)
+	if err != nil {
+		s.logger.Error("failed to init mq publisher", zap.Error(err))
+		return nil
+	}
+	s.adapter.publisher = publisher
+	return publisher
+}
+
+// dropPublisher closes a publisher whose connection failed so the next event
+// connects again
+func (s *Service) dropPublisher(publisher *mq.Publisher) {
+	s.adapter.publisherMu.Lock()
+	defer s.adapter.publisherMu.Unlock()
+
+	if s.adapter.publisher != publisher {
+		return
+	}
+	publisher.Close()
+	s.adapter.publisher = nil
+	s.adapter.publisherDialedAt = time.Time{}
 }
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) OpenStocktakeSession(ctx context.Context, req *api.OpenStocktakeSessionRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "OpenStocktakeSession"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID := pgtype.Int4{}
+	if req.CategoryId != 0 {
+		if _, err := s.queries.GetProductCategoryByID(ctx, req.CategoryId); err != nil {
+			log.Error("failed to get category", zap.Error(err))
+			return nil, status.Error(codes.InvalidArgument, "category not found")
+		}
+		categoryID = utils.Int32(req.CategoryId)
+	}
+
+	session, err := s.queries.CreateStocktakeSession(ctx, db.CreateStocktakeSessionParams{
+		CategoryID: categoryID,
+		Note:       pgtype.Text{String: req.Note, Valid: req.Note != ""},
+		OpenedBy:   userID,
+	})
+	if err != nil {
+		log.Error("failed to create stocktake session", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create stocktake session: %v", err)
+	}
+
+	return s.stocktakeResponse(ctx, s.queries, session)
+}
+
+func (s *Service) GetStocktakeSession(ctx context.Context, req *api.GetStocktakeSessionRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "GetStocktakeSession"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	session, err := s.queries.GetStocktakeSession(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "stocktake session not found")
+		}
+		log.Error("failed to get stocktake session", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get stocktake session: %v", err)
+	}
+
+	return s.stocktakeResponse(ctx, s.queries, session)
+}
+
+// SubmitStocktakeCounts adds the scanned pieces to the session. Scanning the
+// same code again accumulates onto the previous count.
+func (s *Service) SubmitStocktakeCounts(ctx context.Context, req *api.SubmitStocktakeCountsRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "SubmitStocktakeCounts"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Scans) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no scans to submit")
+	}
+
+	session, err := s.queries.GetStocktakeSession(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "stocktake session not found")
+		}
+		log.Error("failed to get stocktake session", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get stocktake session: %v", err)
+	}
+	if session.Status != consts.STOCKTAKE_OPEN {
+		return nil, status.Errorf(codes.FailedPrecondition, "stocktake session is %s", session.Status)
+	}
+
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		for _, scan := range req.Scans {
+			product, quantity, err := s.resolveStocktakeScan(ctx, q, session, scan, userID)
+			if err != nil {
+				return err
+			}
+			if session.CategoryID.Valid && product.CategoryID.Int32 != session.CategoryID.Int32 {
+				return status.Errorf(codes.InvalidArgument, "product %s is not in the counted category", product.Code)
+			}
+			if err := checkStocktakeProduct(ctx, q, product); err != nil {
+				return err
+			}
+
+			_, err = q.AddStocktakeCount(ctx, db.AddStocktakeCountParams{
+				SessionID:       session.ID,
+				ProductID:       product.ID,
+				CountedQuantity: quantity,
+				CountedBy:       pgtype.Text{String: userID, Valid: true},
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to add count: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to submit counts", zap.Error(err))
+		return nil, err
+	}
+
+	return s.stocktakeResponse(ctx, s.queries, session)
+}
+
+// SubmitStocktakeSession closes counting and hands the session over for approval.
+func (s *Service) SubmitStocktakeSession(ctx context.Context, req *api.SubmitStocktakeSessionRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "SubmitStocktakeSession"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	session, err := s.queries.SubmitStocktakeSession(ctx, req.SessionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "stocktake session is not open")
+		}
+		log.Error("failed to submit stocktake session", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to submit stocktake session: %v", err)
+	}
+
+	return s.stocktakeResponse(ctx, s.queries, session)
+}
+
+// ApproveStocktakeSession posts one stocktake movement per variance line and
+// sets the product stock to the counted quantity. Products nobody counted are
+// left untouched.
+func (s *Service) ApproveStocktakeSession(ctx context.Context, req *api.ApproveStocktakeSessionRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "ApproveStocktakeSession"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *api.StocktakeSessionResponse
+	var lowStock []db.Product
+	var changes []stockChange
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		lowStock, changes = nil, nil
+		session, err := q.ApproveStocktakeSession(ctx, db.ApproveStocktakeSessionParams{
+			ID:         req.SessionId,
+			ApprovedBy: pgtype.Text{String: userID, Valid: true},
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.FailedPrecondition, "stocktake session is not awaiting approval")
+			}
+			return status.Errorf(codes.Internal, "failed to approve stocktake session: %v", err)
+		}
+
+		lines, err := q.ListStocktakeVariances(ctx, session.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list variances: %v", err)
+		}
+		for _, line := range lines {
+			variance := line.CountedQuantity - line.ExpectedQuantity
+			if variance == 0 {
+				continue
+			}
+			movement, err := q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+				ProductID:    line.ProductID,
+				Quantity:     variance,
+				MovementType: consts.MOVEMENT_STOCKTAKE,
+				ReferenceID:  utils.Int32(session.ID),
+				Note:         pgtype.Text{String: fmt.Sprintf("stocktake #%d", session.ID), Valid: true},
+				CreatedBy:    pgtype.Text{String: userID, Valid: true},
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to post stock movement: %v", err)
+			}
+			product, err := q.UpdateProductByCode(ctx, db.UpdateProductByCodeParams{
+				Code:  line.Code,
+				Stock: utils.Int32(line.CountedQuantity),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to update product: %v", err)
+			}
+			changes = append(changes, stockChange{product: product, movement: movement})
+			if lowStockCrossed(product, line.ExpectedQuantity) {
+				lowStock = append(lowStock, product)
+			}
+		}
+
+		resp, err = s.stocktakeResponse(ctx, q, session)
+		return err
+	})
+	if err != nil {
+		log.Error("failed to approve stocktake session", zap.Error(err))
+		return nil, err
+	}
+	s.publishProducts(mqconsts.TOPIC_UPDATE_PRODUCT, stockedProducts(changes)...)
+	s.publishStockChanges(changes...)
+	s.publishLowStock(lowStock...)
+
+	return resp, nil
+}
+
+func (s *Service) RejectStocktakeSession(ctx context.Context, req *api.RejectStocktakeSessionRequest) (*api.StocktakeSessionResponse, error) {
+	log := s.logger.With(zap.String("func", "RejectStocktakeSession"))
+	log.Info("req", zap.Any("req", req))
+
+	userID, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.queries.RejectStocktakeSession(ctx, db.RejectStocktakeSessionParams{
+		ID:         req.SessionId,
+		ApprovedBy: pgtype.Text{String: userID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "stocktake session is not awaiting approval")
+		}
+		log.Error("failed to reject stocktake session", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to reject stocktake session: %v", err)
+	}
+
+	return s.stocktakeResponse(ctx, s.queries, session)
+}
+
+// resolveStocktakeScan finds the product a scan refers to. A serial scan
+// always counts one piece, once per session, and is recorded on the piece
+// history.
+func (s *Service) resolveStocktakeScan(ctx context.Context, q *db.Queries, session db.StocktakeSession, scan *api.StocktakeScan, userID string) (db.Product, int32, error) {
+	if scan.SerialNumber == "" {
+		product, err := q.GetProductByCode(ctx, scan.Code)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return db.Product{}, 0, status.Errorf(codes.InvalidArgument, "unknown product code: %s", scan.Code)
+			}
+			return db.Product{}, 0, status.Errorf(codes.Internal, "failed to get product: %v", err)
+		}
+		quantity := scan.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		return product, quantity, nil
+	}
+
+	serial, err := q.GetProductSerialByNumber(ctx, scan.SerialNumber)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.Product{}, 0, status.Errorf(codes.InvalidArgument, "unknown serial: %s", scan.SerialNumber)
+		}
+		return db.Product{}, 0, status.Errorf(codes.Internal, "failed to get serial: %v", err)
+	}
+	if serial.Status != consts.SERIAL_IN_STOCK {
+		return db.Product{}, 0, status.Errorf(codes.InvalidArgument, "serial %s is %s", scan.SerialNumber, serial.Status)
+	}
+	product, err := q.GetProductByID(ctx, serial.ProductID)
+	if err != nil {
+		return db.Product{}, 0, status.Errorf(codes.Internal, "failed to get product: %v", err)
+	}
+	_, err = q.CreateProductSerialEvent(ctx, db.CreateProductSerialEventParams{
+		SerialID:    serial.ID,
+		EventType:   consts.SERIAL_EVENT_COUNTED,
+		ReferenceID: utils.Int32(session.ID),
+		CreatedBy:   pgtype.Text{String: userID, Valid: true},
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return db.Product{}, 0, status.Errorf(codes.AlreadyExists, "serial %s is already counted in this session", scan.SerialNumber)
+		}
+		return db.Product{}, 0, status.Errorf(codes.Internal, "failed to create serial event: %v", err)
+	}
+	return product, 1, nil
+}
+
+// checkStocktakeProduct keeps archived products and variant parents out of
+// the count, their stock is not on the shelf.
+func checkStocktakeProduct(ctx context.Context, q *db.Queries, product db.Product) error {
+	if product.Status == consts.PRODUCT_ARCHIVED {
+		return status.Errorf(codes.FailedPrecondition, "product %s is archived", product.Code)
+	}
+	variants, err := q.ListProductVariantsByParentIDs(ctx, []int32{product.ID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list variants: %v", err)
+	}
+	if len(variants) > 0 {
+		return status.Errorf(codes.InvalidArgument, "product %s has variants, count the variants instead", product.Code)
+	}
+	return nil
+}
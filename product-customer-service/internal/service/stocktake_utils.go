@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stocktakeResponse maps the session together with its variance lines
+// computed against the stock ledger.
+func (s *Service) stocktakeResponse(ctx context.Context, q db.Querier, session db.StocktakeSession) (*api.StocktakeSessionResponse, error) {
+	lines, err := q.ListStocktakeVariances(ctx, session.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list variances: %v", err)
+	}
+
+	resp := &api.StocktakeSessionResponse{Session: s.stocktakeSessionToProto(session)}
+	for _, l := range lines {
+		resp.Lines = append(resp.Lines, &api.StocktakeLine{
+			ProductId:        l.ProductID,
+			Code:             l.Code,
+			Name:             l.Name.String,
+			CountedQuantity:  l.CountedQuantity,
+			ExpectedQuantity: l.ExpectedQuantity,
+			Variance:         l.CountedQuantity - l.ExpectedQuantity,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Service) stocktakeSessionToProto(ss db.StocktakeSession) *api.StocktakeSession {
+	return &api.StocktakeSession{
+		Id:          ss.ID,
+		CategoryId:  ss.CategoryID.Int32,
+		Status:      ss.Status,
+		Note:        ss.Note.String,
+		OpenedBy:    ss.OpenedBy,
+		ApprovedBy:  ss.ApprovedBy.String,
+		CreatedAt:   ss.CreatedAt.Time.Format(time.RFC3339),
+		SubmittedAt: formatTimestamp(ss.SubmittedAt),
+		ApprovedAt:  formatTimestamp(ss.ApprovedAt),
+	}
+}
+
+func formatTimestamp(ts pgtype.Timestamp) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.Format(time.RFC3339)
+}
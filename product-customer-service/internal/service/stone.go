@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// saveProductStones replaces the stones of a product with the given list
+func (s *Service) saveProductStones(ctx context.Context, q *db.Queries, productID int32, stones []*api.ProductStone) error {
+	if err := q.DeleteProductStones(ctx, productID); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete stones: %v", err)
+	}
+
+	for _, st := range stones {
+		if st.StoneType == "" {
+			return status.Error(codes.InvalidArgument, "stone type is required")
+		}
+		if st.Carat < 0 || st.Count < 0 {
+			return status.Error(codes.InvalidArgument, "stone carat and count must not be negative")
+		}
+		count := st.Count
+		if count == 0 {
+			count = 1
+		}
+		_, err := q.CreateProductStone(ctx, db.CreateProductStoneParams{
+			ProductID:         productID,
+			StoneType:         st.StoneType,
+			Carat:             utils.ToNumeric(st.Carat),
+			Cut:               optionalText(st.Cut),
+			Color:             optionalText(st.Color),
+			Clarity:           optionalText(st.Clarity),
+			StoneCount:        count,
+			CertificateLab:    optionalText(st.CertificateLab),
+			CertificateNumber: optionalText(st.CertificateNumber),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create stone: %v", err)
+		}
+	}
+	return nil
+}
+
+// withStones loads the stones of the given products in one query
+func (s *Service) withStones(ctx context.Context, q db.Querier, products ...*api.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(products))
+	byID := make(map[int32][]*api.Product, len(products))
+	for _, p := range products {
+		ids = append(ids, p.Id)
+		byID[p.Id] = append(byID[p.Id], p)
+	}
+
+	stones, err := q.ListProductStonesByProductIDs(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list stones: %v", err)
+	}
+	for _, st := range stones {
+		for _, p := range byID[st.ProductID] {
+			p.Stones = append(p.Stones, productStoneToProto(st))
+		}
+	}
+	return nil
+}
+
+func productStoneToProto(st db.ProductStone) *api.ProductStone {
+	return &api.ProductStone{
+		StoneType:         st.StoneType,
+		Carat:             utils.NumericToFloat64(st.Carat),
+		Cut:               st.Cut.String,
+		Color:             st.Color.String,
+		Clarity:           st.Clarity.String,
+		Count:             st.StoneCount,
+		CertificateLab:    st.CertificateLab.String,
+		CertificateNumber: st.CertificateNumber.String,
+	}
+}
+
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CreateSupplier(ctx context.Context, req *api.CreateSupplierRequest) (*api.SupplierResponse, error) {
+	log := s.logger.With(zap.String("func", "CreateSupplier"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier name is required")
+	}
+
+	supplier, err := s.queries.CreateSupplier(ctx, db.CreateSupplierParams{
+		Name:    strings.TrimSpace(req.Name),
+		Phone:   optionalText(req.Phone),
+		Email:   optionalText(req.Email),
+		Address: optionalText(req.Address),
+		TaxCode: optionalText(req.TaxCode),
+		Note:    optionalText(req.Note),
+	})
+	if err != nil {
+		log.Error("failed to create supplier", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to create supplier: %v", err)
+	}
+
+	return &api.SupplierResponse{Supplier: supplierToProto(supplier)}, nil
+}
+
+func (s *Service) UpdateSupplier(ctx context.Context, req *api.UpdateSupplierRequest) (*api.SupplierResponse, error) {
+	log := s.logger.With(zap.String("func", "UpdateSupplier"))
+	log.Info("req", zap.Any("req", req))
+
+	if _, err := s.authorize(ctx, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier name is required")
+	}
+
+	supplier, err := s.queries.UpdateSupplier(ctx, db.UpdateSupplierParams{
+		ID:       req.Id,
+		Name:     strings.TrimSpace(req.Name),
+		Phone:    optionalText(req.Phone),
+		Email:    optionalText(req.Email),
+		Address:  optionalText(req.Address),
+		TaxCode:  optionalText(req.TaxCode),
+		Note:     optionalText(req.Note),
+		IsActive: !req.Inactive,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "supplier not found")
+		}
+		log.Error("failed to update supplier", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to update supplier: %v", err)
+	}
+
+	return &api.SupplierResponse{Supplier: supplierToProto(supplier)}, nil
+}
+
+func (s *Service) ListSuppliers(ctx context.Context, req *api.ListSuppliersRequest) (*api.ListSuppliersResponse, error) {
+	log := s.logger.With(zap.String("func", "ListSuppliers"))
+
+	if _, err := s.authorize(ctx, consts.ROLE_STAFF, consts.ROLE_MANAGER, consts.ROLE_ADMIN); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	suppliers, err := s.queries.ListSuppliers(ctx, db.ListSuppliersParams{
+		ActiveOnly: req.ActiveOnly,
+		Limit:      limit,
+		Offset:     req.Page * limit,
+	})
+	if err != nil {
+		log.Error("failed to list suppliers", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list suppliers: %v", err)
+	}
+	total, err := s.queries.CountSuppliers(ctx, req.ActiveOnly)
+	if err != nil {
+		log.Error("failed to count suppliers", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to count suppliers: %v", err)
+	}
+
+	resp := &api.ListSuppliersResponse{
+		Pagination: &api.Pagination{
+			Total:   total,
+			Page:    req.Page,
+			Limit:   limit,
+			HasNext: int64(req.Page+1)*int64(limit) < total,
+		},
+	}
+	for _, sp := range suppliers {
+		resp.Suppliers = append(resp.Suppliers, supplierToProto(sp))
+	}
+	return resp, nil
+}
+
+func supplierToProto(sp db.Supplier) *api.Supplier {
+	return &api.Supplier{
+		Id:        sp.ID,
+		Name:      sp.Name,
+		Phone:     sp.Phone.String,
+		Email:     sp.Email.String,
+		Address:   sp.Address.String,
+		TaxCode:   sp.TaxCode.String,
+		Note:      sp.Note.String,
+		IsActive:  sp.IsActive,
+		CreatedAt: sp.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: sp.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
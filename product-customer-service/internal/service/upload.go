@@ -5,58 +5,155 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"path/filepath"
-
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	"github.com/linhhuynhcoding/jss-microservices/product/pkg/imageproc"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
 	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// UploadFile validates the image, strips its metadata and stores the
+// thumbnail/medium/large renditions as JPEG
 func (s *Service) UploadFile(ctx context.Context, req *api.UploadFileRequest) (*api.UploadFileResponse, error) {
 	log := s.logger.With(zap.String("func", "UploadFile"))
 
-	// Generate a unique file ID
-	fileID := generateFileID()
+	if s.adapter.storage == nil {
+		return nil, status.Error(codes.Unavailable, "file storage is not configured")
+	}
+	if len(req.FileData) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file is empty")
+	}
 
-	// Create uploads directory if it doesn't exist
-	uploadDir := s.cfg.UploadFolder
-	if err := os.MkdirAll(uploadDir, 0755); err != nil {
-		log.Error("failed to create upload directory", zap.Error(err))
-		return nil, fmt.Errorf("failed to create upload directory: %v", err)
+	img, format, err := imageproc.Decode(req.FileData)
+	if err != nil {
+		log.Warn("rejected upload", zap.String("filename", req.Filename), zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Create file path with unique ID
-	filename := fmt.Sprintf("%s_%s", fileID, req.Filename)
-	filePath := filepath.Join(uploadDir, filename)
+	productID := pgtype.Int4{}
+	if req.ProductId != 0 {
+		if _, err := s.queries.GetProductByID(ctx, req.ProductId); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, status.Error(codes.NotFound, "product not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
+		}
+		productID = utils.Int32(req.ProductId)
+	}
 
-	// Write file to disk
-	if err := os.WriteFile(filePath, req.FileData, 0644); err != nil {
-		log.Error("failed to write file", zap.Error(err))
-		return nil, fmt.Errorf("failed to write file: %v", err)
+	renditions, err := imageproc.Renditions(img)
+	if err != nil {
+		log.Error("failed to render image", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to render image: %v", err)
 	}
 
-	cloud := s.adapter.cloudinaryAdapter
-	if cloud == nil {
-		return nil, fmt.Errorf("failed to upload file")
+	// Generate a unique file ID
+	fileID := generateFileID()
+	stored := make([]db.ProductImageRendition, 0, len(renditions))
+	for _, r := range renditions {
+		key := fmt.Sprintf("%s_%s.%s", fileID, r.Size, renditionExt(r.Format))
+		url, err := s.adapter.storage.Put(ctx, key, r.Data, r.ContentType)
+		if err != nil {
+			log.Error("failed to store file", zap.String("driver", s.cfg.StorageDriver), zap.Error(err))
+			s.deleteStoredRenditions(ctx, stored)
+			return nil, status.Errorf(codes.Internal, "failed to store file: %v", err)
+		}
+		stored = append(stored, db.ProductImageRendition{
+			Size:       r.Size,
+			Format:     r.Format,
+			Width:      int32(r.Width),
+			Height:     int32(r.Height),
+			StorageKey: key,
+			Url:        url,
+		})
 	}
 
-	url, err := s.adapter.cloudinaryAdapter.UploadImage(ctx, filePath)
+	bounds := img.Bounds()
+	var image *api.ProductImage
+	var isPrimary bool
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		sortOrder := int32(0)
+		isPrimary = false
+		if productID.Valid {
+			sortOrder, err = q.GetNextProductImageSortOrder(ctx, productID)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get sort order: %v", err)
+			}
+			isPrimary = req.IsPrimary || sortOrder == 0
+			if isPrimary {
+				if err := q.ClearPrimaryProductImage(ctx, productID); err != nil {
+					return status.Errorf(codes.Internal, "failed to clear primary image: %v", err)
+				}
+			}
+		}
+
+		created, err := q.CreateProductImage(ctx, db.CreateProductImageParams{
+			ProductID:        productID,
+			FileID:           fileID,
+			OriginalFilename: optionalText(filepath.Base(req.Filename)),
+			SourceFormat:     format,
+			Width:            int32(bounds.Dx()),
+			Height:           int32(bounds.Dy()),
+			SortOrder:        sortOrder,
+			IsPrimary:        isPrimary,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create image: %v", err)
+		}
+		for i := range stored {
+			stored[i], err = q.CreateProductImageRendition(ctx, db.CreateProductImageRenditionParams{
+				ImageID:    created.ID,
+				Size:       stored[i].Size,
+				Format:     stored[i].Format,
+				Width:      stored[i].Width,
+				Height:     stored[i].Height,
+				StorageKey: stored[i].StorageKey,
+				Url:        stored[i].Url,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create rendition: %v", err)
+			}
+		}
+		if isPrimary {
+			if err := s.setProductImageUrl(ctx, q, req.ProductId, stored); err != nil {
+				return err
+			}
+		}
+
+		image = productImageToProto(created, stored)
+		return nil
+	})
 	if err != nil {
-		log.Error("failed to upload file to couldinary", zap.Error(err))
-		return nil, fmt.Errorf("failed to upload file to couldinary: %v", err)
+		log.Error("failed to save image", zap.Error(err))
+		s.deleteStoredRenditions(ctx, stored)
+		return nil, err
 	}
 	log.Info("File uploaded successfully:",
-		zap.Any("url", url))
+		zap.Int32("image_id", image.Id))
+	if isPrimary {
+		s.publishProductByID(ctx, req.ProductId)
+	}
 
 	return &api.UploadFileResponse{
-		Message:  "File uploaded successfully",
-		FileId:   fileID,
-		Filename: req.Filename,
-		FileSize: req.FileSize,
-		FileUrl:  url,
+		Message:    "File uploaded successfully",
+		FileId:     fileID,
+		Filename:   req.Filename,
+		FileSize:   int64(len(req.FileData)),
+		FileUrl:    primaryRenditionUrl(stored),
+		ImageId:    image.Id,
+		Renditions: image.Renditions,
 	}, nil
 }
 
@@ -82,6 +179,8 @@ func (s *Service) UploadFileHTTP(w http.ResponseWriter, r *http.Request, pathPar
 
 	// đọc content type nếu có
 	contentType := handler.Header.Get("Content-Type")
+	productID, _ := strconv.Atoi(r.FormValue("product_id"))
+	isPrimary, _ := strconv.ParseBool(r.FormValue("is_primary"))
 
 	data, err := io.ReadAll(file)
 	if err != nil {
@@ -95,8 +194,14 @@ func (s *Service) UploadFileHTTP(w http.ResponseWriter, r *http.Request, pathPar
 		Filename:    handler.Filename,
 		ContentType: contentType,
 		FileSize:    int64(len(data)),
+		ProductId:   int32(productID),
+		IsPrimary:   isPrimary,
 	})
 	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			http.Error(w, st.Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "failed to upload file", http.StatusInternalServerError)
 		return
 	}
@@ -104,3 +209,19 @@ func (s *Service) UploadFileHTTP(w http.ResponseWriter, r *http.Request, pathPar
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
+
+// ServeFileHTTP serves the files stored by the local storage backend
+func (s *Service) ServeFileHTTP(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	if s.cfg.StorageDriver != consts.STORAGE_LOCAL {
+		http.NotFound(w, r)
+		return
+	}
+
+	name := filepath.Base(pathParams["name"])
+	if name == "." || name == "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	http.ServeFile(w, r, filepath.Join(s.cfg.UploadFolder, name))
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	mqconsts "github.com/linhhuynhcoding/jss-microservices/mq/consts"
+	"github.com/linhhuynhcoding/jss-microservices/product/consts"
+	db "github.com/linhhuynhcoding/jss-microservices/product/internal/repository"
+	utils "github.com/linhhuynhcoding/jss-microservices/product/utils/numeric"
+	api "github.com/linhhuynhcoding/jss-microservices/rpc/gen/product"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateProductVariant adds a size of an existing design. The variant copies
+// the name, category, image, stones and pricing formula of the parent and is
+// priced on its own weight.
+func (s *Service) CreateProductVariant(ctx context.Context, req *api.CreateProductVariantRequest) (*api.ProductResponse, error) {
+	log := s.logger.With(zap.String("func", "CreateProductVariant"))
+	log.Info("req", zap.Any("req", req))
+
+	if req.Code == "" || req.Size == "" {
+		return nil, status.Error(codes.InvalidArgument, "code and size are required")
+	}
+	if req.Weight <= 0 || req.Stock < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid weight or stock")
+	}
+
+	parent, err := s.queries.GetProductByID(ctx, req.ParentId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "parent product not found")
+		}
+		log.Error("failed to get parent product", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to get parent product: %v", err)
+	}
+	if parent.ParentID.Valid {
+		return nil, status.Error(codes.InvalidArgument, "cannot add a variant to a variant")
+	}
+	if parent.Status == consts.PRODUCT_ARCHIVED {
+		return nil, status.Error(codes.FailedPrecondition, "parent product is archived")
+	}
+
+	goldBuyPrice, err := s.goldBuyPrice(ctx, parent.GoldType.Int32, parent.AlloyID.Int32)
+	if err != nil {
+		log.Error("cannot get gold price", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "cannot get gold price")
+	}
+
+	sellingPrice := calcSellingPrice(
+		goldBuyPrice,
+		req.Weight,
+		utils.NumericToFloat64(parent.LaborCost),
+		utils.NumericToFloat64(parent.StoneCost),
+		utils.NumericToFloat64(parent.MarkupRate),
+	)
+
+	stones, err := s.queries.ListProductStonesByProductIDs(ctx, []int32{parent.ID})
+	if err != nil {
+		log.Error("failed to list parent stones", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list stones: %v", err)
+	}
+	parentStones := make([]*api.ProductStone, 0, len(stones))
+	for _, st := range stones {
+		parentStones = append(parentStones, productStoneToProto(st))
+	}
+
+	var product db.Product
+	err = s.queries.ExecTx(ctx, func(q *db.Queries) error {
+		product, err = q.CreateProduct(ctx, db.CreateProductParams{
+			Name:            parent.Name,
+			Code:            req.Code,
+			CategoryID:      parent.CategoryID,
+			Weight:          utils.ToNumeric(req.Weight),
+			GoldPriceAtTime: utils.ToNumeric(goldBuyPrice),
+			LaborCost:       parent.LaborCost,
+			StoneCost:       parent.StoneCost,
+			MarkupRate:      parent.MarkupRate,
+			SellingPrice:    utils.ToNumeric(sellingPrice),
+			WarrantyPeriod:  parent.WarrantyPeriod,
+			Stock:           utils.Int32(req.Stock),
+			Image:           parent.Image,
+			GoldType:        parent.GoldType,
+			ParentID:        utils.Int32(parent.ID),
+			Size:            pgtype.Text{String: req.Size, Valid: true},
+			AlloyID:         parent.AlloyID,
+		})
+		if err != nil {
+			if isUniqueViolation(err) {
+				return status.Errorf(codes.AlreadyExists, "product code %s already exists", req.Code)
+			}
+			return status.Errorf(codes.Internal, "failed to create variant: %v", err)
+		}
+		if err := s.saveProductStones(ctx, q, product.ID, parentStones); err != nil {
+			return err
+		}
+		if req.Stock == 0 {
+			return nil
+		}
+		_, err = q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+			ProductID:    product.ID,
+			Quantity:     req.Stock,
+			MovementType: consts.MOVEMENT_OPENING,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create stock movement: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("failed to create variant", zap.Error(err))
+		return nil, err
+	}
+	s.publishProducts(mqconsts.TOPIC_CREATE_PRODUCT, product)
+
+	resp := &api.ProductResponse{Product: s.productToProto(product)}
+	if err := s.withStones(ctx, s.queries, resp.Product); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// withVariants loads the variants of the given parent products in one query
+func (s *Service) withVariants(ctx context.Context, q db.Querier, parents ...*api.Product) error {
+	if len(parents) == 0 {
+		return nil
+	}
+
+	ids := make([]int32, 0, len(parents))
+	byID := make(map[int32][]*api.Product, len(parents))
+	for _, p := range parents {
+		ids = append(ids, p.Id)
+		byID[p.Id] = append(byID[p.Id], p)
+	}
+
+	variants, err := q.ListProductVariantsByParentIDs(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list variants: %v", err)
+	}
+	protos := make([]*api.Product, 0, len(variants))
+	for _, v := range variants {
+		vp := s.productToProto(v)
+		protos = append(protos, vp)
+		for _, p := range byID[v.ParentID.Int32] {
+			p.Variants = append(p.Variants, vp)
+		}
+	}
+	return s.withStones(ctx, q, protos...)
+}
+
+// syncProductVariants copies the shared attributes and stones of a parent onto
+// its variants
+func (s *Service) syncProductVariants(ctx context.Context, q *db.Queries, parent db.Product) error {
+	if parent.ParentID.Valid {
+		return nil
+	}
+	err := q.SyncProductVariants(ctx, db.SyncProductVariantsParams{
+		ParentID:   utils.Int32(parent.ID),
+		Name:       parent.Name,
+		CategoryID: parent.CategoryID,
+		Image:      parent.Image,
+		LaborCost:  parent.LaborCost,
+		StoneCost:  parent.StoneCost,
+		MarkupRate: parent.MarkupRate,
+		GoldType:   parent.GoldType,
+		AlloyID:    parent.AlloyID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to sync variants: %v", err)
+	}
+
+	variants, err := q.ListProductVariantsByParentIDs(ctx, []int32{parent.ID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list variants: %v", err)
+	}
+	if len(variants) == 0 {
+		return nil
+	}
+	stones, err := q.ListProductStonesByProductIDs(ctx, []int32{parent.ID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list stones: %v", err)
+	}
+	parentStones := make([]*api.ProductStone, 0, len(stones))
+	for _, st := range stones {
+		parentStones = append(parentStones, productStoneToProto(st))
+	}
+	for _, v := range variants {
+		if err := s.saveProductStones(ctx, q, v.ID, parentStones); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkVariantUpdate rejects edits of the attributes a variant shares with its
+// parent, they are edited on the parent and synced down
+func checkVariantUpdate(current db.Product, req *api.UpdateProductRequest) error {
+	if !current.ParentID.Valid {
+		return nil
+	}
+	shared := req.Name != current.Name.String ||
+		req.CategoryId != current.CategoryID.Int32 ||
+		req.Image != current.Image.String ||
+		req.LaborCost != utils.NumericToFloat64(current.LaborCost) ||
+		req.StoneCost != utils.NumericToFloat64(current.StoneCost) ||
+		req.MarkupRate != utils.NumericToFloat64(current.MarkupRate) ||
+		(req.AlloyId != nil && *req.AlloyId != current.AlloyID.Int32) ||
+		req.ReplaceStones || len(req.Stones) > 0
+	if shared {
+		return status.Errorf(codes.InvalidArgument, "product %s is a variant, edit its name, category, image, pricing and stones on the parent", current.Code)
+	}
+	return nil
+}
+
+// repriceProductTree recomputes the selling price of the product and of its
+// variants, each on its own weight, off the given gold price
+func (s *Service) repriceProductTree(ctx context.Context, q *db.Queries, product db.Product, goldBuyPrice float64) (db.Product, error) {
+	products := []db.Product{product}
+	if !product.ParentID.Valid {
+		variants, err := q.ListProductVariantsByParentIDs(ctx, []int32{product.ID})
+		if err != nil {
+			return product, status.Errorf(codes.Internal, "failed to list variants: %v", err)
+		}
+		products = append(products, variants...)
+	}
+
+	for i, p := range products {
+		sellingPrice := calcSellingPrice(
+			goldBuyPrice,
+			utils.NumericToFloat64(p.Weight),
+			utils.NumericToFloat64(p.LaborCost),
+			utils.NumericToFloat64(p.StoneCost),
+			utils.NumericToFloat64(p.MarkupRate),
+		)
+		repriced, err := q.SetProductPrice(ctx, db.SetProductPriceParams{
+			GoldPriceAtTime: utils.ToNumeric(goldBuyPrice),
+			SellingPrice:    utils.ToNumeric(sellingPrice),
+			ID:              p.ID,
+		})
+		if err != nil {
+			return product, status.Errorf(codes.Internal, "failed to reprice product %s: %v", p.Code, err)
+		}
+		if i == 0 {
+			product = repriced
+		}
+	}
+	return product, nil
+}
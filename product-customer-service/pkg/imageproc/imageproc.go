@@ -0,0 +1,155 @@
+package imageproc
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatWebP = "webp"
+
+	maxPixels   = 40_000_000 // refuse decompression bombs
+	jpegQuality = 85
+)
+
+// Size is the longest side of a rendition, images are never upscaled
+type Size struct {
+	Name    string
+	MaxSide int
+}
+
+var Sizes = []Size{
+	{Name: "thumbnail", MaxSide: 200},
+	{Name: "medium", MaxSide: 600},
+	{Name: "large", MaxSide: 1200},
+}
+
+// Rendition is one encoded size/format of the uploaded image
+type Rendition struct {
+	Size        string
+	Format      string
+	Width       int
+	Height      int
+	Data        []byte
+	ContentType string
+}
+
+// Detect returns the image format from the magic bytes, the client content
+// type and file extension are not trusted
+func Detect(data []byte) (string, error) {
+	switch {
+	case len(data) >= 3 && bytes.Equal(data[:3], []byte{0xff, 0xd8, 0xff}):
+		return FormatJPEG, nil
+	case len(data) >= 8 && bytes.Equal(data[:8], []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG, nil
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return FormatWebP, nil
+	default:
+		return "", fmt.Errorf("unsupported file type, only jpeg, png and webp images are accepted")
+	}
+}
+
+// Decode decodes the image and applies the EXIF orientation of jpeg files.
+// The metadata itself is dropped since every rendition is encoded again.
+func Decode(data []byte) (image.Image, string, error) {
+	format, err := Detect(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var cfg image.Config
+	switch format {
+	case FormatJPEG:
+		cfg, err = jpeg.DecodeConfig(bytes.NewReader(data))
+	case FormatPNG:
+		cfg, err = png.DecodeConfig(bytes.NewReader(data))
+	case FormatWebP:
+		cfg, err = webp.DecodeConfig(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid %s image: %w", format, err)
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, "", fmt.Errorf("image is too large: %dx%d", cfg.Width, cfg.Height)
+	}
+
+	var img image.Image
+	switch format {
+	case FormatJPEG:
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case FormatPNG:
+		img, err = png.Decode(bytes.NewReader(data))
+	case FormatWebP:
+		img, err = webp.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid %s image: %w", format, err)
+	}
+
+	if format == FormatJPEG {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return img, format, nil
+}
+
+// Renditions resizes the image to every size and encodes it as JPEG. WebP
+// uploads are accepted but not produced, the standard library and x/image
+// have no lossy WebP encoder.
+func Renditions(img image.Image) ([]Rendition, error) {
+	var out []Rendition
+	for _, size := range Sizes {
+		resized := resize(img, size.MaxSide)
+		b := resized.Bounds()
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, flatten(resized), &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode %s jpeg: %w", size.Name, err)
+		}
+		out = append(out, Rendition{
+			Size:        size.Name,
+			Format:      FormatJPEG,
+			Width:       b.Dx(),
+			Height:      b.Dy(),
+			Data:        buf.Bytes(),
+			ContentType: "image/jpeg",
+		})
+	}
+	return out, nil
+}
+
+func resize(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+		draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+		return dst
+	}
+	if w >= h {
+		h = max(1, h*maxSide/w)
+		w = maxSide
+	} else {
+		w = max(1, w*maxSide/h)
+		h = maxSide
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+// flatten puts transparent pixels on white, jpeg has no alpha
+func flatten(img image.Image) image.Image {
+	b := img.Bounds()
+	dst := image.NewRGBA(b)
+	draw.Draw(dst, b, image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, b, img, b.Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,192 @@
+package imageproc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// photo returns a w x h gradient with a transparent corner
+func photo(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			a := uint8(0xff)
+			if x < 10 && y < 10 {
+				a = 0
+			}
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: uint8(x + y), A: a})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF APP1 segment holding the orientation tag
+// right after the SOI marker of a jpeg
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	ifd := make([]byte, 2+12+4)
+	binary.LittleEndian.PutUint16(ifd[0:], 1)
+	binary.LittleEndian.PutUint16(ifd[2:], exifOrientationTag)
+	binary.LittleEndian.PutUint16(ifd[4:], 3) // SHORT
+	binary.LittleEndian.PutUint32(ifd[6:], 1)
+	binary.LittleEndian.PutUint16(ifd[10:], orientation)
+	payload := append(append([]byte("Exif\x00\x00"), tiff...), ifd...)
+
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func TestDetect(t *testing.T) {
+	img := photo(8, 8)
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "png", data: encodePNG(t, img), want: FormatPNG},
+		{name: "jpeg", data: encodeJPEG(t, img), want: FormatJPEG},
+		{name: "webp", data: []byte("RIFF\x00\x00\x00\x00WEBPVP8L"), want: FormatWebP},
+		{name: "gif", data: []byte("GIF89a......"), wantErr: true},
+		{name: "empty", data: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Detect(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Detect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Detect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenditionsRoundTrip(t *testing.T) {
+	img, format, err := Decode(encodePNG(t, photo(600, 401)))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if format != FormatPNG {
+		t.Fatalf("format = %q, want %q", format, FormatPNG)
+	}
+
+	renditions, err := Renditions(img)
+	if err != nil {
+		t.Fatalf("Renditions: %v", err)
+	}
+	want := map[string]image.Point{
+		"thumbnail": {200, 133},
+		"medium":    {600, 401},
+		"large":     {600, 401}, // never upscaled
+	}
+	if len(renditions) != len(want) {
+		t.Fatalf("got %d renditions, want %d", len(renditions), len(want))
+	}
+	for _, r := range renditions {
+		size, ok := want[r.Size]
+		if !ok {
+			t.Fatalf("unexpected rendition %q", r.Size)
+		}
+		if r.Format != FormatJPEG || r.ContentType != "image/jpeg" {
+			t.Errorf("%s: format %s %s, want jpeg", r.Size, r.Format, r.ContentType)
+		}
+		if r.Width != size.X || r.Height != size.Y {
+			t.Errorf("%s: size %dx%d, want %dx%d", r.Size, r.Width, r.Height, size.X, size.Y)
+		}
+		// a 600x401 rendition must stay far below the size of the raw pixels
+		if len(r.Data) > 100<<10 {
+			t.Errorf("%s: %d bytes, renditions should be compressed", r.Size, len(r.Data))
+		}
+
+		decoded, format, err := Decode(r.Data)
+		if err != nil {
+			t.Fatalf("%s: decode rendition: %v", r.Size, err)
+		}
+		if format != FormatJPEG {
+			t.Errorf("%s: decoded format %q", r.Size, format)
+		}
+		if b := decoded.Bounds(); b.Dx() != size.X || b.Dy() != size.Y {
+			t.Errorf("%s: decoded size %dx%d, want %dx%d", r.Size, b.Dx(), b.Dy(), size.X, size.Y)
+		}
+		// the transparent corner is flattened on white
+		if c := color.RGBAModel.Convert(decoded.At(0, 0)).(color.RGBA); c.R < 0xf0 || c.G < 0xf0 || c.B < 0xf0 {
+			t.Errorf("%s: transparent pixel decoded as %v, want white", r.Size, c)
+		}
+	}
+}
+
+func TestDecodeAppliesAndStripsExifOrientation(t *testing.T) {
+	data := withOrientation(encodeJPEG(t, photo(40, 20)), 6) // rotate 90 CW
+	if got := jpegOrientation(data); got != 6 {
+		t.Fatalf("jpegOrientation() = %d, want 6", got)
+	}
+
+	img, _, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 20 || b.Dy() != 40 {
+		t.Fatalf("decoded size %dx%d, want 20x40", b.Dx(), b.Dy())
+	}
+
+	renditions, err := Renditions(img)
+	if err != nil {
+		t.Fatalf("Renditions: %v", err)
+	}
+	for _, r := range renditions {
+		if bytes.Contains(r.Data, []byte("Exif\x00\x00")) {
+			t.Errorf("%s: rendition still carries EXIF data", r.Size)
+		}
+		if got := jpegOrientation(r.Data); got != 1 {
+			t.Errorf("%s: orientation %d, want 1", r.Size, got)
+		}
+	}
+}
+
+func TestDecodeRejectsDecompressionBomb(t *testing.T) {
+	// the header alone claims more pixels than allowed
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	data := buf.Bytes()
+	binary.BigEndian.PutUint32(data[16:], 10000) // IHDR width
+	binary.BigEndian.PutUint32(data[20:], 10000) // IHDR height
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	_, _, err := Decode(data)
+	if err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Fatalf("Decode() error = %v, want image is too large", err)
+	}
+}
@@ -0,0 +1,185 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Label is the content printed on a single price tag
+type Label struct {
+	Code     string
+	Name     string
+	GoldType string
+	Weight   float64 // gram
+	Price    float64 // VND
+}
+
+// label stock, 50 x 30 mm like the ZPL labels, one label per page
+const (
+	labelWidth   = 50.0
+	labelHeight  = 30.0
+	labelPadding = 2.0
+	qrSize       = 12.0
+)
+
+// ZPLFont is the Unicode TrueType font used for the ZPL text fields. The
+// resident ^A0 font has no Vietnamese glyphs, so the font has to be
+// downloaded to the printer once (e.g. with ZebraNet Bridge).
+const ZPLFont = "E:ARIALUNI.TTF"
+
+// RenderPDF renders one label per page at the size of the label stock, ready
+// for a label printer
+func RenderPDF(labels []Label) ([]byte, error) {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "mm",
+		Size:           gofpdf.SizeType{Wd: labelWidth, Ht: labelHeight},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+
+	for _, l := range labels {
+		pdf.AddPage()
+		if err := drawLabel(pdf, l, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func drawLabel(pdf *gofpdf.Fpdf, l Label, x, y float64) error {
+	textWidth := labelWidth - qrSize - 3*labelPadding
+
+	// core PDF fonts are not unicode, print names without diacritics
+	pdf.SetFont("Arial", "B", 7)
+	pdf.SetXY(x+labelPadding, y+labelPadding)
+	pdf.CellFormat(textWidth, 3.5, fitText(pdf, toASCII(l.Name), textWidth), "", 0, "L", false, 0, "")
+
+	pdf.SetFont("Arial", "", 6)
+	pdf.SetXY(x+labelPadding, y+labelPadding+3.5)
+	pdf.CellFormat(textWidth, 3, toASCII(fmt.Sprintf("%s - %sg", l.GoldType, formatWeight(l.Weight))), "", 0, "L", false, 0, "")
+
+	pdf.SetFont("Arial", "B", 8)
+	pdf.SetXY(x+labelPadding, y+labelPadding+6.5)
+	pdf.CellFormat(textWidth, 3.5, FormatVND(l.Price), "", 0, "L", false, 0, "")
+
+	bc, err := code128.Encode(l.Code)
+	if err != nil {
+		return fmt.Errorf("failed to encode barcode for %s: %w", l.Code, err)
+	}
+	if err := placeImage(pdf, "bc-"+l.Code, bc, 300, 60, x+labelPadding, y+labelPadding+11, labelWidth-2*labelPadding, 9); err != nil {
+		return err
+	}
+
+	pdf.SetFont("Arial", "", 6)
+	pdf.SetXY(x+labelPadding, y+labelPadding+20.5)
+	pdf.CellFormat(labelWidth-2*labelPadding, 3, l.Code, "", 0, "C", false, 0, "")
+
+	qrCode, err := qr.Encode(l.Code, qr.M, qr.Auto)
+	if err != nil {
+		return fmt.Errorf("failed to encode qr code for %s: %w", l.Code, err)
+	}
+	return placeImage(pdf, "qr-"+l.Code, qrCode, 200, 200, x+labelWidth-labelPadding-qrSize, y+labelPadding-0.5, qrSize, qrSize)
+}
+
+// placeImage registers the barcode once per document and draws it
+func placeImage(pdf *gofpdf.Fpdf, name string, code barcode.Barcode, pxW, pxH int, x, y, w, h float64) error {
+	if info := pdf.GetImageInfo(name); info == nil {
+		scaled, err := barcode.Scale(code, pxW, pxH)
+		if err != nil {
+			return fmt.Errorf("failed to scale %s: %w", name, err)
+		}
+		// barcodes are 16-bit gray, which gofpdf cannot embed
+		gray := image.NewGray(scaled.Bounds())
+		draw.Draw(gray, gray.Bounds(), scaled, scaled.Bounds().Min, draw.Src)
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, gray); err != nil {
+			return fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		pdf.RegisterImageOptionsReader(name, gofpdf.ImageOptions{ImageType: "PNG"}, &buf)
+	}
+	pdf.ImageOptions(name, x, y, w, h, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	return pdf.Error()
+}
+
+// RenderZPL returns one ^XA...^XZ block per label for Zebra printers (203 dpi, 50 x 30 mm)
+func RenderZPL(labels []Label) []byte {
+	var b strings.Builder
+	for _, l := range labels {
+		b.WriteString("^XA\n")
+		b.WriteString("^CI28\n") // UTF-8
+		b.WriteString("^PW400\n^LL240\n")
+		fmt.Fprintf(&b, "^FO16,12^A@N,24,24,%s^FB300,1,0,L^FD%s^FS\n", ZPLFont, zplEscape(l.Name))
+		fmt.Fprintf(&b, "^FO16,40^A@N,20,20,%s^FD%s - %sg^FS\n", ZPLFont, zplEscape(l.GoldType), formatWeight(l.Weight))
+		fmt.Fprintf(&b, "^FO16,64^A@N,28,28,%s^FD%s^FS\n", ZPLFont, FormatVND(l.Price))
+		fmt.Fprintf(&b, "^FO16,100^BY2^BCN,80,Y,N,N^FD%s^FS\n", zplEscape(l.Code))
+		fmt.Fprintf(&b, "^FO320,12^BQN,2,3^FDMA,%s^FS\n", zplEscape(l.Code))
+		b.WriteString("^XZ\n")
+	}
+	return []byte(b.String())
+}
+
+// FormatVND formats a price as 12.500.000 VND
+func FormatVND(n float64) string {
+	s := strconv.FormatInt(int64(n+0.5), 10)
+	var out []byte
+	for i := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			out = append(out, '.')
+		}
+		out = append(out, s[i])
+	}
+	return string(out) + " VND"
+}
+
+func formatWeight(w float64) string {
+	return strconv.FormatFloat(w, 'f', -1, 64)
+}
+
+// fitText cuts the text so it fits into the given width
+func fitText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	r := []rune(text)
+	for len(r) > 0 && pdf.GetStringWidth(string(r)+"...") > width {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "..."
+}
+
+// toASCII drops Vietnamese diacritics: "Nhẫn vàng Đẹp" -> "Nhan vang Dep"
+func toASCII(s string) string {
+	s = strings.NewReplacer("đ", "d", "Đ", "D").Replace(s)
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	out, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return out
+}
+
+// zplEscape keeps field data from closing the ^FD command early
+func zplEscape(s string) string {
+	return strings.NewReplacer("^", " ", "~", " ").Replace(s)
+}
@@ -0,0 +1,110 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	ContentTypeCSV  = "text/csv"
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// FormatOf returns the format from the file extension
+func FormatOf(filename string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported file type %q, use .csv or .xlsx", filepath.Ext(filename))
+	}
+}
+
+// Read returns all rows of the file, for xlsx the first sheet is read
+func Read(filename string, data []byte) ([][]string, error) {
+	format, err := FormatOf(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == FormatCSV {
+		// Excel saves UTF-8 csv files with a BOM
+		data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+		rows, err := r.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+		return rows, nil
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open xlsx: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("xlsx has no sheet")
+	}
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xlsx: %w", err)
+	}
+	return rows, nil
+}
+
+// Write renders the rows in the given format
+func Write(format string, sheet string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if format == FormatCSV {
+		// BOM so Excel opens Vietnamese names correctly
+		buf.WriteString("\xef\xbb\xbf")
+		w := csv.NewWriter(&buf)
+		if err := w.WriteAll(rows); err != nil {
+			return nil, fmt.Errorf("failed to write csv: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	f := excelize.NewFile()
+	defer f.Close()
+	if err := f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+		return nil, fmt.Errorf("failed to name sheet: %w", err)
+	}
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	for i, row := range rows {
+		cells := make([]interface{}, len(row))
+		for j, v := range row {
+			cells[j] = v
+		}
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := sw.SetRow(cell, cells); err != nil {
+			return nil, fmt.Errorf("failed to write xlsx row %d: %w", i+1, err)
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	if err := f.Write(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write xlsx: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -24,6 +24,10 @@ VALUES
 ('Gold Bracelet', 3, 1, 8.75, 5600000, 800000, 0, 8.50, 9000000, 6, 'bracelet1.jpg', NOW(), NOW(), 10),
 ('Platinum Earrings', 4, 3, 3.40, 7500000, 400000, 500000, 12.00, 8900000, 18, 'earring1.jpg', NOW(), NOW(), 10);
 
+-- Opening balance of the stock ledger
+INSERT INTO stock_movements (product_id, quantity, movement_type, note, created_at)
+SELECT id, stock, 'opening', 'seed', NOW() FROM products;
+
 -- Seed data for customers
 INSERT INTO customers 
 (name, phone, email, address, created_at, updated_at) 
@@ -32,3 +36,9 @@ VALUES
 ('Tran Thi B', '0912345678', 'thib@example.com', '45 Le Loi, Ho Chi Minh', NOW(), NOW()),
 ('Le Van C', '0923456789', 'vanc@example.com', '78 Tran Hung Dao, Da Nang', NOW(), NOW()),
 ('Pham Thi D', '0934567890', 'thid@example.com', '12 Phan Boi Chau, Hue', NOW(), NOW());
+
+-- Seed data for product stones
+INSERT INTO product_stones
+(product_id, stone_type, carat, cut, color, clarity, stone_count, certificate_lab, certificate_number, created_at)
+SELECT id, 'diamond', 0.50, 'Excellent', 'F', 'VS1', 1, 'GIA', '2141438167', NOW()
+FROM products WHERE code = '2';
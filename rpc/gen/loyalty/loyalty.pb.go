@@ -442,6 +442,50 @@ func (x *GetCustomerTotalPointsRequest) GetCustomerId() string {
 	return ""
 }
 
+type GetCustomersTotalPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerIds   []string               `protobuf:"bytes,1,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersTotalPointsRequest) Reset() {
+	*x = GetCustomersTotalPointsRequest{}
+	mi := &file_loyalty_loyalty_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersTotalPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersTotalPointsRequest) ProtoMessage() {}
+
+func (x *GetCustomersTotalPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loyalty_loyalty_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersTotalPointsRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomersTotalPointsRequest) Descriptor() ([]byte, []int) {
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCustomersTotalPointsRequest) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
 // Response messages for loyalty points
 type GetLoyaltyPointResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -452,7 +496,7 @@ type GetLoyaltyPointResponse struct {
 
 func (x *GetLoyaltyPointResponse) Reset() {
 	*x = GetLoyaltyPointResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[8]
+	mi := &file_loyalty_loyalty_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +508,7 @@ func (x *GetLoyaltyPointResponse) String() string {
 func (*GetLoyaltyPointResponse) ProtoMessage() {}
 
 func (x *GetLoyaltyPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[8]
+	mi := &file_loyalty_loyalty_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +521,7 @@ func (x *GetLoyaltyPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoyaltyPointResponse.ProtoReflect.Descriptor instead.
 func (*GetLoyaltyPointResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{8}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetLoyaltyPointResponse) GetLoyaltyPoint() *LoyaltyPoint {
@@ -497,7 +541,7 @@ type GetLoyaltyPointsResponse struct {
 
 func (x *GetLoyaltyPointsResponse) Reset() {
 	*x = GetLoyaltyPointsResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[9]
+	mi := &file_loyalty_loyalty_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +553,7 @@ func (x *GetLoyaltyPointsResponse) String() string {
 func (*GetLoyaltyPointsResponse) ProtoMessage() {}
 
 func (x *GetLoyaltyPointsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[9]
+	mi := &file_loyalty_loyalty_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +566,7 @@ func (x *GetLoyaltyPointsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoyaltyPointsResponse.ProtoReflect.Descriptor instead.
 func (*GetLoyaltyPointsResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{9}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetLoyaltyPointsResponse) GetLoyaltyPoints() []*LoyaltyPoint {
@@ -549,7 +593,7 @@ type GetCustomerTotalPointsResponse struct {
 
 func (x *GetCustomerTotalPointsResponse) Reset() {
 	*x = GetCustomerTotalPointsResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[10]
+	mi := &file_loyalty_loyalty_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +605,7 @@ func (x *GetCustomerTotalPointsResponse) String() string {
 func (*GetCustomerTotalPointsResponse) ProtoMessage() {}
 
 func (x *GetCustomerTotalPointsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[10]
+	mi := &file_loyalty_loyalty_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +618,7 @@ func (x *GetCustomerTotalPointsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerTotalPointsResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerTotalPointsResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{10}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetCustomerTotalPointsResponse) GetTotalPoints() int32 {
@@ -591,6 +635,50 @@ func (x *GetCustomerTotalPointsResponse) GetCustomerId() string {
 	return ""
 }
 
+type GetCustomersTotalPointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalPoints   map[string]int32       `protobuf:"bytes,1,rep,name=total_points,json=totalPoints,proto3" json:"total_points,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersTotalPointsResponse) Reset() {
+	*x = GetCustomersTotalPointsResponse{}
+	mi := &file_loyalty_loyalty_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersTotalPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersTotalPointsResponse) ProtoMessage() {}
+
+func (x *GetCustomersTotalPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loyalty_loyalty_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersTotalPointsResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomersTotalPointsResponse) Descriptor() ([]byte, []int) {
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCustomersTotalPointsResponse) GetTotalPoints() map[string]int32 {
+	if x != nil {
+		return x.TotalPoints
+	}
+	return nil
+}
+
 // Request messages for vouchers
 type CreateVoucherRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -608,7 +696,7 @@ type CreateVoucherRequest struct {
 
 func (x *CreateVoucherRequest) Reset() {
 	*x = CreateVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[11]
+	mi := &file_loyalty_loyalty_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -620,7 +708,7 @@ func (x *CreateVoucherRequest) String() string {
 func (*CreateVoucherRequest) ProtoMessage() {}
 
 func (x *CreateVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[11]
+	mi := &file_loyalty_loyalty_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,7 +721,7 @@ func (x *CreateVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVoucherRequest.ProtoReflect.Descriptor instead.
 func (*CreateVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{11}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateVoucherRequest) GetCode() string {
@@ -701,7 +789,7 @@ type GetVoucherRequest struct {
 
 func (x *GetVoucherRequest) Reset() {
 	*x = GetVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[12]
+	mi := &file_loyalty_loyalty_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +801,7 @@ func (x *GetVoucherRequest) String() string {
 func (*GetVoucherRequest) ProtoMessage() {}
 
 func (x *GetVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[12]
+	mi := &file_loyalty_loyalty_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +814,7 @@ func (x *GetVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVoucherRequest.ProtoReflect.Descriptor instead.
 func (*GetVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{12}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetVoucherRequest) GetId() int32 {
@@ -745,7 +833,7 @@ type GetVoucherByCodeRequest struct {
 
 func (x *GetVoucherByCodeRequest) Reset() {
 	*x = GetVoucherByCodeRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[13]
+	mi := &file_loyalty_loyalty_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +845,7 @@ func (x *GetVoucherByCodeRequest) String() string {
 func (*GetVoucherByCodeRequest) ProtoMessage() {}
 
 func (x *GetVoucherByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[13]
+	mi := &file_loyalty_loyalty_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +858,7 @@ func (x *GetVoucherByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVoucherByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetVoucherByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{13}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetVoucherByCodeRequest) GetCode() string {
@@ -789,7 +877,7 @@ type GetActiveVouchersRequest struct {
 
 func (x *GetActiveVouchersRequest) Reset() {
 	*x = GetActiveVouchersRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[14]
+	mi := &file_loyalty_loyalty_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +889,7 @@ func (x *GetActiveVouchersRequest) String() string {
 func (*GetActiveVouchersRequest) ProtoMessage() {}
 
 func (x *GetActiveVouchersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[14]
+	mi := &file_loyalty_loyalty_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +902,7 @@ func (x *GetActiveVouchersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActiveVouchersRequest.ProtoReflect.Descriptor instead.
 func (*GetActiveVouchersRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{14}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetActiveVouchersRequest) GetPagination() *PaginationRequest {
@@ -833,7 +921,7 @@ type GetAllVouchersRequest struct {
 
 func (x *GetAllVouchersRequest) Reset() {
 	*x = GetAllVouchersRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[15]
+	mi := &file_loyalty_loyalty_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +933,7 @@ func (x *GetAllVouchersRequest) String() string {
 func (*GetAllVouchersRequest) ProtoMessage() {}
 
 func (x *GetAllVouchersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[15]
+	mi := &file_loyalty_loyalty_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +946,7 @@ func (x *GetAllVouchersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllVouchersRequest.ProtoReflect.Descriptor instead.
 func (*GetAllVouchersRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{15}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAllVouchersRequest) GetPagination() *PaginationRequest {
@@ -884,7 +972,7 @@ type UpdateVoucherRequest struct {
 
 func (x *UpdateVoucherRequest) Reset() {
 	*x = UpdateVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[16]
+	mi := &file_loyalty_loyalty_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +984,7 @@ func (x *UpdateVoucherRequest) String() string {
 func (*UpdateVoucherRequest) ProtoMessage() {}
 
 func (x *UpdateVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[16]
+	mi := &file_loyalty_loyalty_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +997,7 @@ func (x *UpdateVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVoucherRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{16}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateVoucherRequest) GetId() int32 {
@@ -977,7 +1065,7 @@ type DeleteVoucherRequest struct {
 
 func (x *DeleteVoucherRequest) Reset() {
 	*x = DeleteVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[17]
+	mi := &file_loyalty_loyalty_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1077,7 @@ func (x *DeleteVoucherRequest) String() string {
 func (*DeleteVoucherRequest) ProtoMessage() {}
 
 func (x *DeleteVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[17]
+	mi := &file_loyalty_loyalty_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1090,7 @@ func (x *DeleteVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVoucherRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{17}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteVoucherRequest) GetId() int32 {
@@ -1022,7 +1110,7 @@ type GetVoucherResponse struct {
 
 func (x *GetVoucherResponse) Reset() {
 	*x = GetVoucherResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[18]
+	mi := &file_loyalty_loyalty_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1034,7 +1122,7 @@ func (x *GetVoucherResponse) String() string {
 func (*GetVoucherResponse) ProtoMessage() {}
 
 func (x *GetVoucherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[18]
+	mi := &file_loyalty_loyalty_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1135,7 @@ func (x *GetVoucherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVoucherResponse.ProtoReflect.Descriptor instead.
 func (*GetVoucherResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{18}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetVoucherResponse) GetVoucher() *Voucher {
@@ -1067,7 +1155,7 @@ type GetVouchersResponse struct {
 
 func (x *GetVouchersResponse) Reset() {
 	*x = GetVouchersResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[19]
+	mi := &file_loyalty_loyalty_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1079,7 +1167,7 @@ func (x *GetVouchersResponse) String() string {
 func (*GetVouchersResponse) ProtoMessage() {}
 
 func (x *GetVouchersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[19]
+	mi := &file_loyalty_loyalty_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1180,7 @@ func (x *GetVouchersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVouchersResponse.ProtoReflect.Descriptor instead.
 func (*GetVouchersResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{19}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetVouchersResponse) GetVouchers() []*Voucher {
@@ -1121,7 +1209,7 @@ type CreateCustomerVoucherRequest struct {
 
 func (x *CreateCustomerVoucherRequest) Reset() {
 	*x = CreateCustomerVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[20]
+	mi := &file_loyalty_loyalty_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1133,7 +1221,7 @@ func (x *CreateCustomerVoucherRequest) String() string {
 func (*CreateCustomerVoucherRequest) ProtoMessage() {}
 
 func (x *CreateCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[20]
+	mi := &file_loyalty_loyalty_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1234,7 @@ func (x *CreateCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomerVoucherRequest.ProtoReflect.Descriptor instead.
 func (*CreateCustomerVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{20}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateCustomerVoucherRequest) GetCustomerId() string {
@@ -1179,7 +1267,7 @@ type GetCustomerVoucherRequest struct {
 
 func (x *GetCustomerVoucherRequest) Reset() {
 	*x = GetCustomerVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[21]
+	mi := &file_loyalty_loyalty_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1279,7 @@ func (x *GetCustomerVoucherRequest) String() string {
 func (*GetCustomerVoucherRequest) ProtoMessage() {}
 
 func (x *GetCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[21]
+	mi := &file_loyalty_loyalty_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1292,7 @@ func (x *GetCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerVoucherRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{21}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetCustomerVoucherRequest) GetId() int32 {
@@ -1224,7 +1312,7 @@ type GetCustomerVouchersRequest struct {
 
 func (x *GetCustomerVouchersRequest) Reset() {
 	*x = GetCustomerVouchersRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[22]
+	mi := &file_loyalty_loyalty_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1236,7 +1324,7 @@ func (x *GetCustomerVouchersRequest) String() string {
 func (*GetCustomerVouchersRequest) ProtoMessage() {}
 
 func (x *GetCustomerVouchersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[22]
+	mi := &file_loyalty_loyalty_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,7 +1337,7 @@ func (x *GetCustomerVouchersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerVouchersRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerVouchersRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{22}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetCustomerVouchersRequest) GetCustomerId() string {
@@ -1277,7 +1365,7 @@ type GetCustomerVouchersByStatusRequest struct {
 
 func (x *GetCustomerVouchersByStatusRequest) Reset() {
 	*x = GetCustomerVouchersByStatusRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[23]
+	mi := &file_loyalty_loyalty_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +1377,7 @@ func (x *GetCustomerVouchersByStatusRequest) String() string {
 func (*GetCustomerVouchersByStatusRequest) ProtoMessage() {}
 
 func (x *GetCustomerVouchersByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[23]
+	mi := &file_loyalty_loyalty_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +1390,7 @@ func (x *GetCustomerVouchersByStatusRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCustomerVouchersByStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerVouchersByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{23}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetCustomerVouchersByStatusRequest) GetCustomerId() string {
@@ -1335,7 +1423,7 @@ type GetAllCustomerVouchersRequest struct {
 
 func (x *GetAllCustomerVouchersRequest) Reset() {
 	*x = GetAllCustomerVouchersRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[24]
+	mi := &file_loyalty_loyalty_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +1435,7 @@ func (x *GetAllCustomerVouchersRequest) String() string {
 func (*GetAllCustomerVouchersRequest) ProtoMessage() {}
 
 func (x *GetAllCustomerVouchersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[24]
+	mi := &file_loyalty_loyalty_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1448,7 @@ func (x *GetAllCustomerVouchersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllCustomerVouchersRequest.ProtoReflect.Descriptor instead.
 func (*GetAllCustomerVouchersRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{24}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetAllCustomerVouchersRequest) GetPagination() *PaginationRequest {
@@ -1379,7 +1467,7 @@ type UseCustomerVoucherRequest struct {
 
 func (x *UseCustomerVoucherRequest) Reset() {
 	*x = UseCustomerVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[25]
+	mi := &file_loyalty_loyalty_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1391,7 +1479,7 @@ func (x *UseCustomerVoucherRequest) String() string {
 func (*UseCustomerVoucherRequest) ProtoMessage() {}
 
 func (x *UseCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[25]
+	mi := &file_loyalty_loyalty_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +1492,7 @@ func (x *UseCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UseCustomerVoucherRequest.ProtoReflect.Descriptor instead.
 func (*UseCustomerVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{25}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UseCustomerVoucherRequest) GetId() int32 {
@@ -1424,7 +1512,7 @@ type UpdateCustomerVoucherStatusRequest struct {
 
 func (x *UpdateCustomerVoucherStatusRequest) Reset() {
 	*x = UpdateCustomerVoucherStatusRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[26]
+	mi := &file_loyalty_loyalty_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1436,7 +1524,7 @@ func (x *UpdateCustomerVoucherStatusRequest) String() string {
 func (*UpdateCustomerVoucherStatusRequest) ProtoMessage() {}
 
 func (x *UpdateCustomerVoucherStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[26]
+	mi := &file_loyalty_loyalty_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1537,7 @@ func (x *UpdateCustomerVoucherStatusRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateCustomerVoucherStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCustomerVoucherStatusRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{26}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateCustomerVoucherStatusRequest) GetId() int32 {
@@ -1475,7 +1563,7 @@ type DeleteCustomerVoucherRequest struct {
 
 func (x *DeleteCustomerVoucherRequest) Reset() {
 	*x = DeleteCustomerVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[27]
+	mi := &file_loyalty_loyalty_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1487,7 +1575,7 @@ func (x *DeleteCustomerVoucherRequest) String() string {
 func (*DeleteCustomerVoucherRequest) ProtoMessage() {}
 
 func (x *DeleteCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[27]
+	mi := &file_loyalty_loyalty_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,7 +1588,7 @@ func (x *DeleteCustomerVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCustomerVoucherRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCustomerVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{27}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteCustomerVoucherRequest) GetId() int32 {
@@ -1520,7 +1608,7 @@ type GetAvailableVouchersForCustomerRequest struct {
 
 func (x *GetAvailableVouchersForCustomerRequest) Reset() {
 	*x = GetAvailableVouchersForCustomerRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[28]
+	mi := &file_loyalty_loyalty_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1532,7 +1620,7 @@ func (x *GetAvailableVouchersForCustomerRequest) String() string {
 func (*GetAvailableVouchersForCustomerRequest) ProtoMessage() {}
 
 func (x *GetAvailableVouchersForCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[28]
+	mi := &file_loyalty_loyalty_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +1633,7 @@ func (x *GetAvailableVouchersForCustomerRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetAvailableVouchersForCustomerRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableVouchersForCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{28}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetAvailableVouchersForCustomerRequest) GetCustomerId() string {
@@ -1572,7 +1660,7 @@ type GetCustomerVoucherResponse struct {
 
 func (x *GetCustomerVoucherResponse) Reset() {
 	*x = GetCustomerVoucherResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[29]
+	mi := &file_loyalty_loyalty_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +1672,7 @@ func (x *GetCustomerVoucherResponse) String() string {
 func (*GetCustomerVoucherResponse) ProtoMessage() {}
 
 func (x *GetCustomerVoucherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[29]
+	mi := &file_loyalty_loyalty_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +1685,7 @@ func (x *GetCustomerVoucherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerVoucherResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerVoucherResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{29}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetCustomerVoucherResponse) GetCustomerVoucher() *CustomerVoucher {
@@ -1617,7 +1705,7 @@ type GetCustomerVouchersResponse struct {
 
 func (x *GetCustomerVouchersResponse) Reset() {
 	*x = GetCustomerVouchersResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[30]
+	mi := &file_loyalty_loyalty_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1629,7 +1717,7 @@ func (x *GetCustomerVouchersResponse) String() string {
 func (*GetCustomerVouchersResponse) ProtoMessage() {}
 
 func (x *GetCustomerVouchersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[30]
+	mi := &file_loyalty_loyalty_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,7 +1730,7 @@ func (x *GetCustomerVouchersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerVouchersResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerVouchersResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{30}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetCustomerVouchersResponse) GetCustomerVouchers() []*CustomerVoucher {
@@ -1671,7 +1759,7 @@ type CalculateDiscountAmountRequest struct {
 
 func (x *CalculateDiscountAmountRequest) Reset() {
 	*x = CalculateDiscountAmountRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[31]
+	mi := &file_loyalty_loyalty_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +1771,7 @@ func (x *CalculateDiscountAmountRequest) String() string {
 func (*CalculateDiscountAmountRequest) ProtoMessage() {}
 
 func (x *CalculateDiscountAmountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[31]
+	mi := &file_loyalty_loyalty_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +1784,7 @@ func (x *CalculateDiscountAmountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountAmountRequest.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountAmountRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{31}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CalculateDiscountAmountRequest) GetVouchers() []string {
@@ -1737,7 +1825,7 @@ type CalculateDiscountAmountResponse struct {
 
 func (x *CalculateDiscountAmountResponse) Reset() {
 	*x = CalculateDiscountAmountResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[32]
+	mi := &file_loyalty_loyalty_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1749,7 +1837,7 @@ func (x *CalculateDiscountAmountResponse) String() string {
 func (*CalculateDiscountAmountResponse) ProtoMessage() {}
 
 func (x *CalculateDiscountAmountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[32]
+	mi := &file_loyalty_loyalty_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,7 +1850,7 @@ func (x *CalculateDiscountAmountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountAmountResponse.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountAmountResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{32}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CalculateDiscountAmountResponse) GetTotalDiscountAmount() float64 {
@@ -1791,7 +1879,7 @@ type CalculateDiscountAmountResponse_Voucher struct {
 
 func (x *CalculateDiscountAmountResponse_Voucher) Reset() {
 	*x = CalculateDiscountAmountResponse_Voucher{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[33]
+	mi := &file_loyalty_loyalty_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +1891,7 @@ func (x *CalculateDiscountAmountResponse_Voucher) String() string {
 func (*CalculateDiscountAmountResponse_Voucher) ProtoMessage() {}
 
 func (x *CalculateDiscountAmountResponse_Voucher) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[33]
+	mi := &file_loyalty_loyalty_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +1904,7 @@ func (x *CalculateDiscountAmountResponse_Voucher) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use CalculateDiscountAmountResponse_Voucher.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountAmountResponse_Voucher) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{33}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CalculateDiscountAmountResponse_Voucher) GetCode() string {
@@ -1860,7 +1948,7 @@ type UsingVoucherRequest struct {
 
 func (x *UsingVoucherRequest) Reset() {
 	*x = UsingVoucherRequest{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[34]
+	mi := &file_loyalty_loyalty_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1872,7 +1960,7 @@ func (x *UsingVoucherRequest) String() string {
 func (*UsingVoucherRequest) ProtoMessage() {}
 
 func (x *UsingVoucherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[34]
+	mi := &file_loyalty_loyalty_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,7 +1973,7 @@ func (x *UsingVoucherRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsingVoucherRequest.ProtoReflect.Descriptor instead.
 func (*UsingVoucherRequest) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{34}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UsingVoucherRequest) GetVouchers() []string {
@@ -1933,7 +2021,7 @@ type UsingVoucherResponse struct {
 
 func (x *UsingVoucherResponse) Reset() {
 	*x = UsingVoucherResponse{}
-	mi := &file_loyalty_loyalty_proto_msgTypes[35]
+	mi := &file_loyalty_loyalty_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1945,7 +2033,7 @@ func (x *UsingVoucherResponse) String() string {
 func (*UsingVoucherResponse) ProtoMessage() {}
 
 func (x *UsingVoucherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loyalty_loyalty_proto_msgTypes[35]
+	mi := &file_loyalty_loyalty_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1958,7 +2046,7 @@ func (x *UsingVoucherResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsingVoucherResponse.ProtoReflect.Descriptor instead.
 func (*UsingVoucherResponse) Descriptor() ([]byte, []int) {
-	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{35}
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UsingVoucherResponse) GetTotalDiscountAmount() float64 {
@@ -2015,7 +2103,9 @@ const file_loyalty_loyalty_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"@\n" +
 	"\x1dGetCustomerTotalPointsRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
-	"customerId\"T\n" +
+	"customerId\"C\n" +
+	"\x1eGetCustomersTotalPointsRequest\x12!\n" +
+	"\fcustomer_ids\x18\x01 \x03(\tR\vcustomerIds\"T\n" +
 	"\x17GetLoyaltyPointResponse\x129\n" +
 	"\rloyalty_point\x18\x01 \x01(\v2\x14.common.LoyaltyPointR\floyaltyPoint\"\x93\x01\n" +
 	"\x18GetLoyaltyPointsResponse\x12;\n" +
@@ -2026,7 +2116,12 @@ const file_loyalty_loyalty_proto_rawDesc = "" +
 	"\x1eGetCustomerTotalPointsResponse\x12E\n" +
 	"\ftotal_points\x18\x01 \x01(\x05B\"\x92A\x1f2\x1dTotal points for the customerR\vtotalPoints\x121\n" +
 	"\vcustomer_id\x18\x02 \x01(\tB\x10\x92A\r2\vCustomer IDR\n" +
-	"customerId\"\xd3\x03\n" +
+	"customerId\"\x89\x02\n" +
+	"\x1fGetCustomersTotalPointsResponse\x12\xa5\x01\n" +
+	"\ftotal_points\x18\x01 \x03(\v29.loyalty.GetCustomersTotalPointsResponse.TotalPointsEntryBG\x92AD2BTotal points by customer ID, customers without points are left outR\vtotalPoints\x1a>\n" +
+	"\x10TotalPointsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd3\x03\n" +
 	"\x14CreateVoucherRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12A\n" +
@@ -2147,7 +2242,7 @@ const file_loyalty_loyalty_proto_rawDesc = "" +
 	"\border_id\x18\x05 \x01(\x05R\aorderId\"\x98\x01\n" +
 	"\x14UsingVoucherResponse\x122\n" +
 	"\x15total_discount_amount\x18\x01 \x01(\x01R\x13totalDiscountAmount\x12L\n" +
-	"\bvouchers\x18\x02 \x03(\v20.loyalty.CalculateDiscountAmountResponse_VoucherR\bvouchers2\xd9,\n" +
+	"\bvouchers\x18\x02 \x03(\v20.loyalty.CalculateDiscountAmountResponse_VoucherR\bvouchers2\xe4.\n" +
 	"\aLoyalty\x12\xe3\x01\n" +
 	"\x12CreateLoyaltyPoint\x12\".loyalty.CreateLoyaltyPointRequest\x1a .loyalty.GetLoyaltyPointResponse\"\x86\x01\x92Af\n" +
 	"\x0eLoyalty Points\x12!Create a new loyalty point record\x1a1Creates a new loyalty point record for a customer\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/loyalty-points\x12\xd5\x01\n" +
@@ -2164,7 +2259,9 @@ const file_loyalty_loyalty_proto_rawDesc = "" +
 	"\x12DeleteLoyaltyPoint\x12\".loyalty.DeleteLoyaltyPointRequest\x1a\x16.google.protobuf.Empty\"z\x92AX\n" +
 	"\x0eLoyalty Points\x12\x1dDelete a loyalty point record\x1a'Deletes a specific loyalty point record\x82\xd3\xe4\x93\x02\x19*\x17/v1/loyalty-points/{id}\x12\xfd\x01\n" +
 	"\x16GetCustomerTotalPoints\x12&.loyalty.GetCustomerTotalPointsRequest\x1a'.loyalty.GetCustomerTotalPointsResponse\"\x91\x01\x92A^\n" +
-	"\x0eLoyalty Points\x12\x19Get customer total points\x1a1Retrieves the total loyalty points for a customer\x82\xd3\xe4\x93\x02*\x12(/v1/customers/{customer_id}/total-points\x12\xb4\x01\n" +
+	"\x0eLoyalty Points\x12\x19Get customer total points\x1a1Retrieves the total loyalty points for a customer\x82\xd3\xe4\x93\x02*\x12(/v1/customers/{customer_id}/total-points\x12\x88\x02\n" +
+	"\x17GetCustomersTotalPoints\x12'.loyalty.GetCustomersTotalPointsRequest\x1a(.loyalty.GetCustomersTotalPointsResponse\"\x99\x01\x92Aq\n" +
+	"\x0eLoyalty Points\x12\"Get total points of many customers\x1a;Retrieves the total loyalty points for a batch of customers\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/customers/total-points\x12\xb4\x01\n" +
 	"\rCreateVoucher\x12\x1d.loyalty.CreateVoucherRequest\x1a\x1b.loyalty.GetVoucherResponse\"g\x92AM\n" +
 	"\bVouchers\x12\x14Create a new voucher\x1a+Creates a new voucher with discount details\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/vouchers\x12\xa0\x01\n" +
 	"\n" +
@@ -2221,7 +2318,7 @@ func file_loyalty_loyalty_proto_rawDescGZIP() []byte {
 	return file_loyalty_loyalty_proto_rawDescData
 }
 
-var file_loyalty_loyalty_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_loyalty_loyalty_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_loyalty_loyalty_proto_goTypes = []any{
 	(*CreateLoyaltyPointRequest)(nil),               // 0: loyalty.CreateLoyaltyPointRequest
 	(*GetLoyaltyPointRequest)(nil),                  // 1: loyalty.GetLoyaltyPointRequest
@@ -2231,126 +2328,132 @@ var file_loyalty_loyalty_proto_goTypes = []any{
 	(*UpdateLoyaltyPointRequest)(nil),               // 5: loyalty.UpdateLoyaltyPointRequest
 	(*DeleteLoyaltyPointRequest)(nil),               // 6: loyalty.DeleteLoyaltyPointRequest
 	(*GetCustomerTotalPointsRequest)(nil),           // 7: loyalty.GetCustomerTotalPointsRequest
-	(*GetLoyaltyPointResponse)(nil),                 // 8: loyalty.GetLoyaltyPointResponse
-	(*GetLoyaltyPointsResponse)(nil),                // 9: loyalty.GetLoyaltyPointsResponse
-	(*GetCustomerTotalPointsResponse)(nil),          // 10: loyalty.GetCustomerTotalPointsResponse
-	(*CreateVoucherRequest)(nil),                    // 11: loyalty.CreateVoucherRequest
-	(*GetVoucherRequest)(nil),                       // 12: loyalty.GetVoucherRequest
-	(*GetVoucherByCodeRequest)(nil),                 // 13: loyalty.GetVoucherByCodeRequest
-	(*GetActiveVouchersRequest)(nil),                // 14: loyalty.GetActiveVouchersRequest
-	(*GetAllVouchersRequest)(nil),                   // 15: loyalty.GetAllVouchersRequest
-	(*UpdateVoucherRequest)(nil),                    // 16: loyalty.UpdateVoucherRequest
-	(*DeleteVoucherRequest)(nil),                    // 17: loyalty.DeleteVoucherRequest
-	(*GetVoucherResponse)(nil),                      // 18: loyalty.GetVoucherResponse
-	(*GetVouchersResponse)(nil),                     // 19: loyalty.GetVouchersResponse
-	(*CreateCustomerVoucherRequest)(nil),            // 20: loyalty.CreateCustomerVoucherRequest
-	(*GetCustomerVoucherRequest)(nil),               // 21: loyalty.GetCustomerVoucherRequest
-	(*GetCustomerVouchersRequest)(nil),              // 22: loyalty.GetCustomerVouchersRequest
-	(*GetCustomerVouchersByStatusRequest)(nil),      // 23: loyalty.GetCustomerVouchersByStatusRequest
-	(*GetAllCustomerVouchersRequest)(nil),           // 24: loyalty.GetAllCustomerVouchersRequest
-	(*UseCustomerVoucherRequest)(nil),               // 25: loyalty.UseCustomerVoucherRequest
-	(*UpdateCustomerVoucherStatusRequest)(nil),      // 26: loyalty.UpdateCustomerVoucherStatusRequest
-	(*DeleteCustomerVoucherRequest)(nil),            // 27: loyalty.DeleteCustomerVoucherRequest
-	(*GetAvailableVouchersForCustomerRequest)(nil),  // 28: loyalty.GetAvailableVouchersForCustomerRequest
-	(*GetCustomerVoucherResponse)(nil),              // 29: loyalty.GetCustomerVoucherResponse
-	(*GetCustomerVouchersResponse)(nil),             // 30: loyalty.GetCustomerVouchersResponse
-	(*CalculateDiscountAmountRequest)(nil),          // 31: loyalty.CalculateDiscountAmountRequest
-	(*CalculateDiscountAmountResponse)(nil),         // 32: loyalty.CalculateDiscountAmountResponse
-	(*CalculateDiscountAmountResponse_Voucher)(nil), // 33: loyalty.CalculateDiscountAmountResponse_Voucher
-	(*UsingVoucherRequest)(nil),                     // 34: loyalty.UsingVoucherRequest
-	(*UsingVoucherResponse)(nil),                    // 35: loyalty.UsingVoucherResponse
-	(*PaginationRequest)(nil),                       // 36: common.PaginationRequest
-	(*LoyaltyPoint)(nil),                            // 37: common.LoyaltyPoint
-	(*PaginationResponse)(nil),                      // 38: common.PaginationResponse
-	(Voucher_DiscountType)(0),                       // 39: common.Voucher.DiscountType
-	(*Voucher)(nil),                                 // 40: common.Voucher
-	(CustomerVoucher_Status)(0),                     // 41: common.CustomerVoucher.Status
-	(*CustomerVoucher)(nil),                         // 42: common.CustomerVoucher
-	(*emptypb.Empty)(nil),                           // 43: google.protobuf.Empty
+	(*GetCustomersTotalPointsRequest)(nil),          // 8: loyalty.GetCustomersTotalPointsRequest
+	(*GetLoyaltyPointResponse)(nil),                 // 9: loyalty.GetLoyaltyPointResponse
+	(*GetLoyaltyPointsResponse)(nil),                // 10: loyalty.GetLoyaltyPointsResponse
+	(*GetCustomerTotalPointsResponse)(nil),          // 11: loyalty.GetCustomerTotalPointsResponse
+	(*GetCustomersTotalPointsResponse)(nil),         // 12: loyalty.GetCustomersTotalPointsResponse
+	(*CreateVoucherRequest)(nil),                    // 13: loyalty.CreateVoucherRequest
+	(*GetVoucherRequest)(nil),                       // 14: loyalty.GetVoucherRequest
+	(*GetVoucherByCodeRequest)(nil),                 // 15: loyalty.GetVoucherByCodeRequest
+	(*GetActiveVouchersRequest)(nil),                // 16: loyalty.GetActiveVouchersRequest
+	(*GetAllVouchersRequest)(nil),                   // 17: loyalty.GetAllVouchersRequest
+	(*UpdateVoucherRequest)(nil),                    // 18: loyalty.UpdateVoucherRequest
+	(*DeleteVoucherRequest)(nil),                    // 19: loyalty.DeleteVoucherRequest
+	(*GetVoucherResponse)(nil),                      // 20: loyalty.GetVoucherResponse
+	(*GetVouchersResponse)(nil),                     // 21: loyalty.GetVouchersResponse
+	(*CreateCustomerVoucherRequest)(nil),            // 22: loyalty.CreateCustomerVoucherRequest
+	(*GetCustomerVoucherRequest)(nil),               // 23: loyalty.GetCustomerVoucherRequest
+	(*GetCustomerVouchersRequest)(nil),              // 24: loyalty.GetCustomerVouchersRequest
+	(*GetCustomerVouchersByStatusRequest)(nil),      // 25: loyalty.GetCustomerVouchersByStatusRequest
+	(*GetAllCustomerVouchersRequest)(nil),           // 26: loyalty.GetAllCustomerVouchersRequest
+	(*UseCustomerVoucherRequest)(nil),               // 27: loyalty.UseCustomerVoucherRequest
+	(*UpdateCustomerVoucherStatusRequest)(nil),      // 28: loyalty.UpdateCustomerVoucherStatusRequest
+	(*DeleteCustomerVoucherRequest)(nil),            // 29: loyalty.DeleteCustomerVoucherRequest
+	(*GetAvailableVouchersForCustomerRequest)(nil),  // 30: loyalty.GetAvailableVouchersForCustomerRequest
+	(*GetCustomerVoucherResponse)(nil),              // 31: loyalty.GetCustomerVoucherResponse
+	(*GetCustomerVouchersResponse)(nil),             // 32: loyalty.GetCustomerVouchersResponse
+	(*CalculateDiscountAmountRequest)(nil),          // 33: loyalty.CalculateDiscountAmountRequest
+	(*CalculateDiscountAmountResponse)(nil),         // 34: loyalty.CalculateDiscountAmountResponse
+	(*CalculateDiscountAmountResponse_Voucher)(nil), // 35: loyalty.CalculateDiscountAmountResponse_Voucher
+	(*UsingVoucherRequest)(nil),                     // 36: loyalty.UsingVoucherRequest
+	(*UsingVoucherResponse)(nil),                    // 37: loyalty.UsingVoucherResponse
+	nil,                                             // 38: loyalty.GetCustomersTotalPointsResponse.TotalPointsEntry
+	(*PaginationRequest)(nil),                       // 39: common.PaginationRequest
+	(*LoyaltyPoint)(nil),                            // 40: common.LoyaltyPoint
+	(*PaginationResponse)(nil),                      // 41: common.PaginationResponse
+	(Voucher_DiscountType)(0),                       // 42: common.Voucher.DiscountType
+	(*Voucher)(nil),                                 // 43: common.Voucher
+	(CustomerVoucher_Status)(0),                     // 44: common.CustomerVoucher.Status
+	(*CustomerVoucher)(nil),                         // 45: common.CustomerVoucher
+	(*emptypb.Empty)(nil),                           // 46: google.protobuf.Empty
 }
 var file_loyalty_loyalty_proto_depIdxs = []int32{
-	36, // 0: loyalty.GetLoyaltyPointsByCustomerRequest.pagination:type_name -> common.PaginationRequest
-	36, // 1: loyalty.GetLoyaltyPointsBySourceRequest.pagination:type_name -> common.PaginationRequest
-	36, // 2: loyalty.GetAllLoyaltyPointsRequest.pagination:type_name -> common.PaginationRequest
-	37, // 3: loyalty.GetLoyaltyPointResponse.loyalty_point:type_name -> common.LoyaltyPoint
-	37, // 4: loyalty.GetLoyaltyPointsResponse.loyalty_points:type_name -> common.LoyaltyPoint
-	38, // 5: loyalty.GetLoyaltyPointsResponse.pagination:type_name -> common.PaginationResponse
-	39, // 6: loyalty.CreateVoucherRequest.discount_type:type_name -> common.Voucher.DiscountType
-	36, // 7: loyalty.GetActiveVouchersRequest.pagination:type_name -> common.PaginationRequest
-	36, // 8: loyalty.GetAllVouchersRequest.pagination:type_name -> common.PaginationRequest
-	39, // 9: loyalty.UpdateVoucherRequest.discount_type:type_name -> common.Voucher.DiscountType
-	40, // 10: loyalty.GetVoucherResponse.voucher:type_name -> common.Voucher
-	40, // 11: loyalty.GetVouchersResponse.vouchers:type_name -> common.Voucher
-	38, // 12: loyalty.GetVouchersResponse.pagination:type_name -> common.PaginationResponse
-	41, // 13: loyalty.CreateCustomerVoucherRequest.status:type_name -> common.CustomerVoucher.Status
-	36, // 14: loyalty.GetCustomerVouchersRequest.pagination:type_name -> common.PaginationRequest
-	41, // 15: loyalty.GetCustomerVouchersByStatusRequest.status:type_name -> common.CustomerVoucher.Status
-	36, // 16: loyalty.GetCustomerVouchersByStatusRequest.pagination:type_name -> common.PaginationRequest
-	36, // 17: loyalty.GetAllCustomerVouchersRequest.pagination:type_name -> common.PaginationRequest
-	41, // 18: loyalty.UpdateCustomerVoucherStatusRequest.status:type_name -> common.CustomerVoucher.Status
-	36, // 19: loyalty.GetAvailableVouchersForCustomerRequest.pagination:type_name -> common.PaginationRequest
-	42, // 20: loyalty.GetCustomerVoucherResponse.customer_voucher:type_name -> common.CustomerVoucher
-	42, // 21: loyalty.GetCustomerVouchersResponse.customer_vouchers:type_name -> common.CustomerVoucher
-	38, // 22: loyalty.GetCustomerVouchersResponse.pagination:type_name -> common.PaginationResponse
-	33, // 23: loyalty.CalculateDiscountAmountResponse.vouchers:type_name -> loyalty.CalculateDiscountAmountResponse_Voucher
-	33, // 24: loyalty.UsingVoucherResponse.vouchers:type_name -> loyalty.CalculateDiscountAmountResponse_Voucher
-	0,  // 25: loyalty.Loyalty.CreateLoyaltyPoint:input_type -> loyalty.CreateLoyaltyPointRequest
-	1,  // 26: loyalty.Loyalty.GetLoyaltyPoint:input_type -> loyalty.GetLoyaltyPointRequest
-	2,  // 27: loyalty.Loyalty.GetLoyaltyPointsByCustomer:input_type -> loyalty.GetLoyaltyPointsByCustomerRequest
-	3,  // 28: loyalty.Loyalty.GetLoyaltyPointsBySource:input_type -> loyalty.GetLoyaltyPointsBySourceRequest
-	4,  // 29: loyalty.Loyalty.GetAllLoyaltyPoints:input_type -> loyalty.GetAllLoyaltyPointsRequest
-	5,  // 30: loyalty.Loyalty.UpdateLoyaltyPoint:input_type -> loyalty.UpdateLoyaltyPointRequest
-	6,  // 31: loyalty.Loyalty.DeleteLoyaltyPoint:input_type -> loyalty.DeleteLoyaltyPointRequest
-	7,  // 32: loyalty.Loyalty.GetCustomerTotalPoints:input_type -> loyalty.GetCustomerTotalPointsRequest
-	11, // 33: loyalty.Loyalty.CreateVoucher:input_type -> loyalty.CreateVoucherRequest
-	12, // 34: loyalty.Loyalty.GetVoucher:input_type -> loyalty.GetVoucherRequest
-	13, // 35: loyalty.Loyalty.GetVoucherByCode:input_type -> loyalty.GetVoucherByCodeRequest
-	14, // 36: loyalty.Loyalty.GetActiveVouchers:input_type -> loyalty.GetActiveVouchersRequest
-	15, // 37: loyalty.Loyalty.GetAllVouchers:input_type -> loyalty.GetAllVouchersRequest
-	16, // 38: loyalty.Loyalty.UpdateVoucher:input_type -> loyalty.UpdateVoucherRequest
-	17, // 39: loyalty.Loyalty.DeleteVoucher:input_type -> loyalty.DeleteVoucherRequest
-	20, // 40: loyalty.Loyalty.CreateCustomerVoucher:input_type -> loyalty.CreateCustomerVoucherRequest
-	28, // 41: loyalty.Loyalty.GetAvailableVouchersForCustomer:input_type -> loyalty.GetAvailableVouchersForCustomerRequest
-	21, // 42: loyalty.Loyalty.GetCustomerVoucher:input_type -> loyalty.GetCustomerVoucherRequest
-	22, // 43: loyalty.Loyalty.GetCustomerVouchers:input_type -> loyalty.GetCustomerVouchersRequest
-	23, // 44: loyalty.Loyalty.GetCustomerVouchersByStatus:input_type -> loyalty.GetCustomerVouchersByStatusRequest
-	24, // 45: loyalty.Loyalty.GetAllCustomerVouchers:input_type -> loyalty.GetAllCustomerVouchersRequest
-	25, // 46: loyalty.Loyalty.UseCustomerVoucher:input_type -> loyalty.UseCustomerVoucherRequest
-	26, // 47: loyalty.Loyalty.UpdateCustomerVoucherStatus:input_type -> loyalty.UpdateCustomerVoucherStatusRequest
-	27, // 48: loyalty.Loyalty.DeleteCustomerVoucher:input_type -> loyalty.DeleteCustomerVoucherRequest
-	31, // 49: loyalty.Loyalty.CalculateDiscountAmount:input_type -> loyalty.CalculateDiscountAmountRequest
-	34, // 50: loyalty.Loyalty.UsingVoucher:input_type -> loyalty.UsingVoucherRequest
-	8,  // 51: loyalty.Loyalty.CreateLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
-	8,  // 52: loyalty.Loyalty.GetLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
-	9,  // 53: loyalty.Loyalty.GetLoyaltyPointsByCustomer:output_type -> loyalty.GetLoyaltyPointsResponse
-	9,  // 54: loyalty.Loyalty.GetLoyaltyPointsBySource:output_type -> loyalty.GetLoyaltyPointsResponse
-	9,  // 55: loyalty.Loyalty.GetAllLoyaltyPoints:output_type -> loyalty.GetLoyaltyPointsResponse
-	8,  // 56: loyalty.Loyalty.UpdateLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
-	43, // 57: loyalty.Loyalty.DeleteLoyaltyPoint:output_type -> google.protobuf.Empty
-	10, // 58: loyalty.Loyalty.GetCustomerTotalPoints:output_type -> loyalty.GetCustomerTotalPointsResponse
-	18, // 59: loyalty.Loyalty.CreateVoucher:output_type -> loyalty.GetVoucherResponse
-	18, // 60: loyalty.Loyalty.GetVoucher:output_type -> loyalty.GetVoucherResponse
-	18, // 61: loyalty.Loyalty.GetVoucherByCode:output_type -> loyalty.GetVoucherResponse
-	19, // 62: loyalty.Loyalty.GetActiveVouchers:output_type -> loyalty.GetVouchersResponse
-	19, // 63: loyalty.Loyalty.GetAllVouchers:output_type -> loyalty.GetVouchersResponse
-	18, // 64: loyalty.Loyalty.UpdateVoucher:output_type -> loyalty.GetVoucherResponse
-	43, // 65: loyalty.Loyalty.DeleteVoucher:output_type -> google.protobuf.Empty
-	29, // 66: loyalty.Loyalty.CreateCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
-	19, // 67: loyalty.Loyalty.GetAvailableVouchersForCustomer:output_type -> loyalty.GetVouchersResponse
-	29, // 68: loyalty.Loyalty.GetCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
-	30, // 69: loyalty.Loyalty.GetCustomerVouchers:output_type -> loyalty.GetCustomerVouchersResponse
-	30, // 70: loyalty.Loyalty.GetCustomerVouchersByStatus:output_type -> loyalty.GetCustomerVouchersResponse
-	30, // 71: loyalty.Loyalty.GetAllCustomerVouchers:output_type -> loyalty.GetCustomerVouchersResponse
-	29, // 72: loyalty.Loyalty.UseCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
-	29, // 73: loyalty.Loyalty.UpdateCustomerVoucherStatus:output_type -> loyalty.GetCustomerVoucherResponse
-	43, // 74: loyalty.Loyalty.DeleteCustomerVoucher:output_type -> google.protobuf.Empty
-	32, // 75: loyalty.Loyalty.CalculateDiscountAmount:output_type -> loyalty.CalculateDiscountAmountResponse
-	35, // 76: loyalty.Loyalty.UsingVoucher:output_type -> loyalty.UsingVoucherResponse
-	51, // [51:77] is the sub-list for method output_type
-	25, // [25:51] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	39, // 0: loyalty.GetLoyaltyPointsByCustomerRequest.pagination:type_name -> common.PaginationRequest
+	39, // 1: loyalty.GetLoyaltyPointsBySourceRequest.pagination:type_name -> common.PaginationRequest
+	39, // 2: loyalty.GetAllLoyaltyPointsRequest.pagination:type_name -> common.PaginationRequest
+	40, // 3: loyalty.GetLoyaltyPointResponse.loyalty_point:type_name -> common.LoyaltyPoint
+	40, // 4: loyalty.GetLoyaltyPointsResponse.loyalty_points:type_name -> common.LoyaltyPoint
+	41, // 5: loyalty.GetLoyaltyPointsResponse.pagination:type_name -> common.PaginationResponse
+	38, // 6: loyalty.GetCustomersTotalPointsResponse.total_points:type_name -> loyalty.GetCustomersTotalPointsResponse.TotalPointsEntry
+	42, // 7: loyalty.CreateVoucherRequest.discount_type:type_name -> common.Voucher.DiscountType
+	39, // 8: loyalty.GetActiveVouchersRequest.pagination:type_name -> common.PaginationRequest
+	39, // 9: loyalty.GetAllVouchersRequest.pagination:type_name -> common.PaginationRequest
+	42, // 10: loyalty.UpdateVoucherRequest.discount_type:type_name -> common.Voucher.DiscountType
+	43, // 11: loyalty.GetVoucherResponse.voucher:type_name -> common.Voucher
+	43, // 12: loyalty.GetVouchersResponse.vouchers:type_name -> common.Voucher
+	41, // 13: loyalty.GetVouchersResponse.pagination:type_name -> common.PaginationResponse
+	44, // 14: loyalty.CreateCustomerVoucherRequest.status:type_name -> common.CustomerVoucher.Status
+	39, // 15: loyalty.GetCustomerVouchersRequest.pagination:type_name -> common.PaginationRequest
+	44, // 16: loyalty.GetCustomerVouchersByStatusRequest.status:type_name -> common.CustomerVoucher.Status
+	39, // 17: loyalty.GetCustomerVouchersByStatusRequest.pagination:type_name -> common.PaginationRequest
+	39, // 18: loyalty.GetAllCustomerVouchersRequest.pagination:type_name -> common.PaginationRequest
+	44, // 19: loyalty.UpdateCustomerVoucherStatusRequest.status:type_name -> common.CustomerVoucher.Status
+	39, // 20: loyalty.GetAvailableVouchersForCustomerRequest.pagination:type_name -> common.PaginationRequest
+	45, // 21: loyalty.GetCustomerVoucherResponse.customer_voucher:type_name -> common.CustomerVoucher
+	45, // 22: loyalty.GetCustomerVouchersResponse.customer_vouchers:type_name -> common.CustomerVoucher
+	41, // 23: loyalty.GetCustomerVouchersResponse.pagination:type_name -> common.PaginationResponse
+	35, // 24: loyalty.CalculateDiscountAmountResponse.vouchers:type_name -> loyalty.CalculateDiscountAmountResponse_Voucher
+	35, // 25: loyalty.UsingVoucherResponse.vouchers:type_name -> loyalty.CalculateDiscountAmountResponse_Voucher
+	0,  // 26: loyalty.Loyalty.CreateLoyaltyPoint:input_type -> loyalty.CreateLoyaltyPointRequest
+	1,  // 27: loyalty.Loyalty.GetLoyaltyPoint:input_type -> loyalty.GetLoyaltyPointRequest
+	2,  // 28: loyalty.Loyalty.GetLoyaltyPointsByCustomer:input_type -> loyalty.GetLoyaltyPointsByCustomerRequest
+	3,  // 29: loyalty.Loyalty.GetLoyaltyPointsBySource:input_type -> loyalty.GetLoyaltyPointsBySourceRequest
+	4,  // 30: loyalty.Loyalty.GetAllLoyaltyPoints:input_type -> loyalty.GetAllLoyaltyPointsRequest
+	5,  // 31: loyalty.Loyalty.UpdateLoyaltyPoint:input_type -> loyalty.UpdateLoyaltyPointRequest
+	6,  // 32: loyalty.Loyalty.DeleteLoyaltyPoint:input_type -> loyalty.DeleteLoyaltyPointRequest
+	7,  // 33: loyalty.Loyalty.GetCustomerTotalPoints:input_type -> loyalty.GetCustomerTotalPointsRequest
+	8,  // 34: loyalty.Loyalty.GetCustomersTotalPoints:input_type -> loyalty.GetCustomersTotalPointsRequest
+	13, // 35: loyalty.Loyalty.CreateVoucher:input_type -> loyalty.CreateVoucherRequest
+	14, // 36: loyalty.Loyalty.GetVoucher:input_type -> loyalty.GetVoucherRequest
+	15, // 37: loyalty.Loyalty.GetVoucherByCode:input_type -> loyalty.GetVoucherByCodeRequest
+	16, // 38: loyalty.Loyalty.GetActiveVouchers:input_type -> loyalty.GetActiveVouchersRequest
+	17, // 39: loyalty.Loyalty.GetAllVouchers:input_type -> loyalty.GetAllVouchersRequest
+	18, // 40: loyalty.Loyalty.UpdateVoucher:input_type -> loyalty.UpdateVoucherRequest
+	19, // 41: loyalty.Loyalty.DeleteVoucher:input_type -> loyalty.DeleteVoucherRequest
+	22, // 42: loyalty.Loyalty.CreateCustomerVoucher:input_type -> loyalty.CreateCustomerVoucherRequest
+	30, // 43: loyalty.Loyalty.GetAvailableVouchersForCustomer:input_type -> loyalty.GetAvailableVouchersForCustomerRequest
+	23, // 44: loyalty.Loyalty.GetCustomerVoucher:input_type -> loyalty.GetCustomerVoucherRequest
+	24, // 45: loyalty.Loyalty.GetCustomerVouchers:input_type -> loyalty.GetCustomerVouchersRequest
+	25, // 46: loyalty.Loyalty.GetCustomerVouchersByStatus:input_type -> loyalty.GetCustomerVouchersByStatusRequest
+	26, // 47: loyalty.Loyalty.GetAllCustomerVouchers:input_type -> loyalty.GetAllCustomerVouchersRequest
+	27, // 48: loyalty.Loyalty.UseCustomerVoucher:input_type -> loyalty.UseCustomerVoucherRequest
+	28, // 49: loyalty.Loyalty.UpdateCustomerVoucherStatus:input_type -> loyalty.UpdateCustomerVoucherStatusRequest
+	29, // 50: loyalty.Loyalty.DeleteCustomerVoucher:input_type -> loyalty.DeleteCustomerVoucherRequest
+	33, // 51: loyalty.Loyalty.CalculateDiscountAmount:input_type -> loyalty.CalculateDiscountAmountRequest
+	36, // 52: loyalty.Loyalty.UsingVoucher:input_type -> loyalty.UsingVoucherRequest
+	9,  // 53: loyalty.Loyalty.CreateLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
+	9,  // 54: loyalty.Loyalty.GetLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
+	10, // 55: loyalty.Loyalty.GetLoyaltyPointsByCustomer:output_type -> loyalty.GetLoyaltyPointsResponse
+	10, // 56: loyalty.Loyalty.GetLoyaltyPointsBySource:output_type -> loyalty.GetLoyaltyPointsResponse
+	10, // 57: loyalty.Loyalty.GetAllLoyaltyPoints:output_type -> loyalty.GetLoyaltyPointsResponse
+	9,  // 58: loyalty.Loyalty.UpdateLoyaltyPoint:output_type -> loyalty.GetLoyaltyPointResponse
+	46, // 59: loyalty.Loyalty.DeleteLoyaltyPoint:output_type -> google.protobuf.Empty
+	11, // 60: loyalty.Loyalty.GetCustomerTotalPoints:output_type -> loyalty.GetCustomerTotalPointsResponse
+	12, // 61: loyalty.Loyalty.GetCustomersTotalPoints:output_type -> loyalty.GetCustomersTotalPointsResponse
+	20, // 62: loyalty.Loyalty.CreateVoucher:output_type -> loyalty.GetVoucherResponse
+	20, // 63: loyalty.Loyalty.GetVoucher:output_type -> loyalty.GetVoucherResponse
+	20, // 64: loyalty.Loyalty.GetVoucherByCode:output_type -> loyalty.GetVoucherResponse
+	21, // 65: loyalty.Loyalty.GetActiveVouchers:output_type -> loyalty.GetVouchersResponse
+	21, // 66: loyalty.Loyalty.GetAllVouchers:output_type -> loyalty.GetVouchersResponse
+	20, // 67: loyalty.Loyalty.UpdateVoucher:output_type -> loyalty.GetVoucherResponse
+	46, // 68: loyalty.Loyalty.DeleteVoucher:output_type -> google.protobuf.Empty
+	31, // 69: loyalty.Loyalty.CreateCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
+	21, // 70: loyalty.Loyalty.GetAvailableVouchersForCustomer:output_type -> loyalty.GetVouchersResponse
+	31, // 71: loyalty.Loyalty.GetCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
+	32, // 72: loyalty.Loyalty.GetCustomerVouchers:output_type -> loyalty.GetCustomerVouchersResponse
+	32, // 73: loyalty.Loyalty.GetCustomerVouchersByStatus:output_type -> loyalty.GetCustomerVouchersResponse
+	32, // 74: loyalty.Loyalty.GetAllCustomerVouchers:output_type -> loyalty.GetCustomerVouchersResponse
+	31, // 75: loyalty.Loyalty.UseCustomerVoucher:output_type -> loyalty.GetCustomerVoucherResponse
+	31, // 76: loyalty.Loyalty.UpdateCustomerVoucherStatus:output_type -> loyalty.GetCustomerVoucherResponse
+	46, // 77: loyalty.Loyalty.DeleteCustomerVoucher:output_type -> google.protobuf.Empty
+	34, // 78: loyalty.Loyalty.CalculateDiscountAmount:output_type -> loyalty.CalculateDiscountAmountResponse
+	37, // 79: loyalty.Loyalty.UsingVoucher:output_type -> loyalty.UsingVoucherResponse
+	53, // [53:80] is the sub-list for method output_type
+	26, // [26:53] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_loyalty_loyalty_proto_init() }
@@ -2361,16 +2464,16 @@ func file_loyalty_loyalty_proto_init() {
 	file_loyalty_common_proto_init()
 	file_loyalty_loyalty_proto_msgTypes[0].OneofWrappers = []any{}
 	file_loyalty_loyalty_proto_msgTypes[5].OneofWrappers = []any{}
-	file_loyalty_loyalty_proto_msgTypes[11].OneofWrappers = []any{}
-	file_loyalty_loyalty_proto_msgTypes[16].OneofWrappers = []any{}
-	file_loyalty_loyalty_proto_msgTypes[20].OneofWrappers = []any{}
+	file_loyalty_loyalty_proto_msgTypes[13].OneofWrappers = []any{}
+	file_loyalty_loyalty_proto_msgTypes[18].OneofWrappers = []any{}
+	file_loyalty_loyalty_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loyalty_loyalty_proto_rawDesc), len(file_loyalty_loyalty_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
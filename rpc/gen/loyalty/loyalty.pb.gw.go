@@ -365,6 +365,33 @@ func local_request_Loyalty_GetCustomerTotalPoints_0(ctx context.Context, marshal
 	return msg, metadata, err
 }
 
+func request_Loyalty_GetCustomersTotalPoints_0(ctx context.Context, marshaler runtime.Marshaler, client LoyaltyClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetCustomersTotalPointsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.GetCustomersTotalPoints(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_Loyalty_GetCustomersTotalPoints_0(ctx context.Context, marshaler runtime.Marshaler, server LoyaltyServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetCustomersTotalPointsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetCustomersTotalPoints(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_Loyalty_CreateVoucher_0(ctx context.Context, marshaler runtime.Marshaler, client LoyaltyClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq CreateVoucherRequest
@@ -1253,6 +1280,26 @@ func RegisterLoyaltyHandlerServer(ctx context.Context, mux *runtime.ServeMux, se
 		}
 		forward_Loyalty_GetCustomerTotalPoints_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Loyalty_GetCustomersTotalPoints_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/loyalty.Loyalty/GetCustomersTotalPoints", runtime.WithHTTPPathPattern("/v1/customers/total-points"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_Loyalty_GetCustomersTotalPoints_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Loyalty_GetCustomersTotalPoints_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Loyalty_CreateVoucher_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1789,6 +1836,23 @@ func RegisterLoyaltyHandlerClient(ctx context.Context, mux *runtime.ServeMux, cl
 		}
 		forward_Loyalty_GetCustomerTotalPoints_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodPost, pattern_Loyalty_GetCustomersTotalPoints_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/loyalty.Loyalty/GetCustomersTotalPoints", runtime.WithHTTPPathPattern("/v1/customers/total-points"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Loyalty_GetCustomersTotalPoints_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_Loyalty_GetCustomersTotalPoints_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_Loyalty_CreateVoucher_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -2107,6 +2171,7 @@ var (
 	pattern_Loyalty_UpdateLoyaltyPoint_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "loyalty-points", "id"}, ""))
 	pattern_Loyalty_DeleteLoyaltyPoint_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "loyalty-points", "id"}, ""))
 	pattern_Loyalty_GetCustomerTotalPoints_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"v1", "customers", "customer_id", "total-points"}, ""))
+	pattern_Loyalty_GetCustomersTotalPoints_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "customers", "total-points"}, ""))
 	pattern_Loyalty_CreateVoucher_0                   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "vouchers"}, ""))
 	pattern_Loyalty_GetVoucher_0                      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"v1", "vouchers", "id"}, ""))
 	pattern_Loyalty_GetVoucherByCode_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 2}, []string{"v1", "vouchers", "code"}, ""))
@@ -2136,6 +2201,7 @@ var (
 	forward_Loyalty_UpdateLoyaltyPoint_0              = runtime.ForwardResponseMessage
 	forward_Loyalty_DeleteLoyaltyPoint_0              = runtime.ForwardResponseMessage
 	forward_Loyalty_GetCustomerTotalPoints_0          = runtime.ForwardResponseMessage
+	forward_Loyalty_GetCustomersTotalPoints_0         = runtime.ForwardResponseMessage
 	forward_Loyalty_CreateVoucher_0                   = runtime.ForwardResponseMessage
 	forward_Loyalty_GetVoucher_0                      = runtime.ForwardResponseMessage
 	forward_Loyalty_GetVoucherByCode_0                = runtime.ForwardResponseMessage
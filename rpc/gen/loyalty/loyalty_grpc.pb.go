@@ -28,6 +28,7 @@ const (
 	Loyalty_UpdateLoyaltyPoint_FullMethodName              = "/loyalty.Loyalty/UpdateLoyaltyPoint"
 	Loyalty_DeleteLoyaltyPoint_FullMethodName              = "/loyalty.Loyalty/DeleteLoyaltyPoint"
 	Loyalty_GetCustomerTotalPoints_FullMethodName          = "/loyalty.Loyalty/GetCustomerTotalPoints"
+	Loyalty_GetCustomersTotalPoints_FullMethodName         = "/loyalty.Loyalty/GetCustomersTotalPoints"
 	Loyalty_CreateVoucher_FullMethodName                   = "/loyalty.Loyalty/CreateVoucher"
 	Loyalty_GetVoucher_FullMethodName                      = "/loyalty.Loyalty/GetVoucher"
 	Loyalty_GetVoucherByCode_FullMethodName                = "/loyalty.Loyalty/GetVoucherByCode"
@@ -61,6 +62,7 @@ type LoyaltyClient interface {
 	UpdateLoyaltyPoint(ctx context.Context, in *UpdateLoyaltyPointRequest, opts ...grpc.CallOption) (*GetLoyaltyPointResponse, error)
 	DeleteLoyaltyPoint(ctx context.Context, in *DeleteLoyaltyPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetCustomerTotalPoints(ctx context.Context, in *GetCustomerTotalPointsRequest, opts ...grpc.CallOption) (*GetCustomerTotalPointsResponse, error)
+	GetCustomersTotalPoints(ctx context.Context, in *GetCustomersTotalPointsRequest, opts ...grpc.CallOption) (*GetCustomersTotalPointsResponse, error)
 	// Voucher operations
 	CreateVoucher(ctx context.Context, in *CreateVoucherRequest, opts ...grpc.CallOption) (*GetVoucherResponse, error)
 	GetVoucher(ctx context.Context, in *GetVoucherRequest, opts ...grpc.CallOption) (*GetVoucherResponse, error)
@@ -171,6 +173,16 @@ func (c *loyaltyClient) GetCustomerTotalPoints(ctx context.Context, in *GetCusto
 	return out, nil
 }
 
+func (c *loyaltyClient) GetCustomersTotalPoints(ctx context.Context, in *GetCustomersTotalPointsRequest, opts ...grpc.CallOption) (*GetCustomersTotalPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomersTotalPointsResponse)
+	err := c.cc.Invoke(ctx, Loyalty_GetCustomersTotalPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loyaltyClient) CreateVoucher(ctx context.Context, in *CreateVoucherRequest, opts ...grpc.CallOption) (*GetVoucherResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVoucherResponse)
@@ -364,6 +376,7 @@ type LoyaltyServer interface {
 	UpdateLoyaltyPoint(context.Context, *UpdateLoyaltyPointRequest) (*GetLoyaltyPointResponse, error)
 	DeleteLoyaltyPoint(context.Context, *DeleteLoyaltyPointRequest) (*emptypb.Empty, error)
 	GetCustomerTotalPoints(context.Context, *GetCustomerTotalPointsRequest) (*GetCustomerTotalPointsResponse, error)
+	GetCustomersTotalPoints(context.Context, *GetCustomersTotalPointsRequest) (*GetCustomersTotalPointsResponse, error)
 	// Voucher operations
 	CreateVoucher(context.Context, *CreateVoucherRequest) (*GetVoucherResponse, error)
 	GetVoucher(context.Context, *GetVoucherRequest) (*GetVoucherResponse, error)
@@ -418,6 +431,9 @@ func (UnimplementedLoyaltyServer) DeleteLoyaltyPoint(context.Context, *DeleteLoy
 func (UnimplementedLoyaltyServer) GetCustomerTotalPoints(context.Context, *GetCustomerTotalPointsRequest) (*GetCustomerTotalPointsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerTotalPoints not implemented")
 }
+func (UnimplementedLoyaltyServer) GetCustomersTotalPoints(context.Context, *GetCustomersTotalPointsRequest) (*GetCustomersTotalPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomersTotalPoints not implemented")
+}
 func (UnimplementedLoyaltyServer) CreateVoucher(context.Context, *CreateVoucherRequest) (*GetVoucherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVoucher not implemented")
 }
@@ -637,6 +653,24 @@ func _Loyalty_GetCustomerTotalPoints_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loyalty_GetCustomersTotalPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomersTotalPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoyaltyServer).GetCustomersTotalPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loyalty_GetCustomersTotalPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoyaltyServer).GetCustomersTotalPoints(ctx, req.(*GetCustomersTotalPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Loyalty_CreateVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVoucherRequest)
 	if err := dec(in); err != nil {
@@ -1000,6 +1034,10 @@ var Loyalty_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomerTotalPoints",
 			Handler:    _Loyalty_GetCustomerTotalPoints_Handler,
 		},
+		{
+			MethodName: "GetCustomersTotalPoints",
+			Handler:    _Loyalty_GetCustomersTotalPoints_Handler,
+		},
 		{
 			MethodName: "CreateVoucher",
 			Handler:    _Loyalty_CreateVoucher_Handler,
@@ -107,6 +107,7 @@ type CreateGoldPriceRequest struct {
 	GoldType      string                 `protobuf:"bytes,2,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
 	BuyPrice      float32                `protobuf:"fixed32,3,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
 	SellPrice     float32                `protobuf:"fixed32,4,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	GoldId        int32                  `protobuf:"varint,5,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,6 +170,13 @@ func (x *CreateGoldPriceRequest) GetSellPrice() float32 {
 	return 0
 }
 
+func (x *CreateGoldPriceRequest) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
 type CreateGoldPriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GoldPrice     *GoldPrice             `protobuf:"bytes,1,opt,name=gold_price,json=goldPrice,proto3" json:"gold_price,omitempty"`
@@ -657,6 +665,318 @@ func (x *DeleteGoldPriceRequest) GetId() int64 {
 	return 0
 }
 
+type Ohlc struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Open          float64                `protobuf:"fixed64,1,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,3,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,4,opt,name=close,proto3" json:"close,omitempty"`
+	MovingAverage float64                `protobuf:"fixed64,5,opt,name=moving_average,json=movingAverage,proto3" json:"moving_average,omitempty"`
+	ChangePercent float64                `protobuf:"fixed64,6,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ohlc) Reset() {
+	*x = Ohlc{}
+	mi := &file_market_market_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ohlc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ohlc) ProtoMessage() {}
+
+func (x *Ohlc) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ohlc.ProtoReflect.Descriptor instead.
+func (*Ohlc) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Ohlc) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Ohlc) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Ohlc) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Ohlc) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Ohlc) GetMovingAverage() float64 {
+	if x != nil {
+		return x.MovingAverage
+	}
+	return 0
+}
+
+func (x *Ohlc) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+type GoldPriceCandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Buy           *Ohlc                  `protobuf:"bytes,2,opt,name=buy,proto3" json:"buy,omitempty"`
+	Sell          *Ohlc                  `protobuf:"bytes,3,opt,name=sell,proto3" json:"sell,omitempty"`
+	Ticks         int32                  `protobuf:"varint,4,opt,name=ticks,proto3" json:"ticks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoldPriceCandle) Reset() {
+	*x = GoldPriceCandle{}
+	mi := &file_market_market_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoldPriceCandle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoldPriceCandle) ProtoMessage() {}
+
+func (x *GoldPriceCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoldPriceCandle.ProtoReflect.Descriptor instead.
+func (*GoldPriceCandle) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GoldPriceCandle) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GoldPriceCandle) GetBuy() *Ohlc {
+	if x != nil {
+		return x.Buy
+	}
+	return nil
+}
+
+func (x *GoldPriceCandle) GetSell() *Ohlc {
+	if x != nil {
+		return x.Sell
+	}
+	return nil
+}
+
+func (x *GoldPriceCandle) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+type GetGoldPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoldId        int32                  `protobuf:"varint,1,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	MaPeriod      int32                  `protobuf:"varint,5,opt,name=ma_period,json=maPeriod,proto3" json:"ma_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoldPriceHistoryRequest) Reset() {
+	*x = GetGoldPriceHistoryRequest{}
+	mi := &file_market_market_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoldPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoldPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetGoldPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoldPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetGoldPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetGoldPriceHistoryRequest) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *GetGoldPriceHistoryRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetGoldPriceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetGoldPriceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetGoldPriceHistoryRequest) GetMaPeriod() int32 {
+	if x != nil {
+		return x.MaPeriod
+	}
+	return 0
+}
+
+type GetGoldPriceHistoryResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GoldId            int32                  `protobuf:"varint,1,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	GoldType          string                 `protobuf:"bytes,2,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Interval          string                 `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candles           []*GoldPriceCandle     `protobuf:"bytes,4,rep,name=candles,proto3" json:"candles,omitempty"`
+	BuyChangePercent  float64                `protobuf:"fixed64,5,opt,name=buy_change_percent,json=buyChangePercent,proto3" json:"buy_change_percent,omitempty"`
+	SellChangePercent float64                `protobuf:"fixed64,6,opt,name=sell_change_percent,json=sellChangePercent,proto3" json:"sell_change_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetGoldPriceHistoryResponse) Reset() {
+	*x = GetGoldPriceHistoryResponse{}
+	mi := &file_market_market_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoldPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoldPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetGoldPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoldPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetGoldPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGoldPriceHistoryResponse) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *GetGoldPriceHistoryResponse) GetGoldType() string {
+	if x != nil {
+		return x.GoldType
+	}
+	return ""
+}
+
+func (x *GetGoldPriceHistoryResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetGoldPriceHistoryResponse) GetCandles() []*GoldPriceCandle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *GetGoldPriceHistoryResponse) GetBuyChangePercent() float64 {
+	if x != nil {
+		return x.BuyChangePercent
+	}
+	return 0
+}
+
+func (x *GetGoldPriceHistoryResponse) GetSellChangePercent() float64 {
+	if x != nil {
+		return x.SellChangePercent
+	}
+	return 0
+}
+
 type BuybackPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -670,7 +990,7 @@ type BuybackPolicy struct {
 
 func (x *BuybackPolicy) Reset() {
 	*x = BuybackPolicy{}
-	mi := &file_market_market_proto_msgTypes[12]
+	mi := &file_market_market_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +1002,7 @@ func (x *BuybackPolicy) String() string {
 func (*BuybackPolicy) ProtoMessage() {}
 
 func (x *BuybackPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[12]
+	mi := &file_market_market_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +1015,7 @@ func (x *BuybackPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuybackPolicy.ProtoReflect.Descriptor instead.
 func (*BuybackPolicy) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{12}
+	return file_market_market_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BuybackPolicy) GetId() int64 {
@@ -744,7 +1064,7 @@ type CreateBuybackPolicyRequest struct {
 
 func (x *CreateBuybackPolicyRequest) Reset() {
 	*x = CreateBuybackPolicyRequest{}
-	mi := &file_market_market_proto_msgTypes[13]
+	mi := &file_market_market_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +1076,7 @@ func (x *CreateBuybackPolicyRequest) String() string {
 func (*CreateBuybackPolicyRequest) ProtoMessage() {}
 
 func (x *CreateBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[13]
+	mi := &file_market_market_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +1089,7 @@ func (x *CreateBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBuybackPolicyRequest.ProtoReflect.Descriptor instead.
 func (*CreateBuybackPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{13}
+	return file_market_market_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateBuybackPolicyRequest) GetProductType() string {
@@ -802,7 +1122,7 @@ type CreateBuybackPolicyResponse struct {
 
 func (x *CreateBuybackPolicyResponse) Reset() {
 	*x = CreateBuybackPolicyResponse{}
-	mi := &file_market_market_proto_msgTypes[14]
+	mi := &file_market_market_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1134,7 @@ func (x *CreateBuybackPolicyResponse) String() string {
 func (*CreateBuybackPolicyResponse) ProtoMessage() {}
 
 func (x *CreateBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[14]
+	mi := &file_market_market_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1147,7 @@ func (x *CreateBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBuybackPolicyResponse.ProtoReflect.Descriptor instead.
 func (*CreateBuybackPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{14}
+	return file_market_market_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateBuybackPolicyResponse) GetBuybackPolicy() *BuybackPolicy {
@@ -846,7 +1166,7 @@ type GetBuybackPolicyRequest struct {
 
 func (x *GetBuybackPolicyRequest) Reset() {
 	*x = GetBuybackPolicyRequest{}
-	mi := &file_market_market_proto_msgTypes[15]
+	mi := &file_market_market_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1178,7 @@ func (x *GetBuybackPolicyRequest) String() string {
 func (*GetBuybackPolicyRequest) ProtoMessage() {}
 
 func (x *GetBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[15]
+	mi := &file_market_market_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1191,7 @@ func (x *GetBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuybackPolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetBuybackPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{15}
+	return file_market_market_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetBuybackPolicyRequest) GetId() int64 {
@@ -890,7 +1210,7 @@ type GetBuybackPolicyResponse struct {
 
 func (x *GetBuybackPolicyResponse) Reset() {
 	*x = GetBuybackPolicyResponse{}
-	mi := &file_market_market_proto_msgTypes[16]
+	mi := &file_market_market_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -902,7 +1222,7 @@ func (x *GetBuybackPolicyResponse) String() string {
 func (*GetBuybackPolicyResponse) ProtoMessage() {}
 
 func (x *GetBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[16]
+	mi := &file_market_market_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,7 +1235,7 @@ func (x *GetBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBuybackPolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetBuybackPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{16}
+	return file_market_market_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetBuybackPolicyResponse) GetBuybackPolicy() *BuybackPolicy {
@@ -936,7 +1256,7 @@ type ListBuybackPoliciesRequest struct {
 
 func (x *ListBuybackPoliciesRequest) Reset() {
 	*x = ListBuybackPoliciesRequest{}
-	mi := &file_market_market_proto_msgTypes[17]
+	mi := &file_market_market_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -948,7 +1268,7 @@ func (x *ListBuybackPoliciesRequest) String() string {
 func (*ListBuybackPoliciesRequest) ProtoMessage() {}
 
 func (x *ListBuybackPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[17]
+	mi := &file_market_market_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1281,7 @@ func (x *ListBuybackPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBuybackPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*ListBuybackPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{17}
+	return file_market_market_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListBuybackPoliciesRequest) GetLimit() int32 {
@@ -995,19 +1315,807 @@ type ListBuybackPoliciesResponse struct {
 
 func (x *ListBuybackPoliciesResponse) Reset() {
 	*x = ListBuybackPoliciesResponse{}
-	mi := &file_market_market_proto_msgTypes[18]
+	mi := &file_market_market_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBuybackPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBuybackPoliciesResponse) ProtoMessage() {}
+
+func (x *ListBuybackPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBuybackPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListBuybackPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBuybackPoliciesResponse) GetBuybackPolicies() []*BuybackPolicy {
+	if x != nil {
+		return x.BuybackPolicies
+	}
+	return nil
+}
+
+func (x *ListBuybackPoliciesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateBuybackPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductType   string                 `protobuf:"bytes,2,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	BuybackRate   float32                `protobuf:"fixed32,3,opt,name=buyback_rate,json=buybackRate,proto3" json:"buyback_rate,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBuybackPolicyRequest) Reset() {
+	*x = UpdateBuybackPolicyRequest{}
+	mi := &file_market_market_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBuybackPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuybackPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuybackPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBuybackPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateBuybackPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBuybackPolicyRequest) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *UpdateBuybackPolicyRequest) GetBuybackRate() float32 {
+	if x != nil {
+		return x.BuybackRate
+	}
+	return 0
+}
+
+func (x *UpdateBuybackPolicyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateBuybackPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuybackPolicy *BuybackPolicy         `protobuf:"bytes,1,opt,name=buyback_policy,json=buybackPolicy,proto3" json:"buyback_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBuybackPolicyResponse) Reset() {
+	*x = UpdateBuybackPolicyResponse{}
+	mi := &file_market_market_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBuybackPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBuybackPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBuybackPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBuybackPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateBuybackPolicyResponse) GetBuybackPolicy() *BuybackPolicy {
+	if x != nil {
+		return x.BuybackPolicy
+	}
+	return nil
+}
+
+type DeleteBuybackPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBuybackPolicyRequest) Reset() {
+	*x = DeleteBuybackPolicyRequest{}
+	mi := &file_market_market_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBuybackPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBuybackPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBuybackPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBuybackPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteBuybackPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Alloy struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Metal           string                 `protobuf:"bytes,4,opt,name=metal,proto3" json:"metal,omitempty"`
+	Karat           int32                  `protobuf:"varint,5,opt,name=karat,proto3" json:"karat,omitempty"`
+	Purity          float64                `protobuf:"fixed64,6,opt,name=purity,proto3" json:"purity,omitempty"`
+	GoldId          int32                  `protobuf:"varint,7,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	ReferencePurity float64                `protobuf:"fixed64,8,opt,name=reference_purity,json=referencePurity,proto3" json:"reference_purity,omitempty"`
+	BuyPrice        float64                `protobuf:"fixed64,9,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	SellPrice       float64                `protobuf:"fixed64,10,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	PriceDate       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=price_date,json=priceDate,proto3" json:"price_date,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Alloy) Reset() {
+	*x = Alloy{}
+	mi := &file_market_market_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alloy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alloy) ProtoMessage() {}
+
+func (x *Alloy) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alloy.ProtoReflect.Descriptor instead.
+func (*Alloy) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Alloy) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alloy) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Alloy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alloy) GetMetal() string {
+	if x != nil {
+		return x.Metal
+	}
+	return ""
+}
+
+func (x *Alloy) GetKarat() int32 {
+	if x != nil {
+		return x.Karat
+	}
+	return 0
+}
+
+func (x *Alloy) GetPurity() float64 {
+	if x != nil {
+		return x.Purity
+	}
+	return 0
+}
+
+func (x *Alloy) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *Alloy) GetReferencePurity() float64 {
+	if x != nil {
+		return x.ReferencePurity
+	}
+	return 0
+}
+
+func (x *Alloy) GetBuyPrice() float64 {
+	if x != nil {
+		return x.BuyPrice
+	}
+	return 0
+}
+
+func (x *Alloy) GetSellPrice() float64 {
+	if x != nil {
+		return x.SellPrice
+	}
+	return 0
+}
+
+func (x *Alloy) GetPriceDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceDate
+	}
+	return nil
+}
+
+type ListAlloysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlloysRequest) Reset() {
+	*x = ListAlloysRequest{}
+	mi := &file_market_market_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlloysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlloysRequest) ProtoMessage() {}
+
+func (x *ListAlloysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlloysRequest.ProtoReflect.Descriptor instead.
+func (*ListAlloysRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{27}
+}
+
+type ListAlloysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alloys        []*Alloy               `protobuf:"bytes,1,rep,name=alloys,proto3" json:"alloys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlloysResponse) Reset() {
+	*x = ListAlloysResponse{}
+	mi := &file_market_market_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlloysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlloysResponse) ProtoMessage() {}
+
+func (x *ListAlloysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlloysResponse.ProtoReflect.Descriptor instead.
+func (*ListAlloysResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAlloysResponse) GetAlloys() []*Alloy {
+	if x != nil {
+		return x.Alloys
+	}
+	return nil
+}
+
+type GetAlloyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlloyRequest) Reset() {
+	*x = GetAlloyRequest{}
+	mi := &file_market_market_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlloyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlloyRequest) ProtoMessage() {}
+
+func (x *GetAlloyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlloyRequest.ProtoReflect.Descriptor instead.
+func (*GetAlloyRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAlloyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAlloyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alloy         *Alloy                 `protobuf:"bytes,1,opt,name=alloy,proto3" json:"alloy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlloyResponse) Reset() {
+	*x = GetAlloyResponse{}
+	mi := &file_market_market_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlloyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlloyResponse) ProtoMessage() {}
+
+func (x *GetAlloyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlloyResponse.ProtoReflect.Descriptor instead.
+func (*GetAlloyResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAlloyResponse) GetAlloy() *Alloy {
+	if x != nil {
+		return x.Alloy
+	}
+	return nil
+}
+
+type UpdateAlloyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GoldId          int32                  `protobuf:"varint,3,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	ReferencePurity float64                `protobuf:"fixed64,4,opt,name=reference_purity,json=referencePurity,proto3" json:"reference_purity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateAlloyRequest) Reset() {
+	*x = UpdateAlloyRequest{}
+	mi := &file_market_market_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlloyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlloyRequest) ProtoMessage() {}
+
+func (x *UpdateAlloyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlloyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlloyRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateAlloyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAlloyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAlloyRequest) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *UpdateAlloyRequest) GetReferencePurity() float64 {
+	if x != nil {
+		return x.ReferencePurity
+	}
+	return 0
+}
+
+type UpdateAlloyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alloy         *Alloy                 `protobuf:"bytes,1,opt,name=alloy,proto3" json:"alloy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlloyResponse) Reset() {
+	*x = UpdateAlloyResponse{}
+	mi := &file_market_market_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlloyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlloyResponse) ProtoMessage() {}
+
+func (x *UpdateAlloyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlloyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlloyResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateAlloyResponse) GetAlloy() *Alloy {
+	if x != nil {
+		return x.Alloy
+	}
+	return nil
+}
+
+type BuybackDeduction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Percent       float64                `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuybackDeduction) Reset() {
+	*x = BuybackDeduction{}
+	mi := &file_market_market_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuybackDeduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuybackDeduction) ProtoMessage() {}
+
+func (x *BuybackDeduction) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuybackDeduction.ProtoReflect.Descriptor instead.
+func (*BuybackDeduction) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BuybackDeduction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuybackDeduction) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *BuybackDeduction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type BuybackItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoldId        int32                  `protobuf:"varint,1,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	ProductType   string                 `protobuf:"bytes,3,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	PolicyId      int64                  `protobuf:"varint,4,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Deductions    []*BuybackDeduction    `protobuf:"bytes,5,rep,name=deductions,proto3" json:"deductions,omitempty"`
+	OrderId       int32                  `protobuf:"varint,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,7,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	AlloyId       int32                  `protobuf:"varint,8,opt,name=alloy_id,json=alloyId,proto3" json:"alloy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuybackItem) Reset() {
+	*x = BuybackItem{}
+	mi := &file_market_market_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuybackItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuybackItem) ProtoMessage() {}
+
+func (x *BuybackItem) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuybackItem.ProtoReflect.Descriptor instead.
+func (*BuybackItem) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BuybackItem) GetGoldId() int32 {
+	if x != nil {
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *BuybackItem) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BuybackItem) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *BuybackItem) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *BuybackItem) GetDeductions() []*BuybackDeduction {
+	if x != nil {
+		return x.Deductions
+	}
+	return nil
+}
+
+func (x *BuybackItem) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *BuybackItem) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *BuybackItem) GetAlloyId() int32 {
+	if x != nil {
+		return x.AlloyId
+	}
+	return 0
+}
+
+type BuybackQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GoldId          int32                  `protobuf:"varint,1,opt,name=gold_id,json=goldId,proto3" json:"gold_id,omitempty"`
+	GoldType        string                 `protobuf:"bytes,2,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Weight          float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	BuyPrice        float64                `protobuf:"fixed64,4,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	PolicyId        int64                  `protobuf:"varint,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	BuybackRate     float64                `protobuf:"fixed64,6,opt,name=buyback_rate,json=buybackRate,proto3" json:"buyback_rate,omitempty"`
+	GoldValue       float64                `protobuf:"fixed64,7,opt,name=gold_value,json=goldValue,proto3" json:"gold_value,omitempty"`
+	Deductions      []*BuybackDeduction    `protobuf:"bytes,8,rep,name=deductions,proto3" json:"deductions,omitempty"`
+	DeductionAmount float64                `protobuf:"fixed64,9,opt,name=deduction_amount,json=deductionAmount,proto3" json:"deduction_amount,omitempty"`
+	Amount          float64                `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderId         int32                  `protobuf:"varint,11,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId       int32                  `protobuf:"varint,12,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SerialNumber    string                 `protobuf:"bytes,13,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	WarrantyUntil   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=warranty_until,json=warrantyUntil,proto3" json:"warranty_until,omitempty"`
+	UnderWarranty   bool                   `protobuf:"varint,15,opt,name=under_warranty,json=underWarranty,proto3" json:"under_warranty,omitempty"`
+	CustomerId      string                 `protobuf:"bytes,16,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AlloyId         int32                  `protobuf:"varint,17,opt,name=alloy_id,json=alloyId,proto3" json:"alloy_id,omitempty"`
+	Purity          float64                `protobuf:"fixed64,18,opt,name=purity,proto3" json:"purity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BuybackQuote) Reset() {
+	*x = BuybackQuote{}
+	mi := &file_market_market_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBuybackPoliciesResponse) String() string {
+func (x *BuybackQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBuybackPoliciesResponse) ProtoMessage() {}
+func (*BuybackQuote) ProtoMessage() {}
 
-func (x *ListBuybackPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[18]
+func (x *BuybackQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,50 +2126,159 @@ func (x *ListBuybackPoliciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBuybackPoliciesResponse.ProtoReflect.Descriptor instead.
-func (*ListBuybackPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BuybackQuote.ProtoReflect.Descriptor instead.
+func (*BuybackQuote) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListBuybackPoliciesResponse) GetBuybackPolicies() []*BuybackPolicy {
+func (x *BuybackQuote) GetGoldId() int32 {
 	if x != nil {
-		return x.BuybackPolicies
+		return x.GoldId
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetGoldType() string {
+	if x != nil {
+		return x.GoldType
+	}
+	return ""
+}
+
+func (x *BuybackQuote) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetBuyPrice() float64 {
+	if x != nil {
+		return x.BuyPrice
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetBuybackRate() float64 {
+	if x != nil {
+		return x.BuybackRate
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetGoldValue() float64 {
+	if x != nil {
+		return x.GoldValue
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetDeductions() []*BuybackDeduction {
+	if x != nil {
+		return x.Deductions
 	}
 	return nil
 }
 
-func (x *ListBuybackPoliciesResponse) GetTotalCount() int32 {
+func (x *BuybackQuote) GetDeductionAmount() float64 {
 	if x != nil {
-		return x.TotalCount
+		return x.DeductionAmount
 	}
 	return 0
 }
 
-type UpdateBuybackPolicyRequest struct {
+func (x *BuybackQuote) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *BuybackQuote) GetWarrantyUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WarrantyUntil
+	}
+	return nil
+}
+
+func (x *BuybackQuote) GetUnderWarranty() bool {
+	if x != nil {
+		return x.UnderWarranty
+	}
+	return false
+}
+
+func (x *BuybackQuote) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *BuybackQuote) GetAlloyId() int32 {
+	if x != nil {
+		return x.AlloyId
+	}
+	return 0
+}
+
+func (x *BuybackQuote) GetPurity() float64 {
+	if x != nil {
+		return x.Purity
+	}
+	return 0
+}
+
+type QuoteBuybackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductType   string                 `protobuf:"bytes,2,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
-	BuybackRate   float32                `protobuf:"fixed32,3,opt,name=buyback_rate,json=buybackRate,proto3" json:"buyback_rate,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Item          *BuybackItem           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBuybackPolicyRequest) Reset() {
-	*x = UpdateBuybackPolicyRequest{}
-	mi := &file_market_market_proto_msgTypes[19]
+func (x *QuoteBuybackRequest) Reset() {
+	*x = QuoteBuybackRequest{}
+	mi := &file_market_market_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBuybackPolicyRequest) String() string {
+func (x *QuoteBuybackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBuybackPolicyRequest) ProtoMessage() {}
+func (*QuoteBuybackRequest) ProtoMessage() {}
 
-func (x *UpdateBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[19]
+func (x *QuoteBuybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,61 +2289,166 @@ func (x *UpdateBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBuybackPolicyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBuybackPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QuoteBuybackRequest.ProtoReflect.Descriptor instead.
+func (*QuoteBuybackRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateBuybackPolicyRequest) GetId() int64 {
+func (x *QuoteBuybackRequest) GetItem() *BuybackItem {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateBuybackPolicyRequest) GetProductType() string {
+type QuoteBuybackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *BuybackQuote          `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteBuybackResponse) Reset() {
+	*x = QuoteBuybackResponse{}
+	mi := &file_market_market_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteBuybackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteBuybackResponse) ProtoMessage() {}
+
+func (x *QuoteBuybackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[37]
 	if x != nil {
-		return x.ProductType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteBuybackResponse.ProtoReflect.Descriptor instead.
+func (*QuoteBuybackResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QuoteBuybackResponse) GetQuote() *BuybackQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type ExecuteBuybackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *BuybackItem           `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Disposition   string                 `protobuf:"bytes,3,opt,name=disposition,proto3" json:"disposition,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	QuotedAmount  float64                `protobuf:"fixed64,5,opt,name=quoted_amount,json=quotedAmount,proto3" json:"quoted_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteBuybackRequest) Reset() {
+	*x = ExecuteBuybackRequest{}
+	mi := &file_market_market_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteBuybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteBuybackRequest) ProtoMessage() {}
+
+func (x *ExecuteBuybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteBuybackRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteBuybackRequest) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExecuteBuybackRequest) GetItem() *BuybackItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ExecuteBuybackRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *UpdateBuybackPolicyRequest) GetBuybackRate() float32 {
+func (x *ExecuteBuybackRequest) GetDisposition() string {
 	if x != nil {
-		return x.BuybackRate
+		return x.Disposition
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateBuybackPolicyRequest) GetDescription() string {
+func (x *ExecuteBuybackRequest) GetNote() string {
 	if x != nil {
-		return x.Description
+		return x.Note
 	}
 	return ""
 }
 
-type UpdateBuybackPolicyResponse struct {
+func (x *ExecuteBuybackRequest) GetQuotedAmount() float64 {
+	if x != nil {
+		return x.QuotedAmount
+	}
+	return 0
+}
+
+type Buyback struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuybackPolicy *BuybackPolicy         `protobuf:"bytes,1,opt,name=buyback_policy,json=buybackPolicy,proto3" json:"buyback_policy,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quote         *BuybackQuote          `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	StaffId       string                 `protobuf:"bytes,4,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	Disposition   string                 `protobuf:"bytes,5,opt,name=disposition,proto3" json:"disposition,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBuybackPolicyResponse) Reset() {
-	*x = UpdateBuybackPolicyResponse{}
-	mi := &file_market_market_proto_msgTypes[20]
+func (x *Buyback) Reset() {
+	*x = Buyback{}
+	mi := &file_market_market_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBuybackPolicyResponse) String() string {
+func (x *Buyback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBuybackPolicyResponse) ProtoMessage() {}
+func (*Buyback) ProtoMessage() {}
 
-func (x *UpdateBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[20]
+func (x *Buyback) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,40 +2459,82 @@ func (x *UpdateBuybackPolicyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBuybackPolicyResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBuybackPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Buyback.ProtoReflect.Descriptor instead.
+func (*Buyback) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateBuybackPolicyResponse) GetBuybackPolicy() *BuybackPolicy {
+func (x *Buyback) GetId() int64 {
 	if x != nil {
-		return x.BuybackPolicy
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Buyback) GetQuote() *BuybackQuote {
+	if x != nil {
+		return x.Quote
 	}
 	return nil
 }
 
-type DeleteBuybackPolicyRequest struct {
+func (x *Buyback) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Buyback) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *Buyback) GetDisposition() string {
+	if x != nil {
+		return x.Disposition
+	}
+	return ""
+}
+
+func (x *Buyback) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Buyback) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ExecuteBuybackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyback       *Buyback               `protobuf:"bytes,1,opt,name=buyback,proto3" json:"buyback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBuybackPolicyRequest) Reset() {
-	*x = DeleteBuybackPolicyRequest{}
-	mi := &file_market_market_proto_msgTypes[21]
+func (x *ExecuteBuybackResponse) Reset() {
+	*x = ExecuteBuybackResponse{}
+	mi := &file_market_market_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBuybackPolicyRequest) String() string {
+func (x *ExecuteBuybackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBuybackPolicyRequest) ProtoMessage() {}
+func (*ExecuteBuybackResponse) ProtoMessage() {}
 
-func (x *DeleteBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_market_market_proto_msgTypes[21]
+func (x *ExecuteBuybackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_market_market_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,16 +2545,16 @@ func (x *DeleteBuybackPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBuybackPolicyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBuybackPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_market_market_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ExecuteBuybackResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteBuybackResponse) Descriptor() ([]byte, []int) {
+	return file_market_market_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteBuybackPolicyRequest) GetId() int64 {
+func (x *ExecuteBuybackResponse) GetBuyback() *Buyback {
 	if x != nil {
-		return x.Id
+		return x.Buyback
 	}
-	return 0
+	return nil
 }
 
 var File_market_market_proto protoreflect.FileDescriptor
@@ -1207,13 +2571,14 @@ const file_market_market_proto_rawDesc = "" +
 	"sell_price\x18\x05 \x01(\x02B;\x92A82\x1cSell price in decimal format\x8a\x01\x17^[0-9]+(\\.[0-9]{1,2})?$R\tsellPrice:V\x92AS\n" +
 	"Q*\n" +
 	"Gold Price2\x1eRepresents a gold price record\xd2\x01\tgold_type\xd2\x01\tbuy_price\xd2\x01\n" +
-	"sell_price\"\xee\x01\n" +
+	"sell_price\"\xc1\x02\n" +
 	"\x16CreateGoldPriceRequest\x12.\n" +
 	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1b\n" +
 	"\tgold_type\x18\x02 \x01(\tR\bgoldType\x12\x1b\n" +
 	"\tbuy_price\x18\x03 \x01(\x02R\bbuyPrice\x12\x1d\n" +
 	"\n" +
-	"sell_price\x18\x04 \x01(\x02R\tsellPrice:K\x92AH\n" +
+	"sell_price\x18\x04 \x01(\x02R\tsellPrice\x12Q\n" +
+	"\agold_id\x18\x05 \x01(\x05B8\x92A523Gold type identifier shared with the crawled pricesR\x06goldId:K\x92AH\n" +
 	"F*\x19Create Gold Price Request2)Request to create a new gold price record\"\xa1\x01\n" +
 	"\x17CreateGoldPriceResponse\x120\n" +
 	"\n" +
@@ -1255,7 +2620,36 @@ const file_market_market_proto_rawDesc = "" +
 	"O*\x1aUpdate Gold Price Response21Response containing the updated gold price record\"\x9d\x01\n" +
 	"\x16DeleteGoldPriceRequest\x12:\n" +
 	"\x02id\x18\x01 \x01(\x03B*\x92A'2%ID of the gold price record to deleteR\x02id:G\x92AD\n" +
-	"B*\x19Delete Gold Price Request2%Request to delete a gold price record\"\xfc\x03\n" +
+	"B*\x19Delete Gold Price Request2%Request to delete a gold price record\"\xf0\x02\n" +
+	"\x04Ohlc\x12\x12\n" +
+	"\x04open\x18\x01 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x02 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x03 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x04 \x01(\x01R\x05close\x12p\n" +
+	"\x0emoving_average\x18\x05 \x01(\x01BI\x92AF2DAverage close of the last ma_period candles, 0 while there are fewerR\rmovingAverage\x12e\n" +
+	"\x0echange_percent\x18\x06 \x01(\x01B>\x92A;29Change of the close since the previous candle, in percentR\rchangePercent:?\x92A<\n" +
+	":*\x04OHLC22Open, high, low and close of a price over a candle\"\xbb\x02\n" +
+	"\x0fGoldPriceCandle\x12J\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x18\x92A\x152\x13Start of the candleR\x05start\x12\x1e\n" +
+	"\x03buy\x18\x02 \x01(\v2\f.market.OhlcR\x03buy\x12 \n" +
+	"\x04sell\x18\x03 \x01(\v2\f.market.OhlcR\x04sell\x12B\n" +
+	"\x05ticks\x18\x04 \x01(\x05B,\x92A)2'Number of prices recorded in the candleR\x05ticks:V\x92AS\n" +
+	"Q*\x11Gold Price Candle2<Buy and sell prices of a gold type over a day, week or month\"\x86\x04\n" +
+	"\x1aGetGoldPriceHistoryRequest\x12\x17\n" +
+	"\agold_id\x18\x01 \x01(\x05R\x06goldId\x12E\n" +
+	"\binterval\x18\x02 \x01(\tB)\x92A&2\x1fCandle size: day, week or month:\x03dayR\binterval\x12i\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB9\x92A624Start of the range, defaults to 30 candles before toR\x04from\x12^\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB2\x92A/2-End of the range (exclusive), defaults to nowR\x02to\x12^\n" +
+	"\tma_period\x18\x05 \x01(\x05BA\x92A>2'Number of candles of the moving average:\x017Y\x00\x00\x00\x00\x00\x00i@i\x00\x00\x00\x00\x00\x00\xf0?R\bmaPeriod:]\x92AZ\n" +
+	"X*\x1eGet Gold Price History Request2,Request for the price candles of a gold type\xd2\x01\agold_id\"\xc7\x03\n" +
+	"\x1bGetGoldPriceHistoryResponse\x12\x17\n" +
+	"\agold_id\x18\x01 \x01(\x05R\x06goldId\x12\x1b\n" +
+	"\tgold_type\x18\x02 \x01(\tR\bgoldType\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x121\n" +
+	"\acandles\x18\x04 \x03(\v2\x17.market.GoldPriceCandleR\acandles\x12e\n" +
+	"\x12buy_change_percent\x18\x05 \x01(\x01B7\x92A422Change of the buy price over the range, in percentR\x10buyChangePercent\x12h\n" +
+	"\x13sell_change_percent\x18\x06 \x01(\x01B8\x92A523Change of the sell price over the range, in percentR\x11sellChangePercent:R\x92AO\n" +
+	"M*\x1fGet Gold Price History Response2*Price candles of a gold type, oldest first\"\xfc\x03\n" +
 	"\rBuybackPolicy\x12?\n" +
 	"\x02id\x18\x01 \x01(\x03B/\x92A,2(Unique identifier for the buyback policy@\x01R\x02id\x12E\n" +
 	"\fproduct_type\x18\x02 \x01(\tB\"\x92A\x1f2\x1bType of product for buybackxdR\vproductType\x12j\n" +
@@ -1299,7 +2693,107 @@ const file_market_market_proto_rawDesc = "" +
 	"P*\x1eUpdate Buyback Policy Response2.Response containing the updated buyback policy\"\x9f\x01\n" +
 	"\x1aDeleteBuybackPolicyRequest\x127\n" +
 	"\x02id\x18\x01 \x01(\x03B'\x92A$2\"ID of the buyback policy to deleteR\x02id:H\x92AE\n" +
-	"C*\x1dDelete Buyback Policy Request2\"Request to delete a buyback policy2\xa5\x11\n" +
+	"C*\x1dDelete Buyback Policy Request2\"Request to delete a buyback policy\"\x98\x06\n" +
+	"\x05Alloy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x124\n" +
+	"\x04code\x18\x02 \x01(\tB \x92A\x1d2\x19e.g. 24K, 18K, 18KW, S925@\x01R\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\x05metal\x18\x04 \x01(\tB!\x92A\x1e2\x1agold, white_gold or silver@\x01R\x05metal\x12\x1b\n" +
+	"\x05karat\x18\x05 \x01(\x05B\x05\x92A\x02@\x01R\x05karat\x12E\n" +
+	"\x06purity\x18\x06 \x01(\x01B-\x92A*2&Share of pure metal, e.g. 0.75 for 18K@\x01R\x06purity\x12S\n" +
+	"\agold_id\x18\a \x01(\x05B:\x92A725Gold price the alloy is priced off, 0 when not mappedR\x06goldId\x12i\n" +
+	"\x10reference_purity\x18\b \x01(\x01B>\x92A;29Purity of the metal quoted by the gold price, e.g. 0.9999R\x0freferencePurity\x12l\n" +
+	"\tbuy_price\x18\t \x01(\x01BO\x92AL2HBuy price of a mace of the alloy: gold price * purity / reference_purity@\x01R\bbuyPrice\x12G\n" +
+	"\n" +
+	"sell_price\x18\n" +
+	" \x01(\x01B(\x92A%2!Sell price of a mace of the alloy@\x01R\tsellPrice\x12]\n" +
+	"\n" +
+	"price_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\"\x92A\x1f2\x1bDate of the gold price used@\x01R\tpriceDate:B\x92A?\n" +
+	"=*\x05Alloy24Gold or silver alloy priced off a crawled gold price\"\x13\n" +
+	"\x11ListAlloysRequest\";\n" +
+	"\x12ListAlloysResponse\x12%\n" +
+	"\x06alloys\x18\x01 \x03(\v2\r.market.AlloyR\x06alloys\"!\n" +
+	"\x0fGetAlloyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"7\n" +
+	"\x10GetAlloyResponse\x12#\n" +
+	"\x05alloy\x18\x01 \x01(\v2\r.market.AlloyR\x05alloy\"\xec\x01\n" +
+	"\x12UpdateAlloyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\agold_id\x18\x03 \x01(\x05R\x06goldId\x12)\n" +
+	"\x10reference_purity\x18\x04 \x01(\x01R\x0freferencePurity:n\x92Ak\n" +
+	"i*\x14Update Alloy Request2QRequest to rename an alloy or map it to another gold price, unset fields are kept\":\n" +
+	"\x13UpdateAlloyResponse\x12#\n" +
+	"\x05alloy\x18\x01 \x01(\v2\r.market.AlloyR\x05alloy\"\x87\x02\n" +
+	"\x10BuybackDeduction\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12A\n" +
+	"\apercent\x18\x02 \x01(\x01B'\x92A$2\x19Percent of the gold valueY\x00\x00\x00\x00\x00\x00Y@R\apercent\x12)\n" +
+	"\x06amount\x18\x03 \x01(\x01B\x11\x92A\x0e2\fFixed amountR\x06amount:m\x92Aj\n" +
+	"h*\x11Buyback Deduction2SDeduction for the condition of a bought back item, e.g. missing stones or scratches\"\xb5\x06\n" +
+	"\vBuybackItem\x12u\n" +
+	"\agold_id\x18\x01 \x01(\x05B\\\x92AY2WGold type of the item, defaults to the gold type of the product sold with serial_numberR\x06goldId\x12Z\n" +
+	"\x06weight\x18\x02 \x01(\x01BB\x92A?2=Gold weight in grams, defaults to the weight of serial_numberR\x06weight\x12S\n" +
+	"\fproduct_type\x18\x03 \x01(\tB0\x92A-2+Product type of the buyback policy to applyR\vproductType\x12Q\n" +
+	"\tpolicy_id\x18\x04 \x01(\x03B4\x92A12/Buyback policy to apply, overrides product_typeR\bpolicyId\x128\n" +
+	"\n" +
+	"deductions\x18\x05 \x03(\v2\x18.market.BuybackDeductionR\n" +
+	"deductions\x12G\n" +
+	"\border_id\x18\x06 \x01(\x05B,\x92A)2'Order the item was sold with (optional)R\aorderId\x12s\n" +
+	"\rserial_number\x18\a \x01(\tBN\x92AK2ISerial number on the warranty card (optional), links the item to its saleR\fserialNumber\x12}\n" +
+	"\balloy_id\x18\b \x01(\x05Bb\x92A_2]Alloy of the item, prices it off the gold price of the alloy at its purity. Overrides gold_idR\aalloyId:4\x92A1\n" +
+	"/*\fBuyback Item2\x1fItem brought back by a customer\"\xd8\a\n" +
+	"\fBuybackQuote\x12\x17\n" +
+	"\agold_id\x18\x01 \x01(\x05R\x06goldId\x12\x1b\n" +
+	"\tgold_type\x18\x02 \x01(\tR\bgoldType\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12P\n" +
+	"\tbuy_price\x18\x04 \x01(\x01B3\x92A02.Buy price per mace used, of the alloy when setR\bbuyPrice\x12\x1b\n" +
+	"\tpolicy_id\x18\x05 \x01(\x03R\bpolicyId\x12!\n" +
+	"\fbuyback_rate\x18\x06 \x01(\x01R\vbuybackRate\x12@\n" +
+	"\n" +
+	"gold_value\x18\a \x01(\x01B!\x92A\x1e2\x1cBuy price of the gold weightR\tgoldValue\x128\n" +
+	"\n" +
+	"deductions\x18\b \x03(\v2\x18.market.BuybackDeductionR\n" +
+	"deductions\x12)\n" +
+	"\x10deduction_amount\x18\t \x01(\x01R\x0fdeductionAmount\x12p\n" +
+	"\x06amount\x18\n" +
+	" \x01(\x01BX\x92AU2SAmount paid to the customer: gold value times the buyback rate, less the deductionsR\x06amount\x12\x19\n" +
+	"\border_id\x18\v \x01(\x05R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\f \x01(\x05R\tproductId\x12#\n" +
+	"\rserial_number\x18\r \x01(\tR\fserialNumber\x12A\n" +
+	"\x0ewarranty_until\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rwarrantyUntil\x12%\n" +
+	"\x0eunder_warranty\x18\x0f \x01(\bR\runderWarranty\x12Z\n" +
+	"\vcustomer_id\x18\x10 \x01(\tB9\x92A624Customer the item was sold to, when linked to a saleR\n" +
+	"customerId\x12\x19\n" +
+	"\balloy_id\x18\x11 \x01(\x05R\aalloyId\x12\\\n" +
+	"\x06purity\x18\x12 \x01(\x01BD\x92AA2?Purity of the alloy the buy price is scaled to, 0 without alloyR\x06purity:1\x92A.\n" +
+	",*\rBuyback Quote2\x1bValuation of a buyback item\"\x8c\x01\n" +
+	"\x13QuoteBuybackRequest\x12'\n" +
+	"\x04item\x18\x01 \x01(\v2\x13.market.BuybackItemR\x04item:L\x92AI\n" +
+	"G*\x15Quote Buyback Request2.Request to value an item before buying it back\"B\n" +
+	"\x14QuoteBuybackResponse\x12*\n" +
+	"\x05quote\x18\x01 \x01(\v2\x14.market.BuybackQuoteR\x05quote\"\x84\x04\n" +
+	"\x15ExecuteBuybackRequest\x12'\n" +
+	"\x04item\x18\x01 \x01(\v2\x13.market.BuybackItemR\x04item\x12i\n" +
+	"\vcustomer_id\x18\x02 \x01(\tBH\x92AE2CCustomer phone or uuid, defaults to the customer of the linked saleR\n" +
+	"customerId\x12Y\n" +
+	"\vdisposition\x18\x03 \x01(\tB7\x92A422What inventory does with the item: scrap or resaleR\vdisposition\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x85\x01\n" +
+	"\rquoted_amount\x18\x05 \x01(\x01B`\x92A]2[Amount of the quote shown to the customer, the buyback fails if the valuation changed sinceR\fquotedAmount:`\x92A]\n" +
+	"[*\x17Execute Buyback Request2+Request to buy an item back from a customer\xd2\x01\x04item\xd2\x01\vdisposition\"\xa4\x02\n" +
+	"\aBuyback\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x05quote\x18\x02 \x01(\v2\x14.market.BuybackQuoteR\x05quote\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\bstaff_id\x18\x04 \x01(\tR\astaffId\x12 \n" +
+	"\vdisposition\x18\x05 \x01(\tR\vdisposition\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:0\x92A-\n" +
+	"+*\aBuyback2 Item bought back from a customer\"C\n" +
+	"\x16ExecuteBuybackResponse\x12)\n" +
+	"\abuyback\x18\x01 \x01(\v2\x0f.market.BuybackR\abuyback2\xba\x1b\n" +
 	"\x06Market\x12\xb2\x01\n" +
 	"\x0fCreateGoldPrice\x12\x1e.market.CreateGoldPriceRequest\x1a\x1f.market.CreateGoldPriceResponse\"^\x92AA\n" +
 	"\vGold Prices\x12\x11Create gold price\x1a\x1fCreates a new gold price record\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/gold-prices\x12\xac\x01\n" +
@@ -1312,7 +2806,9 @@ const file_market_market_proto_rawDesc = "" +
 	"\x0fUpdateGoldPrice\x12\x1e.market.UpdateGoldPriceRequest\x1a\x1f.market.UpdateGoldPriceResponse\"i\x92AG\n" +
 	"\vGold Prices\x12\x11Update gold price\x1a%Updates an existing gold price record\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/gold-prices/{id}\x12\xad\x01\n" +
 	"\x0fDeleteGoldPrice\x12\x1e.market.DeleteGoldPriceRequest\x1a\x16.google.protobuf.Empty\"b\x92AC\n" +
-	"\vGold Prices\x12\x11Delete gold price\x1a!Deletes a gold price record by ID\x82\xd3\xe4\x93\x02\x16*\x14/v1/gold-prices/{id}\x12\xc9\x01\n" +
+	"\vGold Prices\x12\x11Delete gold price\x1a!Deletes a gold price record by ID\x82\xd3\xe4\x93\x02\x16*\x14/v1/gold-prices/{id}\x12\x96\x02\n" +
+	"\x13GetGoldPriceHistory\x12\".market.GetGoldPriceHistoryRequest\x1a#.market.GetGoldPriceHistoryResponse\"\xb5\x01\x92A\x88\x01\n" +
+	"\vGold Prices\x12\x16Get gold price history\x1aaReturns day, week or month OHLC candles of a gold type with moving averages and percentage change\x82\xd3\xe4\x93\x02#\x12!/v1/gold-prices/{gold_id}/history\x12\xc9\x01\n" +
 	"\x13CreateBuybackPolicy\x12\".market.CreateBuybackPolicyRequest\x1a#.market.CreateBuybackPolicyResponse\"i\x92AG\n" +
 	"\x10Buyback Policies\x12\x15Create buyback policy\x1a\x1cCreates a new buyback policy\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/buyback-policies\x12\xc3\x01\n" +
 	"\x10GetBuybackPolicy\x12\x1f.market.GetBuybackPolicyRequest\x1a .market.GetBuybackPolicyResponse\"l\x92AH\n" +
@@ -1322,7 +2818,19 @@ const file_market_market_proto_rawDesc = "" +
 	"\x13UpdateBuybackPolicy\x12\".market.UpdateBuybackPolicyRequest\x1a#.market.UpdateBuybackPolicyResponse\"t\x92AM\n" +
 	"\x10Buyback Policies\x12\x15Update buyback policy\x1a\"Updates an existing buyback policy\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/buyback-policies/{id}\x12\xc0\x01\n" +
 	"\x13DeleteBuybackPolicy\x12\".market.DeleteBuybackPolicyRequest\x1a\x16.google.protobuf.Empty\"m\x92AI\n" +
-	"\x10Buyback Policies\x12\x15Delete buyback policy\x1a\x1eDeletes a buyback policy by ID\x82\xd3\xe4\x93\x02\x1b*\x19/v1/buyback-policies/{id}\x1a%\x92A\"\x12 Service for managing gold pricesB\x86\x03\x92A\xc5\x02\x12\xb1\x01\n" +
+	"\x10Buyback Policies\x12\x15Delete buyback policy\x1a\x1eDeletes a buyback policy by ID\x82\xd3\xe4\x93\x02\x1b*\x19/v1/buyback-policies/{id}\x12\xf4\x01\n" +
+	"\fQuoteBuyback\x12\x1b.market.QuoteBuybackRequest\x1a\x1c.market.QuoteBuybackResponse\"\xa8\x01\x92A\x87\x01\n" +
+	"\bBuybacks\x12\rQuote buyback\x1alValues an item from its weight, gold type, the latest buy price, the buyback policy and condition deductions\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/buybacks/quote\x12\xe5\x01\n" +
+	"\x0eExecuteBuyback\x12\x1d.market.ExecuteBuybackRequest\x1a\x1e.market.ExecuteBuybackResponse\"\x93\x01\x92Ay\n" +
+	"\bBuybacks\x12\x0fExecute buyback\x1a\\Values and records the buyback of an item and sends it to inventory as scrap or resale stock\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/buybacks\x12\xcf\x01\n" +
+	"\n" +
+	"ListAlloys\x12\x19.market.ListAlloysRequest\x1a\x1a.market.ListAlloysResponse\"\x89\x01\x92At\n" +
+	"\x06Alloys\x12\vList alloys\x1a]Lists the alloys (24K, 18K, 14K, 10K, white gold, silver) with their purity and current price\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/alloys\x12\x97\x01\n" +
+	"\bGetAlloy\x12\x17.market.GetAlloyRequest\x1a\x18.market.GetAlloyResponse\"X\x92A>\n" +
+	"\x06Alloys\x12\tGet alloy\x1a)Retrieves an alloy with its current price\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/alloys/{id}\x12\xae\x01\n" +
+	"\vUpdateAlloy\x12\x1a.market.UpdateAlloyRequest\x1a\x1b.market.UpdateAlloyResponse\"f\x92AI\n" +
+	"\x06Alloys\x12\fUpdate alloy\x1a1Renames an alloy or maps it to another gold price\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v1/alloys/{id}\x1a%\x92A\"\x12 Service for managing gold pricesB\x86\x03\x92A\xc5\x02\x12\xb1\x01\n" +
 	"\x12Market Service API\x121API for managing gold prices and buyback policies\"c\n" +
 	"\x13Market Service Team\x123https://github.com/linhhuynhcoding/jss-microservice\x1a\x17support@goldservice.com2\x031.0*\x02\x02\x012\x10application/json:\x10application/jsonZY\n" +
 	"W\n" +
@@ -1343,7 +2851,7 @@ func file_market_market_proto_rawDescGZIP() []byte {
 	return file_market_market_proto_rawDescData
 }
 
-var file_market_market_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_market_market_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_market_market_proto_goTypes = []any{
 	(*GoldPrice)(nil),                   // 0: market.GoldPrice
 	(*CreateGoldPriceRequest)(nil),      // 1: market.CreateGoldPriceRequest
@@ -1357,60 +2865,110 @@ var file_market_market_proto_goTypes = []any{
 	(*UpdateGoldPriceRequest)(nil),      // 9: market.UpdateGoldPriceRequest
 	(*UpdateGoldPriceResponse)(nil),     // 10: market.UpdateGoldPriceResponse
 	(*DeleteGoldPriceRequest)(nil),      // 11: market.DeleteGoldPriceRequest
-	(*BuybackPolicy)(nil),               // 12: market.BuybackPolicy
-	(*CreateBuybackPolicyRequest)(nil),  // 13: market.CreateBuybackPolicyRequest
-	(*CreateBuybackPolicyResponse)(nil), // 14: market.CreateBuybackPolicyResponse
-	(*GetBuybackPolicyRequest)(nil),     // 15: market.GetBuybackPolicyRequest
-	(*GetBuybackPolicyResponse)(nil),    // 16: market.GetBuybackPolicyResponse
-	(*ListBuybackPoliciesRequest)(nil),  // 17: market.ListBuybackPoliciesRequest
-	(*ListBuybackPoliciesResponse)(nil), // 18: market.ListBuybackPoliciesResponse
-	(*UpdateBuybackPolicyRequest)(nil),  // 19: market.UpdateBuybackPolicyRequest
-	(*UpdateBuybackPolicyResponse)(nil), // 20: market.UpdateBuybackPolicyResponse
-	(*DeleteBuybackPolicyRequest)(nil),  // 21: market.DeleteBuybackPolicyRequest
-	(*timestamppb.Timestamp)(nil),       // 22: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 23: google.protobuf.Empty
+	(*Ohlc)(nil),                        // 12: market.Ohlc
+	(*GoldPriceCandle)(nil),             // 13: market.GoldPriceCandle
+	(*GetGoldPriceHistoryRequest)(nil),  // 14: market.GetGoldPriceHistoryRequest
+	(*GetGoldPriceHistoryResponse)(nil), // 15: market.GetGoldPriceHistoryResponse
+	(*BuybackPolicy)(nil),               // 16: market.BuybackPolicy
+	(*CreateBuybackPolicyRequest)(nil),  // 17: market.CreateBuybackPolicyRequest
+	(*CreateBuybackPolicyResponse)(nil), // 18: market.CreateBuybackPolicyResponse
+	(*GetBuybackPolicyRequest)(nil),     // 19: market.GetBuybackPolicyRequest
+	(*GetBuybackPolicyResponse)(nil),    // 20: market.GetBuybackPolicyResponse
+	(*ListBuybackPoliciesRequest)(nil),  // 21: market.ListBuybackPoliciesRequest
+	(*ListBuybackPoliciesResponse)(nil), // 22: market.ListBuybackPoliciesResponse
+	(*UpdateBuybackPolicyRequest)(nil),  // 23: market.UpdateBuybackPolicyRequest
+	(*UpdateBuybackPolicyResponse)(nil), // 24: market.UpdateBuybackPolicyResponse
+	(*DeleteBuybackPolicyRequest)(nil),  // 25: market.DeleteBuybackPolicyRequest
+	(*Alloy)(nil),                       // 26: market.Alloy
+	(*ListAlloysRequest)(nil),           // 27: market.ListAlloysRequest
+	(*ListAlloysResponse)(nil),          // 28: market.ListAlloysResponse
+	(*GetAlloyRequest)(nil),             // 29: market.GetAlloyRequest
+	(*GetAlloyResponse)(nil),            // 30: market.GetAlloyResponse
+	(*UpdateAlloyRequest)(nil),          // 31: market.UpdateAlloyRequest
+	(*UpdateAlloyResponse)(nil),         // 32: market.UpdateAlloyResponse
+	(*BuybackDeduction)(nil),            // 33: market.BuybackDeduction
+	(*BuybackItem)(nil),                 // 34: market.BuybackItem
+	(*BuybackQuote)(nil),                // 35: market.BuybackQuote
+	(*QuoteBuybackRequest)(nil),         // 36: market.QuoteBuybackRequest
+	(*QuoteBuybackResponse)(nil),        // 37: market.QuoteBuybackResponse
+	(*ExecuteBuybackRequest)(nil),       // 38: market.ExecuteBuybackRequest
+	(*Buyback)(nil),                     // 39: market.Buyback
+	(*ExecuteBuybackResponse)(nil),      // 40: market.ExecuteBuybackResponse
+	(*timestamppb.Timestamp)(nil),       // 41: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 42: google.protobuf.Empty
 }
 var file_market_market_proto_depIdxs = []int32{
-	22, // 0: market.GoldPrice.date:type_name -> google.protobuf.Timestamp
-	22, // 1: market.CreateGoldPriceRequest.date:type_name -> google.protobuf.Timestamp
+	41, // 0: market.GoldPrice.date:type_name -> google.protobuf.Timestamp
+	41, // 1: market.CreateGoldPriceRequest.date:type_name -> google.protobuf.Timestamp
 	0,  // 2: market.CreateGoldPriceResponse.gold_price:type_name -> market.GoldPrice
 	0,  // 3: market.GetGoldPriceResponse.gold_price:type_name -> market.GoldPrice
 	0,  // 4: market.GetLatestGoldPriceResponse.gold_prices:type_name -> market.GoldPrice
 	0,  // 5: market.ListGoldPricesResponse.gold_prices:type_name -> market.GoldPrice
-	22, // 6: market.UpdateGoldPriceRequest.date:type_name -> google.protobuf.Timestamp
+	41, // 6: market.UpdateGoldPriceRequest.date:type_name -> google.protobuf.Timestamp
 	0,  // 7: market.UpdateGoldPriceResponse.gold_price:type_name -> market.GoldPrice
-	22, // 8: market.BuybackPolicy.created_at:type_name -> google.protobuf.Timestamp
-	12, // 9: market.CreateBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
-	12, // 10: market.GetBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
-	12, // 11: market.ListBuybackPoliciesResponse.buyback_policies:type_name -> market.BuybackPolicy
-	12, // 12: market.UpdateBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
-	1,  // 13: market.Market.CreateGoldPrice:input_type -> market.CreateGoldPriceRequest
-	3,  // 14: market.Market.GetGoldPrice:input_type -> market.GetGoldPriceRequest
-	5,  // 15: market.Market.GetLatestGoldPrice:input_type -> market.GetLatestGoldPriceRequest
-	7,  // 16: market.Market.ListGoldPrices:input_type -> market.ListGoldPricesRequest
-	9,  // 17: market.Market.UpdateGoldPrice:input_type -> market.UpdateGoldPriceRequest
-	11, // 18: market.Market.DeleteGoldPrice:input_type -> market.DeleteGoldPriceRequest
-	13, // 19: market.Market.CreateBuybackPolicy:input_type -> market.CreateBuybackPolicyRequest
-	15, // 20: market.Market.GetBuybackPolicy:input_type -> market.GetBuybackPolicyRequest
-	17, // 21: market.Market.ListBuybackPolicies:input_type -> market.ListBuybackPoliciesRequest
-	19, // 22: market.Market.UpdateBuybackPolicy:input_type -> market.UpdateBuybackPolicyRequest
-	21, // 23: market.Market.DeleteBuybackPolicy:input_type -> market.DeleteBuybackPolicyRequest
-	2,  // 24: market.Market.CreateGoldPrice:output_type -> market.CreateGoldPriceResponse
-	4,  // 25: market.Market.GetGoldPrice:output_type -> market.GetGoldPriceResponse
-	6,  // 26: market.Market.GetLatestGoldPrice:output_type -> market.GetLatestGoldPriceResponse
-	8,  // 27: market.Market.ListGoldPrices:output_type -> market.ListGoldPricesResponse
-	10, // 28: market.Market.UpdateGoldPrice:output_type -> market.UpdateGoldPriceResponse
-	23, // 29: market.Market.DeleteGoldPrice:output_type -> google.protobuf.Empty
-	14, // 30: market.Market.CreateBuybackPolicy:output_type -> market.CreateBuybackPolicyResponse
-	16, // 31: market.Market.GetBuybackPolicy:output_type -> market.GetBuybackPolicyResponse
-	18, // 32: market.Market.ListBuybackPolicies:output_type -> market.ListBuybackPoliciesResponse
-	20, // 33: market.Market.UpdateBuybackPolicy:output_type -> market.UpdateBuybackPolicyResponse
-	23, // 34: market.Market.DeleteBuybackPolicy:output_type -> google.protobuf.Empty
-	24, // [24:35] is the sub-list for method output_type
-	13, // [13:24] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	41, // 8: market.GoldPriceCandle.start:type_name -> google.protobuf.Timestamp
+	12, // 9: market.GoldPriceCandle.buy:type_name -> market.Ohlc
+	12, // 10: market.GoldPriceCandle.sell:type_name -> market.Ohlc
+	41, // 11: market.GetGoldPriceHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	41, // 12: market.GetGoldPriceHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	13, // 13: market.GetGoldPriceHistoryResponse.candles:type_name -> market.GoldPriceCandle
+	41, // 14: market.BuybackPolicy.created_at:type_name -> google.protobuf.Timestamp
+	16, // 15: market.CreateBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
+	16, // 16: market.GetBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
+	16, // 17: market.ListBuybackPoliciesResponse.buyback_policies:type_name -> market.BuybackPolicy
+	16, // 18: market.UpdateBuybackPolicyResponse.buyback_policy:type_name -> market.BuybackPolicy
+	41, // 19: market.Alloy.price_date:type_name -> google.protobuf.Timestamp
+	26, // 20: market.ListAlloysResponse.alloys:type_name -> market.Alloy
+	26, // 21: market.GetAlloyResponse.alloy:type_name -> market.Alloy
+	26, // 22: market.UpdateAlloyResponse.alloy:type_name -> market.Alloy
+	33, // 23: market.BuybackItem.deductions:type_name -> market.BuybackDeduction
+	33, // 24: market.BuybackQuote.deductions:type_name -> market.BuybackDeduction
+	41, // 25: market.BuybackQuote.warranty_until:type_name -> google.protobuf.Timestamp
+	34, // 26: market.QuoteBuybackRequest.item:type_name -> market.BuybackItem
+	35, // 27: market.QuoteBuybackResponse.quote:type_name -> market.BuybackQuote
+	34, // 28: market.ExecuteBuybackRequest.item:type_name -> market.BuybackItem
+	35, // 29: market.Buyback.quote:type_name -> market.BuybackQuote
+	41, // 30: market.Buyback.created_at:type_name -> google.protobuf.Timestamp
+	39, // 31: market.ExecuteBuybackResponse.buyback:type_name -> market.Buyback
+	1,  // 32: market.Market.CreateGoldPrice:input_type -> market.CreateGoldPriceRequest
+	3,  // 33: market.Market.GetGoldPrice:input_type -> market.GetGoldPriceRequest
+	5,  // 34: market.Market.GetLatestGoldPrice:input_type -> market.GetLatestGoldPriceRequest
+	7,  // 35: market.Market.ListGoldPrices:input_type -> market.ListGoldPricesRequest
+	9,  // 36: market.Market.UpdateGoldPrice:input_type -> market.UpdateGoldPriceRequest
+	11, // 37: market.Market.DeleteGoldPrice:input_type -> market.DeleteGoldPriceRequest
+	14, // 38: market.Market.GetGoldPriceHistory:input_type -> market.GetGoldPriceHistoryRequest
+	17, // 39: market.Market.CreateBuybackPolicy:input_type -> market.CreateBuybackPolicyRequest
+	19, // 40: market.Market.GetBuybackPolicy:input_type -> market.GetBuybackPolicyRequest
+	21, // 41: market.Market.ListBuybackPolicies:input_type -> market.ListBuybackPoliciesRequest
+	23, // 42: market.Market.UpdateBuybackPolicy:input_type -> market.UpdateBuybackPolicyRequest
+	25, // 43: market.Market.DeleteBuybackPolicy:input_type -> market.DeleteBuybackPolicyRequest
+	36, // 44: market.Market.QuoteBuyback:input_type -> market.QuoteBuybackRequest
+	38, // 45: market.Market.ExecuteBuyback:input_type -> market.ExecuteBuybackRequest
+	27, // 46: market.Market.ListAlloys:input_type -> market.ListAlloysRequest
+	29, // 47: market.Market.GetAlloy:input_type -> market.GetAlloyRequest
+	31, // 48: market.Market.UpdateAlloy:input_type -> market.UpdateAlloyRequest
+	2,  // 49: market.Market.CreateGoldPrice:output_type -> market.CreateGoldPriceResponse
+	4,  // 50: market.Market.GetGoldPrice:output_type -> market.GetGoldPriceResponse
+	6,  // 51: market.Market.GetLatestGoldPrice:output_type -> market.GetLatestGoldPriceResponse
+	8,  // 52: market.Market.ListGoldPrices:output_type -> market.ListGoldPricesResponse
+	10, // 53: market.Market.UpdateGoldPrice:output_type -> market.UpdateGoldPriceResponse
+	42, // 54: market.Market.DeleteGoldPrice:output_type -> google.protobuf.Empty
+	15, // 55: market.Market.GetGoldPriceHistory:output_type -> market.GetGoldPriceHistoryResponse
+	18, // 56: market.Market.CreateBuybackPolicy:output_type -> market.CreateBuybackPolicyResponse
+	20, // 57: market.Market.GetBuybackPolicy:output_type -> market.GetBuybackPolicyResponse
+	22, // 58: market.Market.ListBuybackPolicies:output_type -> market.ListBuybackPoliciesResponse
+	24, // 59: market.Market.UpdateBuybackPolicy:output_type -> market.UpdateBuybackPolicyResponse
+	42, // 60: market.Market.DeleteBuybackPolicy:output_type -> google.protobuf.Empty
+	37, // 61: market.Market.QuoteBuyback:output_type -> market.QuoteBuybackResponse
+	40, // 62: market.Market.ExecuteBuyback:output_type -> market.ExecuteBuybackResponse
+	28, // 63: market.Market.ListAlloys:output_type -> market.ListAlloysResponse
+	30, // 64: market.Market.GetAlloy:output_type -> market.GetAlloyResponse
+	32, // 65: market.Market.UpdateAlloy:output_type -> market.UpdateAlloyResponse
+	49, // [49:66] is the sub-list for method output_type
+	32, // [32:49] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_market_market_proto_init() }
@@ -1424,7 +2982,7 @@ func file_market_market_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_market_market_proto_rawDesc), len(file_market_market_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
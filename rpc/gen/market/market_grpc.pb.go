@@ -26,11 +26,17 @@ const (
 	Market_ListGoldPrices_FullMethodName      = "/market.Market/ListGoldPrices"
 	Market_UpdateGoldPrice_FullMethodName     = "/market.Market/UpdateGoldPrice"
 	Market_DeleteGoldPrice_FullMethodName     = "/market.Market/DeleteGoldPrice"
+	Market_GetGoldPriceHistory_FullMethodName = "/market.Market/GetGoldPriceHistory"
 	Market_CreateBuybackPolicy_FullMethodName = "/market.Market/CreateBuybackPolicy"
 	Market_GetBuybackPolicy_FullMethodName    = "/market.Market/GetBuybackPolicy"
 	Market_ListBuybackPolicies_FullMethodName = "/market.Market/ListBuybackPolicies"
 	Market_UpdateBuybackPolicy_FullMethodName = "/market.Market/UpdateBuybackPolicy"
 	Market_DeleteBuybackPolicy_FullMethodName = "/market.Market/DeleteBuybackPolicy"
+	Market_QuoteBuyback_FullMethodName        = "/market.Market/QuoteBuyback"
+	Market_ExecuteBuyback_FullMethodName      = "/market.Market/ExecuteBuyback"
+	Market_ListAlloys_FullMethodName          = "/market.Market/ListAlloys"
+	Market_GetAlloy_FullMethodName            = "/market.Market/GetAlloy"
+	Market_UpdateAlloy_FullMethodName         = "/market.Market/UpdateAlloy"
 )
 
 // MarketClient is the client API for Market service.
@@ -49,6 +55,8 @@ type MarketClient interface {
 	UpdateGoldPrice(ctx context.Context, in *UpdateGoldPriceRequest, opts ...grpc.CallOption) (*UpdateGoldPriceResponse, error)
 	// Delete a gold price record
 	DeleteGoldPrice(ctx context.Context, in *DeleteGoldPriceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get the price candles of a gold type
+	GetGoldPriceHistory(ctx context.Context, in *GetGoldPriceHistoryRequest, opts ...grpc.CallOption) (*GetGoldPriceHistoryResponse, error)
 	// Create a new buyback policy
 	CreateBuybackPolicy(ctx context.Context, in *CreateBuybackPolicyRequest, opts ...grpc.CallOption) (*CreateBuybackPolicyResponse, error)
 	// Get a buyback policy by ID
@@ -59,6 +67,16 @@ type MarketClient interface {
 	UpdateBuybackPolicy(ctx context.Context, in *UpdateBuybackPolicyRequest, opts ...grpc.CallOption) (*UpdateBuybackPolicyResponse, error)
 	// Delete a buyback policy
 	DeleteBuybackPolicy(ctx context.Context, in *DeleteBuybackPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Value an item brought back by a customer
+	QuoteBuyback(ctx context.Context, in *QuoteBuybackRequest, opts ...grpc.CallOption) (*QuoteBuybackResponse, error)
+	// Buy an item back from a customer
+	ExecuteBuyback(ctx context.Context, in *ExecuteBuybackRequest, opts ...grpc.CallOption) (*ExecuteBuybackResponse, error)
+	// List the alloys with their current prices
+	ListAlloys(ctx context.Context, in *ListAlloysRequest, opts ...grpc.CallOption) (*ListAlloysResponse, error)
+	// Get an alloy with its current price
+	GetAlloy(ctx context.Context, in *GetAlloyRequest, opts ...grpc.CallOption) (*GetAlloyResponse, error)
+	// Update the name or the price mapping of an alloy
+	UpdateAlloy(ctx context.Context, in *UpdateAlloyRequest, opts ...grpc.CallOption) (*UpdateAlloyResponse, error)
 }
 
 type marketClient struct {
@@ -129,6 +147,16 @@ func (c *marketClient) DeleteGoldPrice(ctx context.Context, in *DeleteGoldPriceR
 	return out, nil
 }
 
+func (c *marketClient) GetGoldPriceHistory(ctx context.Context, in *GetGoldPriceHistoryRequest, opts ...grpc.CallOption) (*GetGoldPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGoldPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Market_GetGoldPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *marketClient) CreateBuybackPolicy(ctx context.Context, in *CreateBuybackPolicyRequest, opts ...grpc.CallOption) (*CreateBuybackPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateBuybackPolicyResponse)
@@ -179,6 +207,56 @@ func (c *marketClient) DeleteBuybackPolicy(ctx context.Context, in *DeleteBuybac
 	return out, nil
 }
 
+func (c *marketClient) QuoteBuyback(ctx context.Context, in *QuoteBuybackRequest, opts ...grpc.CallOption) (*QuoteBuybackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteBuybackResponse)
+	err := c.cc.Invoke(ctx, Market_QuoteBuyback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) ExecuteBuyback(ctx context.Context, in *ExecuteBuybackRequest, opts ...grpc.CallOption) (*ExecuteBuybackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteBuybackResponse)
+	err := c.cc.Invoke(ctx, Market_ExecuteBuyback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) ListAlloys(ctx context.Context, in *ListAlloysRequest, opts ...grpc.CallOption) (*ListAlloysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlloysResponse)
+	err := c.cc.Invoke(ctx, Market_ListAlloys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetAlloy(ctx context.Context, in *GetAlloyRequest, opts ...grpc.CallOption) (*GetAlloyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlloyResponse)
+	err := c.cc.Invoke(ctx, Market_GetAlloy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) UpdateAlloy(ctx context.Context, in *UpdateAlloyRequest, opts ...grpc.CallOption) (*UpdateAlloyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAlloyResponse)
+	err := c.cc.Invoke(ctx, Market_UpdateAlloy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MarketServer is the server API for Market service.
 // All implementations must embed UnimplementedMarketServer
 // for forward compatibility.
@@ -195,6 +273,8 @@ type MarketServer interface {
 	UpdateGoldPrice(context.Context, *UpdateGoldPriceRequest) (*UpdateGoldPriceResponse, error)
 	// Delete a gold price record
 	DeleteGoldPrice(context.Context, *DeleteGoldPriceRequest) (*emptypb.Empty, error)
+	// Get the price candles of a gold type
+	GetGoldPriceHistory(context.Context, *GetGoldPriceHistoryRequest) (*GetGoldPriceHistoryResponse, error)
 	// Create a new buyback policy
 	CreateBuybackPolicy(context.Context, *CreateBuybackPolicyRequest) (*CreateBuybackPolicyResponse, error)
 	// Get a buyback policy by ID
@@ -205,6 +285,16 @@ type MarketServer interface {
 	UpdateBuybackPolicy(context.Context, *UpdateBuybackPolicyRequest) (*UpdateBuybackPolicyResponse, error)
 	// Delete a buyback policy
 	DeleteBuybackPolicy(context.Context, *DeleteBuybackPolicyRequest) (*emptypb.Empty, error)
+	// Value an item brought back by a customer
+	QuoteBuyback(context.Context, *QuoteBuybackRequest) (*QuoteBuybackResponse, error)
+	// Buy an item back from a customer
+	ExecuteBuyback(context.Context, *ExecuteBuybackRequest) (*ExecuteBuybackResponse, error)
+	// List the alloys with their current prices
+	ListAlloys(context.Context, *ListAlloysRequest) (*ListAlloysResponse, error)
+	// Get an alloy with its current price
+	GetAlloy(context.Context, *GetAlloyRequest) (*GetAlloyResponse, error)
+	// Update the name or the price mapping of an alloy
+	UpdateAlloy(context.Context, *UpdateAlloyRequest) (*UpdateAlloyResponse, error)
 	mustEmbedUnimplementedMarketServer()
 }
 
@@ -233,6 +323,9 @@ func (UnimplementedMarketServer) UpdateGoldPrice(context.Context, *UpdateGoldPri
 func (UnimplementedMarketServer) DeleteGoldPrice(context.Context, *DeleteGoldPriceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoldPrice not implemented")
 }
+func (UnimplementedMarketServer) GetGoldPriceHistory(context.Context, *GetGoldPriceHistoryRequest) (*GetGoldPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoldPriceHistory not implemented")
+}
 func (UnimplementedMarketServer) CreateBuybackPolicy(context.Context, *CreateBuybackPolicyRequest) (*CreateBuybackPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBuybackPolicy not implemented")
 }
@@ -248,6 +341,21 @@ func (UnimplementedMarketServer) UpdateBuybackPolicy(context.Context, *UpdateBuy
 func (UnimplementedMarketServer) DeleteBuybackPolicy(context.Context, *DeleteBuybackPolicyRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBuybackPolicy not implemented")
 }
+func (UnimplementedMarketServer) QuoteBuyback(context.Context, *QuoteBuybackRequest) (*QuoteBuybackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteBuyback not implemented")
+}
+func (UnimplementedMarketServer) ExecuteBuyback(context.Context, *ExecuteBuybackRequest) (*ExecuteBuybackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBuyback not implemented")
+}
+func (UnimplementedMarketServer) ListAlloys(context.Context, *ListAlloysRequest) (*ListAlloysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlloys not implemented")
+}
+func (UnimplementedMarketServer) GetAlloy(context.Context, *GetAlloyRequest) (*GetAlloyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlloy not implemented")
+}
+func (UnimplementedMarketServer) UpdateAlloy(context.Context, *UpdateAlloyRequest) (*UpdateAlloyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlloy not implemented")
+}
 func (UnimplementedMarketServer) mustEmbedUnimplementedMarketServer() {}
 func (UnimplementedMarketServer) testEmbeddedByValue()                {}
 
@@ -377,6 +485,24 @@ func _Market_DeleteGoldPrice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Market_GetGoldPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoldPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetGoldPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetGoldPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetGoldPriceHistory(ctx, req.(*GetGoldPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Market_CreateBuybackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBuybackPolicyRequest)
 	if err := dec(in); err != nil {
@@ -467,6 +593,96 @@ func _Market_DeleteBuybackPolicy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Market_QuoteBuyback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteBuybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).QuoteBuyback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_QuoteBuyback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).QuoteBuyback(ctx, req.(*QuoteBuybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_ExecuteBuyback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteBuybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).ExecuteBuyback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_ExecuteBuyback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).ExecuteBuyback(ctx, req.(*ExecuteBuybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_ListAlloys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlloysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).ListAlloys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_ListAlloys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).ListAlloys(ctx, req.(*ListAlloysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetAlloy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlloyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetAlloy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetAlloy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetAlloy(ctx, req.(*GetAlloyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_UpdateAlloy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlloyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).UpdateAlloy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_UpdateAlloy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).UpdateAlloy(ctx, req.(*UpdateAlloyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Market_ServiceDesc is the grpc.ServiceDesc for Market service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -498,6 +714,10 @@ var Market_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGoldPrice",
 			Handler:    _Market_DeleteGoldPrice_Handler,
 		},
+		{
+			MethodName: "GetGoldPriceHistory",
+			Handler:    _Market_GetGoldPriceHistory_Handler,
+		},
 		{
 			MethodName: "CreateBuybackPolicy",
 			Handler:    _Market_CreateBuybackPolicy_Handler,
@@ -518,6 +738,26 @@ var Market_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBuybackPolicy",
 			Handler:    _Market_DeleteBuybackPolicy_Handler,
 		},
+		{
+			MethodName: "QuoteBuyback",
+			Handler:    _Market_QuoteBuyback_Handler,
+		},
+		{
+			MethodName: "ExecuteBuyback",
+			Handler:    _Market_ExecuteBuyback_Handler,
+		},
+		{
+			MethodName: "ListAlloys",
+			Handler:    _Market_ListAlloys_Handler,
+		},
+		{
+			MethodName: "GetAlloy",
+			Handler:    _Market_GetAlloy_Handler,
+		},
+		{
+			MethodName: "UpdateAlloy",
+			Handler:    _Market_UpdateAlloy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "market/market.proto",
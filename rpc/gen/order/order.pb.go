@@ -147,6 +147,8 @@ type OrderItem struct {
 	ProductName   string                 `protobuf:"bytes,10,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
 	ProductImage  string                 `protobuf:"bytes,11,opt,name=product_image,json=productImage,proto3" json:"product_image,omitempty"`
 	LineTotal     float64                `protobuf:"fixed64,12,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,13,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	StoneDetails  string                 `protobuf:"bytes,14,opt,name=stone_details,json=stoneDetails,proto3" json:"stone_details,omitempty"` // e.g. "1 diamond 0.50ct Excellent F VS1 (GIA 2141438167)"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -223,11 +225,26 @@ func (x *OrderItem) GetLineTotal() float64 {
 	return 0
 }
 
+func (x *OrderItem) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *OrderItem) GetStoneDetails() string {
+	if x != nil {
+		return x.StoneDetails
+	}
+	return ""
+}
+
 // ===== Requests/Responses =====
 type CreateOrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,3,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"` // required for serialized products
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -276,6 +293,13 @@ func (x *CreateOrderItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CreateOrderItem) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
 type CreateOrderRequest struct {
 	state        protoimpl.MessageState `protogen:"open.v1"`
 	CustomerName string                 `protobuf:"bytes,1,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
@@ -815,7 +839,7 @@ const file_order_order_proto_rawDesc = "" +
 	"\rStatusHistory\x12*\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x12.order.OrderStatusR\x06status\x12\x12\n" +
 	"\x04note\x18\x02 \x01(\tR\x04note\x12*\n" +
-	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xcc\x01\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\x98\x02\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
@@ -826,11 +850,14 @@ const file_order_order_proto_rawDesc = "" +
 	" \x01(\tR\vproductName\x12#\n" +
 	"\rproduct_image\x18\v \x01(\tR\fproductImage\x12\x1d\n" +
 	"\n" +
-	"line_total\x18\f \x01(\x01R\tlineTotal\"L\n" +
+	"line_total\x18\f \x01(\x01R\tlineTotal\x12%\n" +
+	"\x0eserial_numbers\x18\r \x03(\tR\rserialNumbers\x12#\n" +
+	"\rstone_details\x18\x0e \x01(\tR\fstoneDetails\"s\n" +
 	"\x0fCreateOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xd2\x01\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12%\n" +
+	"\x0eserial_numbers\x18\x03 \x03(\tR\rserialNumbers\"\xd2\x01\n" +
 	"\x12CreateOrderRequest\x12#\n" +
 	"\rcustomer_name\x18\x01 \x01(\tR\fcustomerName\x12,\n" +
 	"\x05items\x18\x02 \x03(\v2\x16.order.CreateOrderItemR\x05items\x12#\n" +
@@ -83,6 +83,17 @@ type Product struct {
 	UpdatedAt       string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Stock           int32                  `protobuf:"varint,15,opt,name=stock,proto3" json:"stock,omitempty"`
 	BuyTurn         int32                  `protobuf:"varint,16,opt,name=buy_turn,json=buyTurn,proto3" json:"buy_turn,omitempty"`
+	GoldType        int32                  `protobuf:"varint,17,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Stones          []*ProductStone        `protobuf:"bytes,18,rep,name=stones,proto3" json:"stones,omitempty"`
+	ParentId        int32                  `protobuf:"varint,19,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Size            string                 `protobuf:"bytes,20,opt,name=size,proto3" json:"size,omitempty"`
+	Variants        []*Product             `protobuf:"bytes,21,rep,name=variants,proto3" json:"variants,omitempty"` // only filled on parent products
+	Images          []*ProductImage        `protobuf:"bytes,22,rep,name=images,proto3" json:"images,omitempty"`     // only filled by GetProduct
+	Status          string                 `protobuf:"bytes,23,opt,name=status,proto3" json:"status,omitempty"`     // active, discontinued, archived
+	ArchivedAt      string                 `protobuf:"bytes,24,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	ReorderPoint    *int32                 `protobuf:"varint,25,opt,name=reorder_point,json=reorderPoint,proto3,oneof" json:"reorder_point,omitempty"` // unset when there is no low stock alert
+	ReorderQuantity *int32                 `protobuf:"varint,26,opt,name=reorder_quantity,json=reorderQuantity,proto3,oneof" json:"reorder_quantity,omitempty"`
+	AlloyId         int32                  `protobuf:"varint,27,opt,name=alloy_id,json=alloyId,proto3" json:"alloy_id,omitempty"` // alloy id in market-service, prices the gold at its purity
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -229,29 +240,2050 @@ func (x *Product) GetBuyTurn() int32 {
 	return 0
 }
 
+func (x *Product) GetGoldType() int32 {
+	if x != nil {
+		return x.GoldType
+	}
+	return 0
+}
+
+func (x *Product) GetStones() []*ProductStone {
+	if x != nil {
+		return x.Stones
+	}
+	return nil
+}
+
+func (x *Product) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Product) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Product) GetVariants() []*Product {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Product) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+func (x *Product) GetReorderPoint() int32 {
+	if x != nil && x.ReorderPoint != nil {
+		return *x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *Product) GetReorderQuantity() int32 {
+	if x != nil && x.ReorderQuantity != nil {
+		return *x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetAlloyId() int32 {
+	if x != nil {
+		return x.AlloyId
+	}
+	return 0
+}
+
+type ProductStone struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StoneType         string                 `protobuf:"bytes,1,opt,name=stone_type,json=stoneType,proto3" json:"stone_type,omitempty"` // diamond, ruby, sapphire, ...
+	Carat             float64                `protobuf:"fixed64,2,opt,name=carat,proto3" json:"carat,omitempty"`
+	Cut               string                 `protobuf:"bytes,3,opt,name=cut,proto3" json:"cut,omitempty"`
+	Color             string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	Clarity           string                 `protobuf:"bytes,5,opt,name=clarity,proto3" json:"clarity,omitempty"`
+	Count             int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	CertificateLab    string                 `protobuf:"bytes,7,opt,name=certificate_lab,json=certificateLab,proto3" json:"certificate_lab,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,8,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProductStone) Reset() {
+	*x = ProductStone{}
+	mi := &file_product_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStone) ProtoMessage() {}
+
+func (x *ProductStone) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStone.ProtoReflect.Descriptor instead.
+func (*ProductStone) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductStone) GetStoneType() string {
+	if x != nil {
+		return x.StoneType
+	}
+	return ""
+}
+
+func (x *ProductStone) GetCarat() float64 {
+	if x != nil {
+		return x.Carat
+	}
+	return 0
+}
+
+func (x *ProductStone) GetCut() string {
+	if x != nil {
+		return x.Cut
+	}
+	return ""
+}
+
+func (x *ProductStone) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ProductStone) GetClarity() string {
+	if x != nil {
+		return x.Clarity
+	}
+	return ""
+}
+
+func (x *ProductStone) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ProductStone) GetCertificateLab() string {
+	if x != nil {
+		return x.CertificateLab
+	}
+	return ""
+}
+
+func (x *ProductStone) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
 type ProductCategory struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId              int32                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	DefaultMarkupRate     float64                `protobuf:"fixed64,4,opt,name=default_markup_rate,json=defaultMarkupRate,proto3" json:"default_markup_rate,omitempty"`            // used when a product is created without markup rate
+	DefaultWarrantyPeriod int32                  `protobuf:"varint,5,opt,name=default_warranty_period,json=defaultWarrantyPeriod,proto3" json:"default_warranty_period,omitempty"` // months, used when a product is created without warranty
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ProductCategory) Reset() {
+	*x = ProductCategory{}
+	mi := &file_product_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductCategory) ProtoMessage() {}
+
+func (x *ProductCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductCategory.ProtoReflect.Descriptor instead.
+func (*ProductCategory) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductCategory) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductCategory) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ProductCategory) GetDefaultMarkupRate() float64 {
+	if x != nil {
+		return x.DefaultMarkupRate
+	}
+	return 0
+}
+
+func (x *ProductCategory) GetDefaultWarrantyPeriod() int32 {
+	if x != nil {
+		return x.DefaultWarrantyPeriod
+	}
+	return 0
+}
+
+type Customer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone             string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email             string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Address           string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Birthday          string                 `protobuf:"bytes,8,opt,name=birthday,proto3" json:"birthday,omitempty"`       // YYYY-MM-DD
+	Anniversary       string                 `protobuf:"bytes,9,opt,name=anniversary,proto3" json:"anniversary,omitempty"` // YYYY-MM-DD
+	RingSize          string                 `protobuf:"bytes,10,opt,name=ring_size,json=ringSize,proto3" json:"ring_size,omitempty"`
+	PreferredGoldType int32                  `protobuf:"varint,11,opt,name=preferred_gold_type,json=preferredGoldType,proto3" json:"preferred_gold_type,omitempty"` // gold price id in market-service
+	Tags              []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Uuid              string                 `protobuf:"bytes,13,opt,name=uuid,proto3" json:"uuid,omitempty"` // customer id in order-service and loyalty-service
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_product_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Customer) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Customer) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Customer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Customer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Customer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Customer) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Customer) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *Customer) GetAnniversary() string {
+	if x != nil {
+		return x.Anniversary
+	}
+	return ""
+}
+
+func (x *Customer) GetRingSize() string {
+	if x != nil {
+		return x.RingSize
+	}
+	return ""
+}
+
+func (x *Customer) GetPreferredGoldType() int32 {
+	if x != nil {
+		return x.PreferredGoldType
+	}
+	return 0
+}
+
+func (x *Customer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Customer) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// CustomerNote is a free-form note left by a staff member
+type CustomerNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    int32                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // user id
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerNote) Reset() {
+	*x = CustomerNote{}
+	mi := &file_product_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerNote) ProtoMessage() {}
+
+func (x *CustomerNote) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerNote.ProtoReflect.Descriptor instead.
+func (*CustomerNote) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CustomerNote) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CustomerNote) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerNote) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CustomerNote) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CustomerNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// CustomerPurchase is a piece bought by a customer, prices at purchase time
+type CustomerPurchase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	GoldType      int32                  `protobuf:"varint,7,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Weight        float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Quantity      int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *float64               `protobuf:"fixed64,10,opt,name=unit_price,json=unitPrice,proto3,oneof" json:"unit_price,omitempty"` // unset for purchases recorded before prices were kept
+	SerialNumbers []string               `protobuf:"bytes,11,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	PurchasedAt   string                 `protobuf:"bytes,13,opt,name=purchased_at,json=purchasedAt,proto3" json:"purchased_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerPurchase) Reset() {
+	*x = CustomerPurchase{}
+	mi := &file_product_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerPurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerPurchase) ProtoMessage() {}
+
+func (x *CustomerPurchase) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerPurchase.ProtoReflect.Descriptor instead.
+func (*CustomerPurchase) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CustomerPurchase) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CustomerPurchase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomerPurchase) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CustomerPurchase) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetGoldType() int32 {
+	if x != nil {
+		return x.GoldType
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetUnitPrice() float64 {
+	if x != nil && x.UnitPrice != nil {
+		return *x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CustomerPurchase) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+func (x *CustomerPurchase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CustomerPurchase) GetPurchasedAt() string {
+	if x != nil {
+		return x.PurchasedAt
+	}
+	return ""
+}
+
+type CustomerCategoryStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	PieceCount    int32                  `protobuf:"varint,3,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+	Spend         float64                `protobuf:"fixed64,4,opt,name=spend,proto3" json:"spend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerCategoryStat) Reset() {
+	*x = CustomerCategoryStat{}
+	mi := &file_product_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerCategoryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerCategoryStat) ProtoMessage() {}
+
+func (x *CustomerCategoryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerCategoryStat.ProtoReflect.Descriptor instead.
+func (*CustomerCategoryStat) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CustomerCategoryStat) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CustomerCategoryStat) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CustomerCategoryStat) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+func (x *CustomerCategoryStat) GetSpend() float64 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+// PrivacyRequest is an export or erasure of a customer's data answered by
+// every service holding it
+type PrivacyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // export, erase
+	CustomerId      int32                  `protobuf:"varint,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending, completed, failed
+	RequestedBy     string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt     string                 `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Acks            []*PrivacyRequestAck   `protobuf:"bytes,8,rep,name=acks,proto3" json:"acks,omitempty"`
+	PendingServices []string               `protobuf:"bytes,9,rep,name=pending_services,json=pendingServices,proto3" json:"pending_services,omitempty"`
+	Archive         string                 `protobuf:"bytes,10,opt,name=archive,proto3" json:"archive,omitempty"` // export: JSON archive, once completed
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PrivacyRequest) Reset() {
+	*x = PrivacyRequest{}
+	mi := &file_product_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRequest) ProtoMessage() {}
+
+func (x *PrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRequest.ProtoReflect.Descriptor instead.
+func (*PrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PrivacyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PrivacyRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PrivacyRequest) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *PrivacyRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PrivacyRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PrivacyRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PrivacyRequest) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *PrivacyRequest) GetAcks() []*PrivacyRequestAck {
+	if x != nil {
+		return x.Acks
+	}
+	return nil
+}
+
+func (x *PrivacyRequest) GetPendingServices() []string {
+	if x != nil {
+		return x.PendingServices
+	}
+	return nil
+}
+
+func (x *PrivacyRequest) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+type PrivacyRequestAck struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Service        string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Success        bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error          string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Records        int32                  `protobuf:"varint,4,opt,name=records,proto3" json:"records,omitempty"`
+	AcknowledgedAt string                 `protobuf:"bytes,5,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PrivacyRequestAck) Reset() {
+	*x = PrivacyRequestAck{}
+	mi := &file_product_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyRequestAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRequestAck) ProtoMessage() {}
+
+func (x *PrivacyRequestAck) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRequestAck.ProtoReflect.Descriptor instead.
+func (*PrivacyRequestAck) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PrivacyRequestAck) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *PrivacyRequestAck) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PrivacyRequestAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PrivacyRequestAck) GetRecords() int32 {
+	if x != nil {
+		return x.Records
+	}
+	return 0
+}
+
+func (x *PrivacyRequestAck) GetAcknowledgedAt() string {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return ""
+}
+
+// SegmentRules select the customers of a segment. Every rule must match, a
+// rule left empty matches everyone.
+type SegmentRules struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinSpend          float64                `protobuf:"fixed64,1,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"` // VND spent in the window
+	MaxSpend          float64                `protobuf:"fixed64,2,opt,name=max_spend,json=maxSpend,proto3" json:"max_spend,omitempty"`
+	SpendWindowDays   int32                  `protobuf:"varint,3,opt,name=spend_window_days,json=spendWindowDays,proto3" json:"spend_window_days,omitempty"`         // 0: lifetime, also bounds category_ids
+	VisitedWithinDays int32                  `protobuf:"varint,4,opt,name=visited_within_days,json=visitedWithinDays,proto3" json:"visited_within_days,omitempty"`   // last purchase at most N days ago
+	NotVisitedForDays int32                  `protobuf:"varint,5,opt,name=not_visited_for_days,json=notVisitedForDays,proto3" json:"not_visited_for_days,omitempty"` // no purchase in the last N days
+	CategoryIds       []int32                `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`                // bought in any of them or their sub categories
+	Tags              []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                                         // carries all the tags
+	MinPoints         *int32                 `protobuf:"varint,9,opt,name=min_points,json=minPoints,proto3,oneof" json:"min_points,omitempty"`                       // loyalty balance, checked against loyalty-service
+	MaxPoints         *int32                 `protobuf:"varint,10,opt,name=max_points,json=maxPoints,proto3,oneof" json:"max_points,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SegmentRules) Reset() {
+	*x = SegmentRules{}
+	mi := &file_product_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentRules) ProtoMessage() {}
+
+func (x *SegmentRules) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentRules.ProtoReflect.Descriptor instead.
+func (*SegmentRules) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SegmentRules) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetMaxSpend() float64 {
+	if x != nil {
+		return x.MaxSpend
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetSpendWindowDays() int32 {
+	if x != nil {
+		return x.SpendWindowDays
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetVisitedWithinDays() int32 {
+	if x != nil {
+		return x.VisitedWithinDays
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetNotVisitedForDays() int32 {
+	if x != nil {
+		return x.NotVisitedForDays
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *SegmentRules) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SegmentRules) GetMinPoints() int32 {
+	if x != nil && x.MinPoints != nil {
+		return *x.MinPoints
+	}
+	return 0
+}
+
+func (x *SegmentRules) GetMaxPoints() int32 {
+	if x != nil && x.MaxPoints != nil {
+		return *x.MaxPoints
+	}
+	return 0
+}
+
+type Segment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Rules         *SegmentRules          `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	VoucherId     int32                  `protobuf:"varint,5,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"` // loyalty voucher granted to new members, 0: none
+	MemberCount   int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	ComputedAt    string                 `protobuf:"bytes,7,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	mi := &file_product_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Segment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Segment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Segment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Segment) GetRules() *SegmentRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Segment) GetVoucherId() int32 {
+	if x != nil {
+		return x.VoucherId
+	}
+	return 0
+}
+
+func (x *Segment) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *Segment) GetComputedAt() string {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return ""
+}
+
+func (x *Segment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Segment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SegmentMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Spend         float64                `protobuf:"fixed64,2,opt,name=spend,proto3" json:"spend,omitempty"` // in the spend window
+	LastVisit     string                 `protobuf:"bytes,3,opt,name=last_visit,json=lastVisit,proto3" json:"last_visit,omitempty"`
+	AddedAt       string                 `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentMember) Reset() {
+	*x = SegmentMember{}
+	mi := &file_product_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentMember) ProtoMessage() {}
+
+func (x *SegmentMember) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentMember.ProtoReflect.Descriptor instead.
+func (*SegmentMember) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SegmentMember) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *SegmentMember) GetSpend() float64 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *SegmentMember) GetLastVisit() string {
+	if x != nil {
+		return x.LastVisit
+	}
+	return ""
+}
+
+func (x *SegmentMember) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type StocktakeSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // open, submitted, approved, rejected
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	OpenedBy      string                 `protobuf:"bytes,6,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,7,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SubmittedAt   string                 `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ApprovedAt    string                 `protobuf:"bytes,10,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StocktakeSession) Reset() {
+	*x = StocktakeSession{}
+	mi := &file_product_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeSession) ProtoMessage() {}
+
+func (x *StocktakeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeSession.ProtoReflect.Descriptor instead.
+func (*StocktakeSession) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StocktakeSession) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StocktakeSession) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *StocktakeSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetOpenedBy() string {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *StocktakeSession) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+type StocktakeLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CountedQuantity  int32                  `protobuf:"varint,4,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	ExpectedQuantity int32                  `protobuf:"varint,5,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"` // ledger balance
+	Variance         int32                  `protobuf:"varint,6,opt,name=variance,proto3" json:"variance,omitempty"`                                         // counted - expected
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StocktakeLine) Reset() {
+	*x = StocktakeLine{}
+	mi := &file_product_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakeLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeLine) ProtoMessage() {}
+
+func (x *StocktakeLine) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeLine.ProtoReflect.Descriptor instead.
+func (*StocktakeLine) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StocktakeLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StocktakeLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StocktakeLine) GetCountedQuantity() int32 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetExpectedQuantity() int32 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *StocktakeLine) GetVariance() int32 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+type ProductSerial struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SerialNumber      string                 `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	ProductId         int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Weight            float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	StoneDetails      string                 `protobuf:"bytes,5,opt,name=stone_details,json=stoneDetails,proto3" json:"stone_details,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,6,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // in_stock, sold, bought_back
+	OrderId           int32                  `protobuf:"varint,8,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProductSerial) Reset() {
+	*x = ProductSerial{}
+	mi := &file_product_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSerial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSerial) ProtoMessage() {}
+
+func (x *ProductSerial) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSerial.ProtoReflect.Descriptor instead.
+func (*ProductSerial) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProductSerial) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductSerial) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *ProductSerial) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductSerial) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ProductSerial) GetStoneDetails() string {
+	if x != nil {
+		return x.StoneDetails
+	}
+	return ""
+}
+
+func (x *ProductSerial) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+func (x *ProductSerial) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductSerial) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ProductSerial) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductSerial) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ProductSerialEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // registered, sold, counted, bought_back
+	ReferenceId   int32                  `protobuf:"varint,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSerialEvent) Reset() {
+	*x = ProductSerialEvent{}
+	mi := &file_product_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSerialEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSerialEvent) ProtoMessage() {}
+
+func (x *ProductSerialEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSerialEvent.ProtoReflect.Descriptor instead.
+func (*ProductSerialEvent) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductSerialEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ProductSerialEvent) GetReferenceId() int32 {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return 0
+}
+
+func (x *ProductSerialEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ProductSerialEvent) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ProductSerialEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Supplier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	TaxCode       string                 `protobuf:"bytes,6,opt,name=tax_code,json=taxCode,proto3" json:"tax_code,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Supplier) Reset() {
+	*x = Supplier{}
+	mi := &file_product_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Supplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supplier) ProtoMessage() {}
+
+func (x *Supplier) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Supplier) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Supplier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Supplier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Supplier) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Supplier) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Supplier) GetTaxCode() string {
+	if x != nil {
+		return x.TaxCode
+	}
+	return ""
+}
+
+func (x *Supplier) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Supplier) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Supplier) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Supplier) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SupplierId    int32                  `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // open, partially_received, received, cancelled
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedAt    string                 `protobuf:"bytes,5,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines,omitempty"`        // only filled on a single order
+	Receipts      []*GoodsReceipt        `protobuf:"bytes,10,rep,name=receipts,proto3" json:"receipts,omitempty"` // only filled on a single order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_product_common_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PurchaseOrder) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetSupplierId() int32 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetExpectedAt() string {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetReceipts() []*GoodsReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type PurchaseOrderLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity         int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReceivedQuantity int32                  `protobuf:"varint,4,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	GoldCostPerGram  float64                `protobuf:"fixed64,5,opt,name=gold_cost_per_gram,json=goldCostPerGram,proto3" json:"gold_cost_per_gram,omitempty"`
+	LaborCost        float64                `protobuf:"fixed64,6,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"` // per piece
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_product_common_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PurchaseOrderLine) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() int32 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetGoldCostPerGram() float64 {
+	if x != nil {
+		return x.GoldCostPerGram
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetLaborCost() float64 {
+	if x != nil {
+		return x.LaborCost
+	}
+	return 0
+}
+
+type GoodsReceipt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PurchaseOrderId int32                  `protobuf:"varint,2,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	ExtraCost       float64                `protobuf:"fixed64,3,opt,name=extra_cost,json=extraCost,proto3" json:"extra_cost,omitempty"` // shipping, insurance, duties
+	Note            string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	ReceivedBy      string                 `protobuf:"bytes,5,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	ReceivedAt      string                 `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Lines           []*GoodsReceiptLine    `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GoodsReceipt) Reset() {
+	*x = GoodsReceipt{}
+	mi := &file_product_common_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReceipt) ProtoMessage() {}
+
+func (x *GoodsReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReceipt.ProtoReflect.Descriptor instead.
+func (*GoodsReceipt) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GoodsReceipt) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetPurchaseOrderId() int32 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetExtraCost() float64 {
+	if x != nil {
+		return x.ExtraCost
+	}
+	return 0
+}
+
+func (x *GoodsReceipt) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetReceivedBy() string {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+func (x *GoodsReceipt) GetLines() []*GoodsReceiptLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GoodsReceiptLine struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderLineId int32                  `protobuf:"varint,1,opt,name=purchase_order_line_id,json=purchaseOrderLineId,proto3" json:"purchase_order_line_id,omitempty"`
+	ProductId           int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity            int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LandedCost          float64                `protobuf:"fixed64,4,opt,name=landed_cost,json=landedCost,proto3" json:"landed_cost,omitempty"` // per piece: gold + labor + share of the extra cost
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GoodsReceiptLine) Reset() {
+	*x = GoodsReceiptLine{}
+	mi := &file_product_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsReceiptLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsReceiptLine) ProtoMessage() {}
+
+func (x *GoodsReceiptLine) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsReceiptLine.ProtoReflect.Descriptor instead.
+func (*GoodsReceiptLine) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GoodsReceiptLine) GetPurchaseOrderLineId() int32 {
+	if x != nil {
+		return x.PurchaseOrderLineId
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GoodsReceiptLine) GetLandedCost() float64 {
+	if x != nil {
+		return x.LandedCost
+	}
+	return 0
+}
+
+type Pagination struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // starts at 0
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	HasNext       bool                   `protobuf:"varint,4,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductCategory) Reset() {
-	*x = ProductCategory{}
-	mi := &file_product_common_proto_msgTypes[2]
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_product_common_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductCategory) String() string {
+func (x *Pagination) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductCategory) ProtoMessage() {}
+func (*Pagination) ProtoMessage() {}
 
-func (x *ProductCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_product_common_proto_msgTypes[2]
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,53 +2294,144 @@ func (x *ProductCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductCategory.ProtoReflect.Descriptor instead.
-func (*ProductCategory) Descriptor() ([]byte, []int) {
-	return file_product_common_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ProductCategory) GetId() int32 {
+func (x *Pagination) GetTotal() int64 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-func (x *ProductCategory) GetName() string {
+func (x *Pagination) GetPage() int32 {
 	if x != nil {
-		return x.Name
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+type ImageRendition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          string                 `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`     // thumbnail, medium, large
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // jpeg, webp on images uploaded before renditions were jpeg only
+	Width         int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageRendition) Reset() {
+	*x = ImageRendition{}
+	mi := &file_product_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageRendition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageRendition) ProtoMessage() {}
+
+func (x *ImageRendition) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageRendition.ProtoReflect.Descriptor instead.
+func (*ImageRendition) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImageRendition) GetSize() string {
+	if x != nil {
+		return x.Size
 	}
 	return ""
 }
 
-type Customer struct {
+func (x *ImageRendition) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImageRendition) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageRendition) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageRendition) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Renditions    []*ImageRendition      `protobuf:"bytes,7,rep,name=renditions,proto3" json:"renditions,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Customer) Reset() {
-	*x = Customer{}
-	mi := &file_product_common_proto_msgTypes[3]
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_product_common_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Customer) String() string {
+func (x *ProductImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Customer) ProtoMessage() {}
+func (*ProductImage) ProtoMessage() {}
 
-func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_product_common_proto_msgTypes[3]
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_product_common_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,56 +2442,63 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
-func (*Customer) Descriptor() ([]byte, []int) {
-	return file_product_common_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_product_common_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Customer) GetId() int32 {
+func (x *ProductImage) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Customer) GetName() string {
+func (x *ProductImage) GetProductId() int32 {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
-	return ""
+	return 0
 }
 
-func (x *Customer) GetPhone() string {
+func (x *ProductImage) GetSortOrder() int32 {
 	if x != nil {
-		return x.Phone
+		return x.SortOrder
 	}
-	return ""
+	return 0
 }
 
-func (x *Customer) GetEmail() string {
+func (x *ProductImage) GetIsPrimary() bool {
 	if x != nil {
-		return x.Email
+		return x.IsPrimary
 	}
-	return ""
+	return false
 }
 
-func (x *Customer) GetAddress() string {
+func (x *ProductImage) GetWidth() int32 {
 	if x != nil {
-		return x.Address
+		return x.Width
 	}
-	return ""
+	return 0
 }
 
-func (x *Customer) GetCreatedAt() string {
+func (x *ProductImage) GetHeight() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Customer) GetUpdatedAt() string {
+func (x *ProductImage) GetRenditions() []*ImageRendition {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Renditions
+	}
+	return nil
+}
+
+func (x *ProductImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
@@ -379,7 +2509,7 @@ const file_product_common_proto_rawDesc = "" +
 	"\n" +
 	"\x14product/common.proto\x12\aproduct\"\x1c\n" +
 	"\x04User\x12\x14\n" +
-	"\x05dummy\x18\x01 \x01(\tR\x05dummy\"\xd9\x03\n" +
+	"\x05dummy\x18\x01 \x01(\tR\x05dummy\"\x88\a\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -403,10 +2533,37 @@ const file_product_common_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12\x14\n" +
 	"\x05stock\x18\x0f \x01(\x05R\x05stock\x12\x19\n" +
-	"\bbuy_turn\x18\x10 \x01(\x05R\abuyTurn\"5\n" +
+	"\bbuy_turn\x18\x10 \x01(\x05R\abuyTurn\x12\x1b\n" +
+	"\tgold_type\x18\x11 \x01(\x05R\bgoldType\x12-\n" +
+	"\x06stones\x18\x12 \x03(\v2\x15.product.ProductStoneR\x06stones\x12\x1b\n" +
+	"\tparent_id\x18\x13 \x01(\x05R\bparentId\x12\x12\n" +
+	"\x04size\x18\x14 \x01(\tR\x04size\x12,\n" +
+	"\bvariants\x18\x15 \x03(\v2\x10.product.ProductR\bvariants\x12-\n" +
+	"\x06images\x18\x16 \x03(\v2\x15.product.ProductImageR\x06images\x12\x16\n" +
+	"\x06status\x18\x17 \x01(\tR\x06status\x12\x1f\n" +
+	"\varchived_at\x18\x18 \x01(\tR\n" +
+	"archivedAt\x12(\n" +
+	"\rreorder_point\x18\x19 \x01(\x05H\x00R\freorderPoint\x88\x01\x01\x12.\n" +
+	"\x10reorder_quantity\x18\x1a \x01(\x05H\x01R\x0freorderQuantity\x88\x01\x01\x12\x19\n" +
+	"\balloy_id\x18\x1b \x01(\x05R\aalloyIdB\x10\n" +
+	"\x0e_reorder_pointB\x13\n" +
+	"\x11_reorder_quantity\"\xf3\x01\n" +
+	"\fProductStone\x12\x1d\n" +
+	"\n" +
+	"stone_type\x18\x01 \x01(\tR\tstoneType\x12\x14\n" +
+	"\x05carat\x18\x02 \x01(\x01R\x05carat\x12\x10\n" +
+	"\x03cut\x18\x03 \x01(\tR\x03cut\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x12\x18\n" +
+	"\aclarity\x18\x05 \x01(\tR\aclarity\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\x12'\n" +
+	"\x0fcertificate_lab\x18\a \x01(\tR\x0ecertificateLab\x12-\n" +
+	"\x12certificate_number\x18\b \x01(\tR\x11certificateNumber\"\xba\x01\n" +
 	"\x0fProductCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xb2\x01\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x05R\bparentId\x12.\n" +
+	"\x13default_markup_rate\x18\x04 \x01(\x01R\x11defaultMarkupRate\x126\n" +
+	"\x17default_warranty_period\x18\x05 \x01(\x05R\x15defaultWarrantyPeriod\"\xe5\x02\n" +
 	"\bCustomer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -416,7 +2573,236 @@ const file_product_common_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAtB>Z<github.com/linhhuynhcoding/jss-microservices/rpc/gen/productb\x06proto3"
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12\x1a\n" +
+	"\bbirthday\x18\b \x01(\tR\bbirthday\x12 \n" +
+	"\vanniversary\x18\t \x01(\tR\vanniversary\x12\x1b\n" +
+	"\tring_size\x18\n" +
+	" \x01(\tR\bringSize\x12.\n" +
+	"\x13preferred_gold_type\x18\v \x01(\x05R\x11preferredGoldType\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x12\n" +
+	"\x04uuid\x18\r \x01(\tR\x04uuid\"\x91\x01\n" +
+	"\fCustomerNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x05R\n" +
+	"customerId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x91\x03\n" +
+	"\x10CustomerPurchase\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x05R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tgold_type\x18\a \x01(\x05R\bgoldType\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bquantity\x18\t \x01(\x05R\bquantity\x12\"\n" +
+	"\n" +
+	"unit_price\x18\n" +
+	" \x01(\x01H\x00R\tunitPrice\x88\x01\x01\x12%\n" +
+	"\x0eserial_numbers\x18\v \x03(\tR\rserialNumbers\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12!\n" +
+	"\fpurchased_at\x18\r \x01(\tR\vpurchasedAtB\r\n" +
+	"\v_unit_price\"\x93\x01\n" +
+	"\x14CustomerCategoryStat\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x05R\n" +
+	"categoryId\x12#\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12\x1f\n" +
+	"\vpiece_count\x18\x03 \x01(\x05R\n" +
+	"pieceCount\x12\x14\n" +
+	"\x05spend\x18\x04 \x01(\x01R\x05spend\"\xc7\x02\n" +
+	"\x0ePrivacyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\x05R\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\a \x01(\tR\vcompletedAt\x12.\n" +
+	"\x04acks\x18\b \x03(\v2\x1a.product.PrivacyRequestAckR\x04acks\x12)\n" +
+	"\x10pending_services\x18\t \x03(\tR\x0fpendingServices\x12\x18\n" +
+	"\aarchive\x18\n" +
+	" \x01(\tR\aarchive\"\xa0\x01\n" +
+	"\x11PrivacyRequestAck\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
+	"\arecords\x18\x04 \x01(\x05R\arecords\x12'\n" +
+	"\x0facknowledged_at\x18\x05 \x01(\tR\x0eacknowledgedAt\"\x87\x03\n" +
+	"\fSegmentRules\x12\x1b\n" +
+	"\tmin_spend\x18\x01 \x01(\x01R\bminSpend\x12\x1b\n" +
+	"\tmax_spend\x18\x02 \x01(\x01R\bmaxSpend\x12*\n" +
+	"\x11spend_window_days\x18\x03 \x01(\x05R\x0fspendWindowDays\x12.\n" +
+	"\x13visited_within_days\x18\x04 \x01(\x05R\x11visitedWithinDays\x12/\n" +
+	"\x14not_visited_for_days\x18\x05 \x01(\x05R\x11notVisitedForDays\x12!\n" +
+	"\fcategory_ids\x18\x06 \x03(\x05R\vcategoryIds\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\"\n" +
+	"\n" +
+	"min_points\x18\t \x01(\x05H\x00R\tminPoints\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_points\x18\n" +
+	" \x01(\x05H\x01R\tmaxPoints\x88\x01\x01B\r\n" +
+	"\v_min_pointsB\r\n" +
+	"\v_max_pointsJ\x04\b\b\x10\tR\rloyalty_tiers\"\x9d\x02\n" +
+	"\aSegment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x05rules\x18\x04 \x01(\v2\x15.product.SegmentRulesR\x05rules\x12\x1d\n" +
+	"\n" +
+	"voucher_id\x18\x05 \x01(\x05R\tvoucherId\x12!\n" +
+	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x12\x1f\n" +
+	"\vcomputed_at\x18\a \x01(\tR\n" +
+	"computedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\x8e\x01\n" +
+	"\rSegmentMember\x12-\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x11.product.CustomerR\bcustomer\x12\x14\n" +
+	"\x05spend\x18\x02 \x01(\x01R\x05spend\x12\x1d\n" +
+	"\n" +
+	"last_visit\x18\x03 \x01(\tR\tlastVisit\x12\x19\n" +
+	"\badded_at\x18\x04 \x01(\tR\aaddedAt\"\x9e\x02\n" +
+	"\x10StocktakeSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x1b\n" +
+	"\topened_by\x18\x06 \x01(\tR\bopenedBy\x12\x1f\n" +
+	"\vapproved_by\x18\a \x01(\tR\n" +
+	"approvedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12!\n" +
+	"\fsubmitted_at\x18\t \x01(\tR\vsubmittedAt\x12\x1f\n" +
+	"\vapproved_at\x18\n" +
+	" \x01(\tR\n" +
+	"approvedAtJ\x04\b\x02\x10\x03R\x06branch\"\xca\x01\n" +
+	"\rStocktakeLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x10counted_quantity\x18\x04 \x01(\x05R\x0fcountedQuantity\x12+\n" +
+	"\x11expected_quantity\x18\x05 \x01(\x05R\x10expectedQuantity\x12\x1a\n" +
+	"\bvariance\x18\x06 \x01(\x05R\bvariance\"\xc0\x02\n" +
+	"\rProductSerial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\tR\fserialNumber\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05R\tproductId\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12#\n" +
+	"\rstone_details\x18\x05 \x01(\tR\fstoneDetails\x12-\n" +
+	"\x12certificate_number\x18\x06 \x01(\tR\x11certificateNumber\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x19\n" +
+	"\border_id\x18\b \x01(\x05R\aorderId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\xa8\x01\n" +
+	"\x12ProductSerialEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12!\n" +
+	"\freference_id\x18\x02 \x01(\x05R\vreferenceId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xfe\x01\n" +
+	"\bSupplier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x19\n" +
+	"\btax_code\x18\x06 \x01(\tR\ataxCode\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\xcf\x02\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\x05R\n" +
+	"supplierId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1f\n" +
+	"\vexpected_at\x18\x05 \x01(\tR\n" +
+	"expectedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x120\n" +
+	"\x05lines\x18\t \x03(\v2\x1a.product.PurchaseOrderLineR\x05lines\x121\n" +
+	"\breceipts\x18\n" +
+	" \x03(\v2\x15.product.GoodsReceiptR\breceipts\"\xd7\x01\n" +
+	"\x11PurchaseOrderLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12+\n" +
+	"\x11received_quantity\x18\x04 \x01(\x05R\x10receivedQuantity\x12+\n" +
+	"\x12gold_cost_per_gram\x18\x05 \x01(\x01R\x0fgoldCostPerGram\x12\x1d\n" +
+	"\n" +
+	"labor_cost\x18\x06 \x01(\x01R\tlaborCost\"\xf0\x01\n" +
+	"\fGoodsReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
+	"\x11purchase_order_id\x18\x02 \x01(\x05R\x0fpurchaseOrderId\x12\x1d\n" +
+	"\n" +
+	"extra_cost\x18\x03 \x01(\x01R\textraCost\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1f\n" +
+	"\vreceived_by\x18\x05 \x01(\tR\n" +
+	"receivedBy\x12\x1f\n" +
+	"\vreceived_at\x18\x06 \x01(\tR\n" +
+	"receivedAt\x12/\n" +
+	"\x05lines\x18\a \x03(\v2\x19.product.GoodsReceiptLineR\x05lines\"\xa3\x01\n" +
+	"\x10GoodsReceiptLine\x123\n" +
+	"\x16purchase_order_line_id\x18\x01 \x01(\x05R\x13purchaseOrderLineId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vlanded_cost\x18\x04 \x01(\x01R\n" +
+	"landedCost\"g\n" +
+	"\n" +
+	"Pagination\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x19\n" +
+	"\bhas_next\x18\x04 \x01(\bR\ahasNext\"|\n" +
+	"\x0eImageRendition\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\tR\x04size\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\x81\x02\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x05R\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x04 \x01(\bR\tisPrimary\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x127\n" +
+	"\n" +
+	"renditions\x18\a \x03(\v2\x17.product.ImageRenditionR\n" +
+	"renditions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAtB>Z<github.com/linhhuynhcoding/jss-microservices/rpc/gen/productb\x06proto3"
 
 var (
 	file_product_common_proto_rawDescOnce sync.Once
@@ -430,19 +2816,50 @@ func file_product_common_proto_rawDescGZIP() []byte {
 	return file_product_common_proto_rawDescData
 }
 
-var file_product_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_product_common_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_product_common_proto_goTypes = []any{
-	(*User)(nil),            // 0: product.User
-	(*Product)(nil),         // 1: product.Product
-	(*ProductCategory)(nil), // 2: product.ProductCategory
-	(*Customer)(nil),        // 3: product.Customer
+	(*User)(nil),                 // 0: product.User
+	(*Product)(nil),              // 1: product.Product
+	(*ProductStone)(nil),         // 2: product.ProductStone
+	(*ProductCategory)(nil),      // 3: product.ProductCategory
+	(*Customer)(nil),             // 4: product.Customer
+	(*CustomerNote)(nil),         // 5: product.CustomerNote
+	(*CustomerPurchase)(nil),     // 6: product.CustomerPurchase
+	(*CustomerCategoryStat)(nil), // 7: product.CustomerCategoryStat
+	(*PrivacyRequest)(nil),       // 8: product.PrivacyRequest
+	(*PrivacyRequestAck)(nil),    // 9: product.PrivacyRequestAck
+	(*SegmentRules)(nil),         // 10: product.SegmentRules
+	(*Segment)(nil),              // 11: product.Segment
+	(*SegmentMember)(nil),        // 12: product.SegmentMember
+	(*StocktakeSession)(nil),     // 13: product.StocktakeSession
+	(*StocktakeLine)(nil),        // 14: product.StocktakeLine
+	(*ProductSerial)(nil),        // 15: product.ProductSerial
+	(*ProductSerialEvent)(nil),   // 16: product.ProductSerialEvent
+	(*Supplier)(nil),             // 17: product.Supplier
+	(*PurchaseOrder)(nil),        // 18: product.PurchaseOrder
+	(*PurchaseOrderLine)(nil),    // 19: product.PurchaseOrderLine
+	(*GoodsReceipt)(nil),         // 20: product.GoodsReceipt
+	(*GoodsReceiptLine)(nil),     // 21: product.GoodsReceiptLine
+	(*Pagination)(nil),           // 22: product.Pagination
+	(*ImageRendition)(nil),       // 23: product.ImageRendition
+	(*ProductImage)(nil),         // 24: product.ProductImage
 }
 var file_product_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: product.Product.stones:type_name -> product.ProductStone
+	1,  // 1: product.Product.variants:type_name -> product.Product
+	24, // 2: product.Product.images:type_name -> product.ProductImage
+	9,  // 3: product.PrivacyRequest.acks:type_name -> product.PrivacyRequestAck
+	10, // 4: product.Segment.rules:type_name -> product.SegmentRules
+	4,  // 5: product.SegmentMember.customer:type_name -> product.Customer
+	19, // 6: product.PurchaseOrder.lines:type_name -> product.PurchaseOrderLine
+	20, // 7: product.PurchaseOrder.receipts:type_name -> product.GoodsReceipt
+	21, // 8: product.GoodsReceipt.lines:type_name -> product.GoodsReceiptLine
+	23, // 9: product.ProductImage.renditions:type_name -> product.ImageRendition
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_product_common_proto_init() }
@@ -450,13 +2867,16 @@ func file_product_common_proto_init() {
 	if File_product_common_proto != nil {
 		return
 	}
+	file_product_common_proto_msgTypes[1].OneofWrappers = []any{}
+	file_product_common_proto_msgTypes[6].OneofWrappers = []any{}
+	file_product_common_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_common_proto_rawDesc), len(file_product_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProductSort int32
+
+const (
+	ProductSort_PRODUCT_SORT_DEFAULT      ProductSort = 0 // by id, or by relevance when searching
+	ProductSort_PRODUCT_SORT_PRICE_ASC    ProductSort = 1
+	ProductSort_PRODUCT_SORT_PRICE_DESC   ProductSort = 2
+	ProductSort_PRODUCT_SORT_NEWEST       ProductSort = 3
+	ProductSort_PRODUCT_SORT_BEST_SELLING ProductSort = 4 // by buy_turn
+)
+
+// Enum value maps for ProductSort.
+var (
+	ProductSort_name = map[int32]string{
+		0: "PRODUCT_SORT_DEFAULT",
+		1: "PRODUCT_SORT_PRICE_ASC",
+		2: "PRODUCT_SORT_PRICE_DESC",
+		3: "PRODUCT_SORT_NEWEST",
+		4: "PRODUCT_SORT_BEST_SELLING",
+	}
+	ProductSort_value = map[string]int32{
+		"PRODUCT_SORT_DEFAULT":      0,
+		"PRODUCT_SORT_PRICE_ASC":    1,
+		"PRODUCT_SORT_PRICE_DESC":   2,
+		"PRODUCT_SORT_NEWEST":       3,
+		"PRODUCT_SORT_BEST_SELLING": 4,
+	}
+)
+
+func (x ProductSort) Enum() *ProductSort {
+	p := new(ProductSort)
+	*p = x
+	return p
+}
+
+func (x ProductSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_product_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductSort) Type() protoreflect.EnumType {
+	return &file_product_product_proto_enumTypes[0]
+}
+
+func (x ProductSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSort.Descriptor instead.
+func (ProductSort) EnumDescriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{0}
+}
+
+type FileFormat int32
+
+const (
+	FileFormat_FILE_FORMAT_CSV  FileFormat = 0
+	FileFormat_FILE_FORMAT_XLSX FileFormat = 1
+)
+
+// Enum value maps for FileFormat.
+var (
+	FileFormat_name = map[int32]string{
+		0: "FILE_FORMAT_CSV",
+		1: "FILE_FORMAT_XLSX",
+	}
+	FileFormat_value = map[string]int32{
+		"FILE_FORMAT_CSV":  0,
+		"FILE_FORMAT_XLSX": 1,
+	}
+)
+
+func (x FileFormat) Enum() *FileFormat {
+	p := new(FileFormat)
+	*p = x
+	return p
+}
+
+func (x FileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_product_proto_enumTypes[1].Descriptor()
+}
+
+func (FileFormat) Type() protoreflect.EnumType {
+	return &file_product_product_proto_enumTypes[1]
+}
+
+func (x FileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileFormat.Descriptor instead.
+func (FileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{1}
+}
+
+type LabelFormat int32
+
+const (
+	LabelFormat_LABEL_FORMAT_PDF LabelFormat = 0 // one 50 x 30 mm label per page
+	LabelFormat_LABEL_FORMAT_ZPL LabelFormat = 1 // Zebra printers
+)
+
+// Enum value maps for LabelFormat.
+var (
+	LabelFormat_name = map[int32]string{
+		0: "LABEL_FORMAT_PDF",
+		1: "LABEL_FORMAT_ZPL",
+	}
+	LabelFormat_value = map[string]int32{
+		"LABEL_FORMAT_PDF": 0,
+		"LABEL_FORMAT_ZPL": 1,
+	}
+)
+
+func (x LabelFormat) Enum() *LabelFormat {
+	p := new(LabelFormat)
+	*p = x
+	return p
+}
+
+func (x LabelFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LabelFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_product_product_proto_enumTypes[2].Descriptor()
+}
+
+func (LabelFormat) Type() protoreflect.EnumType {
+	return &file_product_product_proto_enumTypes[2]
+}
+
+func (x LabelFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LabelFormat.Descriptor instead.
+func (LabelFormat) EnumDescriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{2}
+}
+
 type DummyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dummy         int32                  `protobuf:"varint,1,opt,name=dummy,proto3" json:"dummy,omitempty"`
@@ -111,20 +258,24 @@ func (x *DummyResponse) GetDummy() int32 {
 }
 
 type CreateProductRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	CategoryId     int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Weight         float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
-	LaborCost      float64                `protobuf:"fixed64,5,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"`
-	StoneCost      float64                `protobuf:"fixed64,6,opt,name=stone_cost,json=stoneCost,proto3" json:"stone_cost,omitempty"`
-	MarkupRate     float64                `protobuf:"fixed64,7,opt,name=markup_rate,json=markupRate,proto3" json:"markup_rate,omitempty"`
-	WarrantyPeriod int32                  `protobuf:"varint,8,opt,name=warranty_period,json=warrantyPeriod,proto3" json:"warranty_period,omitempty"`
-	Image          string                 `protobuf:"bytes,9,opt,name=image,proto3" json:"image,omitempty"`
-	GoldType       int32                  `protobuf:"varint,10,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
-	Stock          int32                  `protobuf:"varint,11,opt,name=stock,proto3" json:"stock,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CategoryId      int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Weight          float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	LaborCost       float64                `protobuf:"fixed64,5,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"`
+	StoneCost       float64                `protobuf:"fixed64,6,opt,name=stone_cost,json=stoneCost,proto3" json:"stone_cost,omitempty"`
+	MarkupRate      float64                `protobuf:"fixed64,7,opt,name=markup_rate,json=markupRate,proto3" json:"markup_rate,omitempty"`            // 0 uses the category default
+	WarrantyPeriod  int32                  `protobuf:"varint,8,opt,name=warranty_period,json=warrantyPeriod,proto3" json:"warranty_period,omitempty"` // 0 uses the category default
+	Image           string                 `protobuf:"bytes,9,opt,name=image,proto3" json:"image,omitempty"`
+	GoldType        int32                  `protobuf:"varint,10,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	Stock           int32                  `protobuf:"varint,11,opt,name=stock,proto3" json:"stock,omitempty"`
+	Stones          []*ProductStone        `protobuf:"bytes,12,rep,name=stones,proto3" json:"stones,omitempty"`
+	ReorderPoint    *int32                 `protobuf:"varint,13,opt,name=reorder_point,json=reorderPoint,proto3,oneof" json:"reorder_point,omitempty"` // unset disables the low stock alert
+	ReorderQuantity *int32                 `protobuf:"varint,14,opt,name=reorder_quantity,json=reorderQuantity,proto3,oneof" json:"reorder_quantity,omitempty"`
+	AlloyId         int32                  `protobuf:"varint,15,opt,name=alloy_id,json=alloyId,proto3" json:"alloy_id,omitempty"` // alloy in market-service, overrides gold_type for the gold price
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
@@ -234,27 +385,59 @@ func (x *CreateProductRequest) GetStock() int32 {
 	return 0
 }
 
-type GetProductRequest struct {
+func (x *CreateProductRequest) GetStones() []*ProductStone {
+	if x != nil {
+		return x.Stones
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetReorderPoint() int32 {
+	if x != nil && x.ReorderPoint != nil {
+		return *x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetReorderQuantity() int32 {
+	if x != nil && x.ReorderQuantity != nil {
+		return *x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetAlloyId() int32 {
+	if x != nil {
+		return x.AlloyId
+	}
+	return 0
+}
+
+type CreateProductVariantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      int32                  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Size          string                 `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"` // ring / bracelet size, e.g. "12" or "17cm"
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *CreateProductVariantRequest) Reset() {
+	*x = CreateProductVariantRequest{}
 	mi := &file_product_product_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *CreateProductVariantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*CreateProductVariantRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -266,92 +449,68 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetProductRequest) GetId() int32 {
+func (x *CreateProductVariantRequest) GetParentId() int32 {
 	if x != nil {
-		return x.Id
+		return x.ParentId
 	}
 	return 0
 }
 
-type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_product_product_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProductsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListProductsRequest) ProtoMessage() {}
-
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[4]
+func (x *CreateProductVariantRequest) GetCode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Code
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{4}
+func (x *CreateProductVariantRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
 }
 
-func (x *ListProductsRequest) GetPage() int32 {
+func (x *CreateProductVariantRequest) GetWeight() float64 {
 	if x != nil {
-		return x.Page
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetLimit() int32 {
+func (x *CreateProductVariantRequest) GetStock() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Stock
 	}
 	return 0
 }
 
-type ListProductsResponse struct {
+type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_product_product_proto_msgTypes[5]
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *GetProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*GetProductRequest) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[5]
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,52 +521,59 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *GetProductRequest) GetId() int32 {
 	if x != nil {
-		return x.Products
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UpdateProductRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code            string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	CategoryId      int32                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Weight          float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	GoldPriceAtTime float64                `protobuf:"fixed64,6,opt,name=gold_price_at_time,json=goldPriceAtTime,proto3" json:"gold_price_at_time,omitempty"`
-	LaborCost       float64                `protobuf:"fixed64,7,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"`
-	StoneCost       float64                `protobuf:"fixed64,8,opt,name=stone_cost,json=stoneCost,proto3" json:"stone_cost,omitempty"`
-	MarkupRate      float64                `protobuf:"fixed64,9,opt,name=markup_rate,json=markupRate,proto3" json:"markup_rate,omitempty"`
-	SellingPrice    float64                `protobuf:"fixed64,10,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"`
-	WarrantyPeriod  int32                  `protobuf:"varint,11,opt,name=warranty_period,json=warrantyPeriod,proto3" json:"warranty_period,omitempty"`
-	Image           string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`
-	Stock           int32                  `protobuf:"varint,13,opt,name=stock,proto3" json:"stock,omitempty"`
+type ListProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// stone filters, a product matches when one of its stones matches all of them
+	StoneType       string      `protobuf:"bytes,3,opt,name=stone_type,json=stoneType,proto3" json:"stone_type,omitempty"`
+	MinCarat        float64     `protobuf:"fixed64,4,opt,name=min_carat,json=minCarat,proto3" json:"min_carat,omitempty"`
+	MaxCarat        float64     `protobuf:"fixed64,5,opt,name=max_carat,json=maxCarat,proto3" json:"max_carat,omitempty"`
+	StoneColor      string      `protobuf:"bytes,6,opt,name=stone_color,json=stoneColor,proto3" json:"stone_color,omitempty"`
+	StoneClarity    string      `protobuf:"bytes,7,opt,name=stone_clarity,json=stoneClarity,proto3" json:"stone_clarity,omitempty"`
+	CertificateLab  string      `protobuf:"bytes,8,opt,name=certificate_lab,json=certificateLab,proto3" json:"certificate_lab,omitempty"`
+	CategoryId      int32       `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	GoldType        int32       `protobuf:"varint,10,opt,name=gold_type,json=goldType,proto3" json:"gold_type,omitempty"`
+	MinPrice        float64     `protobuf:"fixed64,11,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice        float64     `protobuf:"fixed64,12,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	MinWeight       float64     `protobuf:"fixed64,13,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`
+	MaxWeight       float64     `protobuf:"fixed64,14,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	InStock         bool        `protobuf:"varint,15,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"` // the product or one of its variants is in stock
+	Search          string      `protobuf:"bytes,16,opt,name=search,proto3" json:"search,omitempty"`                   // name or code, diacritics are ignored
+	Sort            ProductSort `protobuf:"varint,17,opt,name=sort,proto3,enum=product.ProductSort" json:"sort,omitempty"`
+	Status          string      `protobuf:"bytes,18,opt,name=status,proto3" json:"status,omitempty"`                                           // active, discontinued or archived
+	IncludeArchived bool        `protobuf:"varint,19,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // archived products are hidden by default
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_product_product_proto_msgTypes[6]
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ListProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ListProductsRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[6]
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,123 +584,236 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdateProductRequest) GetId() int32 {
+func (x *ListProductsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *ListProductsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateProductRequest) GetCode() string {
+func (x *ListProductsRequest) GetStoneType() string {
 	if x != nil {
-		return x.Code
+		return x.StoneType
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetCategoryId() int32 {
+func (x *ListProductsRequest) GetMinCarat() float64 {
 	if x != nil {
-		return x.CategoryId
+		return x.MinCarat
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetWeight() float64 {
+func (x *ListProductsRequest) GetMaxCarat() float64 {
 	if x != nil {
-		return x.Weight
+		return x.MaxCarat
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetGoldPriceAtTime() float64 {
+func (x *ListProductsRequest) GetStoneColor() string {
 	if x != nil {
-		return x.GoldPriceAtTime
+		return x.StoneColor
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductRequest) GetLaborCost() float64 {
+func (x *ListProductsRequest) GetStoneClarity() string {
 	if x != nil {
-		return x.LaborCost
+		return x.StoneClarity
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductRequest) GetStoneCost() float64 {
+func (x *ListProductsRequest) GetCertificateLab() string {
 	if x != nil {
-		return x.StoneCost
+		return x.CertificateLab
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductRequest) GetMarkupRate() float64 {
+func (x *ListProductsRequest) GetCategoryId() int32 {
 	if x != nil {
-		return x.MarkupRate
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetSellingPrice() float64 {
+func (x *ListProductsRequest) GetGoldType() int32 {
 	if x != nil {
-		return x.SellingPrice
+		return x.GoldType
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetWarrantyPeriod() int32 {
+func (x *ListProductsRequest) GetMinPrice() float64 {
 	if x != nil {
-		return x.WarrantyPeriod
+		return x.MinPrice
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetImage() string {
+func (x *ListProductsRequest) GetMaxPrice() float64 {
 	if x != nil {
-		return x.Image
+		return x.MaxPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateProductRequest) GetStock() int32 {
+func (x *ListProductsRequest) GetMinWeight() float64 {
 	if x != nil {
-		return x.Stock
+		return x.MinWeight
 	}
 	return 0
 }
 
-type DeleteProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListProductsRequest) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_product_product_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListProductsRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *ListProductsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetSort() ProductSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ProductSort_PRODUCT_SORT_DEFAULT
+}
+
+func (x *ListProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*ListProductsResponse) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code              string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	CategoryId        int32                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Weight            float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	GoldPriceAtTime   float64                `protobuf:"fixed64,6,opt,name=gold_price_at_time,json=goldPriceAtTime,proto3" json:"gold_price_at_time,omitempty"`
+	LaborCost         float64                `protobuf:"fixed64,7,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"`
+	StoneCost         float64                `protobuf:"fixed64,8,opt,name=stone_cost,json=stoneCost,proto3" json:"stone_cost,omitempty"`
+	MarkupRate        float64                `protobuf:"fixed64,9,opt,name=markup_rate,json=markupRate,proto3" json:"markup_rate,omitempty"`
+	SellingPrice      float64                `protobuf:"fixed64,10,opt,name=selling_price,json=sellingPrice,proto3" json:"selling_price,omitempty"`
+	WarrantyPeriod    int32                  `protobuf:"varint,11,opt,name=warranty_period,json=warrantyPeriod,proto3" json:"warranty_period,omitempty"`
+	Image             string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`
+	Stock             int32                  `protobuf:"varint,13,opt,name=stock,proto3" json:"stock,omitempty"`
+	Stones            []*ProductStone        `protobuf:"bytes,14,rep,name=stones,proto3" json:"stones,omitempty"`
+	ReplaceStones     bool                   `protobuf:"varint,15,opt,name=replace_stones,json=replaceStones,proto3" json:"replace_stones,omitempty"`    // replace the stones with the given list, even when empty
+	Status            string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`                                        // active or discontinued, use DeleteProduct to archive
+	ReorderPoint      *int32                 `protobuf:"varint,17,opt,name=reorder_point,json=reorderPoint,proto3,oneof" json:"reorder_point,omitempty"` // unset keeps the current value
+	ReorderQuantity   *int32                 `protobuf:"varint,18,opt,name=reorder_quantity,json=reorderQuantity,proto3,oneof" json:"reorder_quantity,omitempty"`
+	ClearReorderPoint bool                   `protobuf:"varint,19,opt,name=clear_reorder_point,json=clearReorderPoint,proto3" json:"clear_reorder_point,omitempty"` // removes the reorder point and quantity
+	AlloyId           *int32                 `protobuf:"varint,20,opt,name=alloy_id,json=alloyId,proto3,oneof" json:"alloy_id,omitempty"`                           // unset keeps the current alloy, 0 removes it
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_product_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -546,39 +825,173 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DeleteProductRequest) GetId() int32 {
+func (x *UpdateProductRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteProductResponse struct {
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetGoldPriceAtTime() float64 {
+	if x != nil {
+		return x.GoldPriceAtTime
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetLaborCost() float64 {
+	if x != nil {
+		return x.LaborCost
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetStoneCost() float64 {
+	if x != nil {
+		return x.StoneCost
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetMarkupRate() float64 {
+	if x != nil {
+		return x.MarkupRate
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSellingPrice() float64 {
+	if x != nil {
+		return x.SellingPrice
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetWarrantyPeriod() int32 {
+	if x != nil {
+		return x.WarrantyPeriod
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetStones() []*ProductStone {
+	if x != nil {
+		return x.Stones
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetReplaceStones() bool {
+	if x != nil {
+		return x.ReplaceStones
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetReorderPoint() int32 {
+	if x != nil && x.ReorderPoint != nil {
+		return *x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetReorderQuantity() int32 {
+	if x != nil && x.ReorderQuantity != nil {
+		return *x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetClearReorderPoint() bool {
+	if x != nil {
+		return x.ClearReorderPoint
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetAlloyId() int32 {
+	if x != nil && x.AlloyId != nil {
+		return *x.AlloyId
+	}
+	return 0
+}
+
+type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hard          bool                   `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"` // delete the row instead of archiving it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
 	mi := &file_product_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *DeleteProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*DeleteProductRequest) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,39 +1003,46 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteProductResponse) GetSuccess() bool {
+func (x *DeleteProductRequest) GetId() int32 {
 	if x != nil {
-		return x.Success
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteProductRequest) GetHard() bool {
+	if x != nil {
+		return x.Hard
 	}
 	return false
 }
 
-type ProductResponse struct {
+type RestoreProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductResponse) Reset() {
-	*x = ProductResponse{}
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
 	mi := &file_product_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductResponse) String() string {
+func (x *RestoreProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductResponse) ProtoMessage() {}
+func (*RestoreProductRequest) ProtoMessage() {}
 
-func (x *ProductResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,38 +1054,39 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
-func (*ProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
 	return file_product_product_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ProductResponse) GetProduct() *Product {
+func (x *RestoreProductRequest) GetId() int32 {
 	if x != nil {
-		return x.Product
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ListProductCategoriesRequest struct {
+type DeleteProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductCategoriesRequest) Reset() {
-	*x = ListProductCategoriesRequest{}
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
 	mi := &file_product_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductCategoriesRequest) String() string {
+func (x *DeleteProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductCategoriesRequest) ProtoMessage() {}
+func (*DeleteProductResponse) ProtoMessage() {}
 
-func (x *ListProductCategoriesRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_product_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,36 +1095,3924 @@ func (x *ListProductCategoriesRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ImportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filename and dry_run are read from the first message
+	Filename      string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`            // .csv or .xlsx
+	DryRun        bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // validate and price the rows without saving them
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportProductsRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportProductsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"` // nothing is imported when a row is invalid
+	Products      []*Product             `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportProductsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // row number in the file, the header is row 1
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_product_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        FileFormat             `protobuf:"varint,1,opt,name=format,proto3,enum=product.FileFormat" json:"format,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportProductsRequest) GetFormat() FileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileFormat_FILE_FORMAT_CSV
+}
+
+func (x *ExportProductsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type ExportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,2,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsResponse) Reset() {
+	*x = ExportProductsResponse{}
+	mi := &file_product_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsResponse) ProtoMessage() {}
+
+func (x *ExportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportProductsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportProductsResponse) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *ExportProductsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GenerateLabelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int32                `protobuf:"varint,1,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // used when product_ids is empty
+	Format        LabelFormat            `protobuf:"varint,3,opt,name=format,proto3,enum=product.LabelFormat" json:"format,omitempty"`
+	Copies        int32                  `protobuf:"varint,4,opt,name=copies,proto3" json:"copies,omitempty"` // per product, default 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLabelsRequest) Reset() {
+	*x = GenerateLabelsRequest{}
+	mi := &file_product_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLabelsRequest) ProtoMessage() {}
+
+func (x *GenerateLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GenerateLabelsRequest) GetProductIds() []int32 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GenerateLabelsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GenerateLabelsRequest) GetFormat() LabelFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LabelFormat_LABEL_FORMAT_PDF
+}
+
+func (x *GenerateLabelsRequest) GetCopies() int32 {
+	if x != nil {
+		return x.Copies
+	}
+	return 0
+}
+
+type GenerateLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,2,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateLabelsResponse) Reset() {
+	*x = GenerateLabelsResponse{}
+	mi := &file_product_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLabelsResponse) ProtoMessage() {}
+
+func (x *GenerateLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLabelsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateLabelsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GenerateLabelsResponse) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *GenerateLabelsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductResponse) Reset() {
+	*x = ProductResponse{}
+	mi := &file_product_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductResponse) ProtoMessage() {}
+
+func (x *ProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
+func (*ProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductCategoriesRequest) Reset() {
+	*x = ListProductCategoriesRequest{}
+	mi := &file_product_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductCategoriesRequest) ProtoMessage() {}
+
+func (x *ListProductCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+type ListProductCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*ProductCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductCategoriesResponse) Reset() {
+	*x = ListProductCategoriesResponse{}
+	mi := &file_product_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductCategoriesResponse) ProtoMessage() {}
+
+func (x *ListProductCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListProductCategoriesResponse) GetCategories() []*ProductCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateProductCategoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId              int32                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	DefaultMarkupRate     float64                `protobuf:"fixed64,3,opt,name=default_markup_rate,json=defaultMarkupRate,proto3" json:"default_markup_rate,omitempty"`
+	DefaultWarrantyPeriod int32                  `protobuf:"varint,4,opt,name=default_warranty_period,json=defaultWarrantyPeriod,proto3" json:"default_warranty_period,omitempty"` // months
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateProductCategoryRequest) Reset() {
+	*x = CreateProductCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductCategoryRequest) ProtoMessage() {}
+
+func (x *CreateProductCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateProductCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductCategoryRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateProductCategoryRequest) GetDefaultMarkupRate() float64 {
+	if x != nil {
+		return x.DefaultMarkupRate
+	}
+	return 0
+}
+
+func (x *CreateProductCategoryRequest) GetDefaultWarrantyPeriod() int32 {
+	if x != nil {
+		return x.DefaultWarrantyPeriod
+	}
+	return 0
+}
+
+type GetProductCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductCategoryRequest) Reset() {
+	*x = GetProductCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductCategoryRequest) ProtoMessage() {}
+
+func (x *GetProductCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetProductCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateProductCategoryRequest replaces all fields of the category
+type UpdateProductCategoryRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId              int32                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 moves the category to the top level
+	DefaultMarkupRate     float64                `protobuf:"fixed64,4,opt,name=default_markup_rate,json=defaultMarkupRate,proto3" json:"default_markup_rate,omitempty"`
+	DefaultWarrantyPeriod int32                  `protobuf:"varint,5,opt,name=default_warranty_period,json=defaultWarrantyPeriod,proto3" json:"default_warranty_period,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateProductCategoryRequest) Reset() {
+	*x = UpdateProductCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateProductCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateProductCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductCategoryRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateProductCategoryRequest) GetDefaultMarkupRate() float64 {
+	if x != nil {
+		return x.DefaultMarkupRate
+	}
+	return 0
+}
+
+func (x *UpdateProductCategoryRequest) GetDefaultWarrantyPeriod() int32 {
+	if x != nil {
+		return x.DefaultWarrantyPeriod
+	}
+	return 0
+}
+
+type DeleteProductCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductCategoryRequest) Reset() {
+	*x = DeleteProductCategoryRequest{}
+	mi := &file_product_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteProductCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteProductCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductCategoryResponse) Reset() {
+	*x = DeleteProductCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteProductCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ProductCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *ProductCategory       `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductCategoryResponse) Reset() {
+	*x = ProductCategoryResponse{}
+	mi := &file_product_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductCategoryResponse) ProtoMessage() {}
+
+func (x *ProductCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ProductCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProductCategoryResponse) GetCategory() *ProductCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type CreateCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	mi := &file_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateCustomerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateCustomerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"` // phone number in any format, or the customer uuid
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRequest) Reset() {
+	*x = GetCustomerRequest{}
+	mi := &file_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRequest) ProtoMessage() {}
+
+func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type ListCustomersRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Page              int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit             int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Search            string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"` // name or email without diacritics, or part of the phone number
+	Tags              []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`     // customers carrying all the tags
+	PreferredGoldType int32                  `protobuf:"varint,5,opt,name=preferred_gold_type,json=preferredGoldType,proto3" json:"preferred_gold_type,omitempty"`
+	RingSize          string                 `protobuf:"bytes,6,opt,name=ring_size,json=ringSize,proto3" json:"ring_size,omitempty"`
+	OccasionMonth     int32                  `protobuf:"varint,7,opt,name=occasion_month,json=occasionMonth,proto3" json:"occasion_month,omitempty"` // 1-12, birthday or anniversary in the month
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListCustomersRequest) Reset() {
+	*x = ListCustomersRequest{}
+	mi := &file_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersRequest) ProtoMessage() {}
+
+func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCustomersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListCustomersRequest) GetPreferredGoldType() int32 {
+	if x != nil {
+		return x.PreferredGoldType
+	}
+	return 0
+}
+
+func (x *ListCustomersRequest) GetRingSize() string {
+	if x != nil {
+		return x.RingSize
+	}
+	return ""
+}
+
+func (x *ListCustomersRequest) GetOccasionMonth() int32 {
+	if x != nil {
+		return x.OccasionMonth
+	}
+	return 0
+}
+
+type ListCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomersResponse) Reset() {
+	*x = ListCustomersResponse{}
+	mi := &file_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomersResponse) ProtoMessage() {}
+
+func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *ListCustomersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UpdateCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	mi := &file_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateCustomerRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCustomerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateCustomerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DeleteCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	mi := &file_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteCustomerRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerResponse) Reset() {
+	*x = DeleteCustomerResponse{}
+	mi := &file_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerResponse) ProtoMessage() {}
+
+func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteCustomerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerResponse) Reset() {
+	*x = CustomerResponse{}
+	mi := &file_product_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerResponse) ProtoMessage() {}
+
+func (x *CustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerResponse.ProtoReflect.Descriptor instead.
+func (*CustomerResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+// Dates are YYYY-MM-DD, empty values clear the attribute
+type UpdateCustomerAttributesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Birthday          string                 `protobuf:"bytes,2,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Anniversary       string                 `protobuf:"bytes,3,opt,name=anniversary,proto3" json:"anniversary,omitempty"`
+	RingSize          string                 `protobuf:"bytes,4,opt,name=ring_size,json=ringSize,proto3" json:"ring_size,omitempty"`
+	PreferredGoldType int32                  `protobuf:"varint,5,opt,name=preferred_gold_type,json=preferredGoldType,proto3" json:"preferred_gold_type,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerAttributesRequest) Reset() {
+	*x = UpdateCustomerAttributesRequest{}
+	mi := &file_product_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerAttributesRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerAttributesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateCustomerAttributesRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCustomerAttributesRequest) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *UpdateCustomerAttributesRequest) GetAnniversary() string {
+	if x != nil {
+		return x.Anniversary
+	}
+	return ""
+}
+
+func (x *UpdateCustomerAttributesRequest) GetRingSize() string {
+	if x != nil {
+		return x.RingSize
+	}
+	return ""
+}
+
+func (x *UpdateCustomerAttributesRequest) GetPreferredGoldType() int32 {
+	if x != nil {
+		return x.PreferredGoldType
+	}
+	return 0
+}
+
+// SetCustomerTags replaces the tags of the customer
+type SetCustomerTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCustomerTagsRequest) Reset() {
+	*x = SetCustomerTagsRequest{}
+	mi := &file_product_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCustomerTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCustomerTagsRequest) ProtoMessage() {}
+
+func (x *SetCustomerTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCustomerTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetCustomerTagsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetCustomerTagsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetCustomerTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddCustomerNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCustomerNoteRequest) Reset() {
+	*x = AddCustomerNoteRequest{}
+	mi := &file_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCustomerNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCustomerNoteRequest) ProtoMessage() {}
+
+func (x *AddCustomerNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomerNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddCustomerNoteRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddCustomerNoteRequest) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *AddCustomerNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CustomerNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *CustomerNote          `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerNoteResponse) Reset() {
+	*x = CustomerNoteResponse{}
+	mi := &file_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerNoteResponse) ProtoMessage() {}
+
+func (x *CustomerNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerNoteResponse.ProtoReflect.Descriptor instead.
+func (*CustomerNoteResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CustomerNoteResponse) GetNote() *CustomerNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type ListCustomerNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerNotesRequest) Reset() {
+	*x = ListCustomerNotesRequest{}
+	mi := &file_product_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerNotesRequest) ProtoMessage() {}
+
+func (x *ListCustomerNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomerNotesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCustomerNotesRequest) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ListCustomerNotesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCustomerNotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCustomerNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*CustomerNote        `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerNotesResponse) Reset() {
+	*x = ListCustomerNotesResponse{}
+	mi := &file_product_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerNotesResponse) ProtoMessage() {}
+
+func (x *ListCustomerNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomerNotesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCustomerNotesResponse) GetNotes() []*CustomerNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListCustomerNotesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type DeleteCustomerNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int32                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerNoteRequest) Reset() {
+	*x = DeleteCustomerNoteRequest{}
+	mi := &file_product_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerNoteRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerNoteRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteCustomerNoteRequest) GetCustomerId() int32 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *DeleteCustomerNoteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCustomerNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerNoteResponse) Reset() {
+	*x = DeleteCustomerNoteResponse{}
+	mi := &file_product_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerNoteResponse) ProtoMessage() {}
+
+func (x *DeleteCustomerNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerNoteResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteCustomerNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCustomerProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` // page of purchases
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerProfileRequest) Reset() {
+	*x = GetCustomerProfileRequest{}
+	mi := &file_product_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerProfileRequest) ProtoMessage() {}
+
+func (x *GetCustomerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCustomerProfileRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetCustomerProfileResponse struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Customer            *Customer               `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	LifetimeSpend       float64                 `protobuf:"fixed64,2,opt,name=lifetime_spend,json=lifetimeSpend,proto3" json:"lifetime_spend,omitempty"`
+	OrderCount          int32                   `protobuf:"varint,3,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	PieceCount          int32                   `protobuf:"varint,4,opt,name=piece_count,json=pieceCount,proto3" json:"piece_count,omitempty"`
+	LastVisit           string                  `protobuf:"bytes,5,opt,name=last_visit,json=lastVisit,proto3" json:"last_visit,omitempty"` // empty when the customer never bought
+	FavouriteCategories []*CustomerCategoryStat `protobuf:"bytes,6,rep,name=favourite_categories,json=favouriteCategories,proto3" json:"favourite_categories,omitempty"`
+	Purchases           []*CustomerPurchase     `protobuf:"bytes,7,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	Pagination          *Pagination             `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LoyaltyPoints       int32                   `protobuf:"varint,9,opt,name=loyalty_points,json=loyaltyPoints,proto3" json:"loyalty_points,omitempty"`
+	LoyaltyAvailable    bool                    `protobuf:"varint,10,opt,name=loyalty_available,json=loyaltyAvailable,proto3" json:"loyalty_available,omitempty"` // false when loyalty-service could not be reached
+	UnpricedCount       int32                   `protobuf:"varint,11,opt,name=unpriced_count,json=unpricedCount,proto3" json:"unpriced_count,omitempty"`          // purchases without a recorded price, not in the spend figures
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCustomerProfileResponse) Reset() {
+	*x = GetCustomerProfileResponse{}
+	mi := &file_product_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerProfileResponse) ProtoMessage() {}
+
+func (x *GetCustomerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCustomerProfileResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *GetCustomerProfileResponse) GetLifetimeSpend() float64 {
+	if x != nil {
+		return x.LifetimeSpend
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileResponse) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileResponse) GetPieceCount() int32 {
+	if x != nil {
+		return x.PieceCount
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileResponse) GetLastVisit() string {
+	if x != nil {
+		return x.LastVisit
+	}
+	return ""
+}
+
+func (x *GetCustomerProfileResponse) GetFavouriteCategories() []*CustomerCategoryStat {
+	if x != nil {
+		return x.FavouriteCategories
+	}
+	return nil
+}
+
+func (x *GetCustomerProfileResponse) GetPurchases() []*CustomerPurchase {
+	if x != nil {
+		return x.Purchases
+	}
+	return nil
+}
+
+func (x *GetCustomerProfileResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetCustomerProfileResponse) GetLoyaltyPoints() int32 {
+	if x != nil {
+		return x.LoyaltyPoints
+	}
+	return 0
+}
+
+func (x *GetCustomerProfileResponse) GetLoyaltyAvailable() bool {
+	if x != nil {
+		return x.LoyaltyAvailable
+	}
+	return false
+}
+
+func (x *GetCustomerProfileResponse) GetUnpricedCount() int32 {
+	if x != nil {
+		return x.UnpricedCount
+	}
+	return 0
+}
+
+type ExportCustomerDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCustomerDataRequest) Reset() {
+	*x = ExportCustomerDataRequest{}
+	mi := &file_product_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCustomerDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCustomerDataRequest) ProtoMessage() {}
+
+func (x *ExportCustomerDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCustomerDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportCustomerDataRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportCustomerDataRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type EraseCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseCustomerRequest) Reset() {
+	*x = EraseCustomerRequest{}
+	mi := &file_product_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseCustomerRequest) ProtoMessage() {}
+
+func (x *EraseCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseCustomerRequest.ProtoReflect.Descriptor instead.
+func (*EraseCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EraseCustomerRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPrivacyRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacyRequestRequest) Reset() {
+	*x = GetPrivacyRequestRequest{}
+	mi := &file_product_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacyRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyRequestRequest) ProtoMessage() {}
+
+func (x *GetPrivacyRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacyRequestRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetPrivacyRequestRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PrivacyRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *PrivacyRequest        `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacyRequestResponse) Reset() {
+	*x = PrivacyRequestResponse{}
+	mi := &file_product_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRequestResponse) ProtoMessage() {}
+
+func (x *PrivacyRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRequestResponse.ProtoReflect.Descriptor instead.
+func (*PrivacyRequestResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PrivacyRequestResponse) GetRequest() *PrivacyRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type MergeCustomersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SurvivorId    int32                  `protobuf:"varint,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	DuplicateId   int32                  `protobuf:"varint,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCustomersRequest) Reset() {
+	*x = MergeCustomersRequest{}
+	mi := &file_product_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCustomersRequest) ProtoMessage() {}
+
+func (x *MergeCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCustomersRequest.ProtoReflect.Descriptor instead.
+func (*MergeCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MergeCustomersRequest) GetSurvivorId() int32 {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return 0
+}
+
+func (x *MergeCustomersRequest) GetDuplicateId() int32 {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return 0
+}
+
+type MergeCustomersResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Customer          *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	MovedOrderRecords int64                  `protobuf:"varint,2,opt,name=moved_order_records,json=movedOrderRecords,proto3" json:"moved_order_records,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeCustomersResponse) Reset() {
+	*x = MergeCustomersResponse{}
+	mi := &file_product_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCustomersResponse) ProtoMessage() {}
+
+func (x *MergeCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCustomersResponse.ProtoReflect.Descriptor instead.
+func (*MergeCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MergeCustomersResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *MergeCustomersResponse) GetMovedOrderRecords() int64 {
+	if x != nil {
+		return x.MovedOrderRecords
+	}
+	return 0
+}
+
+type BackfillCustomerKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillCustomerKeysRequest) Reset() {
+	*x = BackfillCustomerKeysRequest{}
+	mi := &file_product_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillCustomerKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillCustomerKeysRequest) ProtoMessage() {}
+
+func (x *BackfillCustomerKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillCustomerKeysRequest.ProtoReflect.Descriptor instead.
+func (*BackfillCustomerKeysRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{51}
+}
+
+type BackfillCustomerKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     int64                  `protobuf:"varint,1,opt,name=customers,proto3" json:"customers,omitempty"` // number of customers published
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackfillCustomerKeysResponse) Reset() {
+	*x = BackfillCustomerKeysResponse{}
+	mi := &file_product_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillCustomerKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillCustomerKeysResponse) ProtoMessage() {}
+
+func (x *BackfillCustomerKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillCustomerKeysResponse.ProtoReflect.Descriptor instead.
+func (*BackfillCustomerKeysResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BackfillCustomerKeysResponse) GetCustomers() int64 {
+	if x != nil {
+		return x.Customers
+	}
+	return 0
+}
+
+type CreateSegmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Rules         *SegmentRules          `protobuf:"bytes,3,opt,name=rules,proto3" json:"rules,omitempty"`
+	VoucherId     int32                  `protobuf:"varint,4,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSegmentRequest) Reset() {
+	*x = CreateSegmentRequest{}
+	mi := &file_product_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSegmentRequest) ProtoMessage() {}
+
+func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateSegmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSegmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateSegmentRequest) GetRules() *SegmentRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CreateSegmentRequest) GetVoucherId() int32 {
+	if x != nil {
+		return x.VoucherId
+	}
+	return 0
+}
+
+type SegmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segment       *Segment               `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentResponse) Reset() {
+	*x = SegmentResponse{}
+	mi := &file_product_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentResponse) ProtoMessage() {}
+
+func (x *SegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentResponse.ProtoReflect.Descriptor instead.
+func (*SegmentResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SegmentResponse) GetSegment() *Segment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+type PreviewSegmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         *SegmentRules          `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // size of the sample of members
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSegmentRequest) Reset() {
+	*x = PreviewSegmentRequest{}
+	mi := &file_product_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSegmentRequest) ProtoMessage() {}
+
+func (x *PreviewSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSegmentRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PreviewSegmentRequest) GetRules() *SegmentRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PreviewSegmentRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PreviewSegmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Members       []*SegmentMember       `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSegmentResponse) Reset() {
+	*x = PreviewSegmentResponse{}
+	mi := &file_product_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSegmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSegmentResponse) ProtoMessage() {}
+
+func (x *PreviewSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSegmentResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PreviewSegmentResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PreviewSegmentResponse) GetMembers() []*SegmentMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListSegmentMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSegmentMembersRequest) Reset() {
+	*x = ListSegmentMembersRequest{}
+	mi := &file_product_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSegmentMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentMembersRequest) ProtoMessage() {}
+
+func (x *ListSegmentMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListSegmentMembersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListSegmentMembersRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListSegmentMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSegmentMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSegmentMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segment       *Segment               `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	Members       []*SegmentMember       `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSegmentMembersResponse) Reset() {
+	*x = ListSegmentMembersResponse{}
+	mi := &file_product_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSegmentMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentMembersResponse) ProtoMessage() {}
+
+func (x *ListSegmentMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListSegmentMembersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListSegmentMembersResponse) GetSegment() *Segment {
+	if x != nil {
+		return x.Segment
+	}
+	return nil
+}
+
+func (x *ListSegmentMembersResponse) GetMembers() []*SegmentMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListSegmentMembersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UploadFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileData      []byte                 `protobuf:"bytes,1,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // ignored, the type is detected from the file content
+	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	ProductId     int32                  `protobuf:"varint,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // attach the image to the product
+	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"` // the first image of a product is always primary
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	mi := &file_product_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest) ProtoMessage() {}
+
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UploadFileRequest) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadFileRequest) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *UploadFileRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UploadFileRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type UploadFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,5,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"` // large jpeg rendition
+	ImageId       int32                  `protobuf:"varint,6,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Renditions    []*ImageRendition      `protobuf:"bytes,7,rep,name=renditions,proto3" json:"renditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileResponse) Reset() {
+	*x = UploadFileResponse{}
+	mi := &file_product_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileResponse) ProtoMessage() {}
+
+func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileResponse.ProtoReflect.Descriptor instead.
+func (*UploadFileResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UploadFileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *UploadFileResponse) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *UploadFileResponse) GetImageId() int32 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *UploadFileResponse) GetRenditions() []*ImageRendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+type ListProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesRequest) Reset() {
+	*x = ListProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesRequest) ProtoMessage() {}
+
+func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListProductImagesRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+// SetProductImagesRequest orders the images of the product, images left out
+// are detached from it
+type SetProductImagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds       []int32                `protobuf:"varint,2,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	PrimaryImageId int32                  `protobuf:"varint,3,opt,name=primary_image_id,json=primaryImageId,proto3" json:"primary_image_id,omitempty"` // defaults to the first image
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetProductImagesRequest) Reset() {
+	*x = SetProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductImagesRequest) ProtoMessage() {}
+
+func (x *SetProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*SetProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetProductImagesRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductImagesRequest) GetImageIds() []int32 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+func (x *SetProductImagesRequest) GetPrimaryImageId() int32 {
+	if x != nil {
+		return x.PrimaryImageId
+	}
+	return 0
+}
+
+type ProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImagesResponse) Reset() {
+	*x = ProductImagesResponse{}
+	mi := &file_product_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImagesResponse) ProtoMessage() {}
+
+func (x *ProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type PurchaseProductRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	CustomerId    string                            `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // customer uuid or phone
+	Products      []*PurchaseProductRequest_Product `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	OrderId       int32                             `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseProductRequest) Reset() {
+	*x = PurchaseProductRequest{}
+	mi := &file_product_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseProductRequest) ProtoMessage() {}
+
+func (x *PurchaseProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseProductRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PurchaseProductRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *PurchaseProductRequest) GetProducts() []*PurchaseProductRequest_Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *PurchaseProductRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type PurchaseProductRequest_Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,3,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"` // required for serialized products, one per piece
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseProductRequest_Product) Reset() {
+	*x = PurchaseProductRequest_Product{}
+	mi := &file_product_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseProductRequest_Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseProductRequest_Product) ProtoMessage() {}
+
+func (x *PurchaseProductRequest_Product) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseProductRequest_Product.ProtoReflect.Descriptor instead.
+func (*PurchaseProductRequest_Product) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PurchaseProductRequest_Product) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PurchaseProductRequest_Product) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseProductRequest_Product) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type PurchaseProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Customer      *Customer              `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseProductResponse) Reset() {
+	*x = PurchaseProductResponse{}
+	mi := &file_product_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseProductResponse) ProtoMessage() {}
+
+func (x *PurchaseProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseProductResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PurchaseProductResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *PurchaseProductResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type RegisterProductSerialsRequest struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	ProductId     int32                                   `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Serials       []*RegisterProductSerialsRequest_Serial `protobuf:"bytes,2,rep,name=serials,proto3" json:"serials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterProductSerialsRequest) Reset() {
+	*x = RegisterProductSerialsRequest{}
+	mi := &file_product_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterProductSerialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterProductSerialsRequest) ProtoMessage() {}
+
+func (x *RegisterProductSerialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterProductSerialsRequest.ProtoReflect.Descriptor instead.
+func (*RegisterProductSerialsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RegisterProductSerialsRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RegisterProductSerialsRequest) GetSerials() []*RegisterProductSerialsRequest_Serial {
+	if x != nil {
+		return x.Serials
+	}
+	return nil
+}
+
+type RegisterProductSerialsRequest_Serial struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SerialNumber      string                 `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Weight            float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	StoneDetails      string                 `protobuf:"bytes,3,opt,name=stone_details,json=stoneDetails,proto3" json:"stone_details,omitempty"`
+	CertificateNumber string                 `protobuf:"bytes,4,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RegisterProductSerialsRequest_Serial) Reset() {
+	*x = RegisterProductSerialsRequest_Serial{}
+	mi := &file_product_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterProductSerialsRequest_Serial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterProductSerialsRequest_Serial) ProtoMessage() {}
+
+func (x *RegisterProductSerialsRequest_Serial) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterProductSerialsRequest_Serial.ProtoReflect.Descriptor instead.
+func (*RegisterProductSerialsRequest_Serial) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RegisterProductSerialsRequest_Serial) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *RegisterProductSerialsRequest_Serial) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RegisterProductSerialsRequest_Serial) GetStoneDetails() string {
+	if x != nil {
+		return x.StoneDetails
+	}
+	return ""
+}
+
+func (x *RegisterProductSerialsRequest_Serial) GetCertificateNumber() string {
+	if x != nil {
+		return x.CertificateNumber
+	}
+	return ""
+}
+
+type ListProductSerialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductSerialsRequest) Reset() {
+	*x = ListProductSerialsRequest{}
+	mi := &file_product_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductSerialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductSerialsRequest) ProtoMessage() {}
+
+func (x *ListProductSerialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductSerialsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductSerialsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListProductSerialsRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ProductSerialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serials       []*ProductSerial       `protobuf:"bytes,1,rep,name=serials,proto3" json:"serials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSerialsResponse) Reset() {
+	*x = ProductSerialsResponse{}
+	mi := &file_product_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSerialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSerialsResponse) ProtoMessage() {}
+
+func (x *ProductSerialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSerialsResponse.ProtoReflect.Descriptor instead.
+func (*ProductSerialsResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ProductSerialsResponse) GetSerials() []*ProductSerial {
+	if x != nil {
+		return x.Serials
+	}
+	return nil
+}
+
+type GetSerialHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SerialNumber  string                 `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSerialHistoryRequest) Reset() {
+	*x = GetSerialHistoryRequest{}
+	mi := &file_product_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSerialHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSerialHistoryRequest) ProtoMessage() {}
+
+func (x *GetSerialHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSerialHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSerialHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSerialHistoryRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type GetSerialHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Serial  *ProductSerial         `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Product *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Events  []*ProductSerialEvent  `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// sale the piece went out with, empty while in stock
+	OrderId       int32  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSerialHistoryResponse) Reset() {
+	*x = GetSerialHistoryResponse{}
+	mi := &file_product_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSerialHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSerialHistoryResponse) ProtoMessage() {}
+
+func (x *GetSerialHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSerialHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSerialHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetSerialHistoryResponse) GetSerial() *ProductSerial {
+	if x != nil {
+		return x.Serial
+	}
+	return nil
+}
+
+func (x *GetSerialHistoryResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetSerialHistoryResponse) GetEvents() []*ProductSerialEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetSerialHistoryResponse) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *GetSerialHistoryResponse) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type OpenStocktakeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0 = every category
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenStocktakeSessionRequest) Reset() {
+	*x = OpenStocktakeSessionRequest{}
+	mi := &file_product_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenStocktakeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenStocktakeSessionRequest) ProtoMessage() {}
+
+func (x *OpenStocktakeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenStocktakeSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenStocktakeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *OpenStocktakeSessionRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *OpenStocktakeSessionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type GetStocktakeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStocktakeSessionRequest) Reset() {
+	*x = GetStocktakeSessionRequest{}
+	mi := &file_product_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStocktakeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStocktakeSessionRequest) ProtoMessage() {}
+
+func (x *GetStocktakeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStocktakeSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetStocktakeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetStocktakeSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type StocktakeScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 0 is treated as a single scanned piece
+	SerialNumber  string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"` // alternative to code, always counts one piece
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StocktakeScan) Reset() {
+	*x = StocktakeScan{}
+	mi := &file_product_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakeScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeScan) ProtoMessage() {}
+
+func (x *StocktakeScan) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeScan.ProtoReflect.Descriptor instead.
+func (*StocktakeScan) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *StocktakeScan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StocktakeScan) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StocktakeScan) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type SubmitStocktakeCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Scans         []*StocktakeScan       `protobuf:"bytes,2,rep,name=scans,proto3" json:"scans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitStocktakeCountsRequest) Reset() {
+	*x = SubmitStocktakeCountsRequest{}
+	mi := &file_product_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitStocktakeCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStocktakeCountsRequest) ProtoMessage() {}
+
+func (x *SubmitStocktakeCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStocktakeCountsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStocktakeCountsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SubmitStocktakeCountsRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *SubmitStocktakeCountsRequest) GetScans() []*StocktakeScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+type SubmitStocktakeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitStocktakeSessionRequest) Reset() {
+	*x = SubmitStocktakeSessionRequest{}
+	mi := &file_product_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitStocktakeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitStocktakeSessionRequest) ProtoMessage() {}
+
+func (x *SubmitStocktakeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitStocktakeSessionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStocktakeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SubmitStocktakeSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type ApproveStocktakeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveStocktakeSessionRequest) Reset() {
+	*x = ApproveStocktakeSessionRequest{}
+	mi := &file_product_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveStocktakeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveStocktakeSessionRequest) ProtoMessage() {}
+
+func (x *ApproveStocktakeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveStocktakeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveStocktakeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ApproveStocktakeSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RejectStocktakeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int32                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectStocktakeSessionRequest) Reset() {
+	*x = RejectStocktakeSessionRequest{}
+	mi := &file_product_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectStocktakeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectStocktakeSessionRequest) ProtoMessage() {}
+
+func (x *RejectStocktakeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectStocktakeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RejectStocktakeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RejectStocktakeSessionRequest) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type StocktakeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *StocktakeSession      `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Lines         []*StocktakeLine       `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StocktakeSessionResponse) Reset() {
+	*x = StocktakeSessionResponse{}
+	mi := &file_product_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakeSessionResponse) ProtoMessage() {}
+
+func (x *StocktakeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakeSessionResponse.ProtoReflect.Descriptor instead.
+func (*StocktakeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StocktakeSessionResponse) GetSession() *StocktakeSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
 }
 
-// Deprecated: Use ListProductCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListProductCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{10}
+func (x *StocktakeSessionResponse) GetLines() []*StocktakeLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
 }
 
-type ListProductCategoriesResponse struct {
+type ListLowStockProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*ProductCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductCategoriesResponse) Reset() {
-	*x = ListProductCategoriesResponse{}
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListLowStockProductsRequest) Reset() {
+	*x = ListLowStockProductsRequest{}
+	mi := &file_product_product_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductCategoriesResponse) String() string {
+func (x *ListLowStockProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductCategoriesResponse) ProtoMessage() {}
+func (*ListLowStockProductsRequest) ProtoMessage() {}
 
-func (x *ListProductCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListLowStockProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,43 +5023,59 @@ func (x *ListProductCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListProductCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListLowStockProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListProductCategoriesResponse) GetCategories() []*ProductCategory {
+func (x *ListLowStockProductsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Categories
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type CreateCustomerRequest struct {
+func (x *ListLowStockProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLowStockProductsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type CreateSupplierRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	TaxCode       string                 `protobuf:"bytes,5,opt,name=tax_code,json=taxCode,proto3" json:"tax_code,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomerRequest) Reset() {
-	*x = CreateCustomerRequest{}
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomerRequest) String() string {
+func (x *CreateSupplierRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerRequest) ProtoMessage() {}
+func (*CreateSupplierRequest) ProtoMessage() {}
 
-func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,61 +5086,82 @@ func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CreateCustomerRequest) GetName() string {
+func (x *CreateSupplierRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetPhone() string {
+func (x *CreateSupplierRequest) GetPhone() string {
 	if x != nil {
 		return x.Phone
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetEmail() string {
+func (x *CreateSupplierRequest) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateCustomerRequest) GetAddress() string {
+func (x *CreateSupplierRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type GetCustomerRequest struct {
+func (x *CreateSupplierRequest) GetTaxCode() string {
+	if x != nil {
+		return x.TaxCode
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type UpdateSupplierRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	TaxCode       string                 `protobuf:"bytes,6,opt,name=tax_code,json=taxCode,proto3" json:"tax_code,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Inactive      bool                   `protobuf:"varint,8,opt,name=inactive,proto3" json:"inactive,omitempty"` // hide the supplier from new purchase orders
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerRequest) Reset() {
-	*x = GetCustomerRequest{}
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *UpdateSupplierRequest) Reset() {
+	*x = UpdateSupplierRequest{}
+	mi := &file_product_product_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerRequest) String() string {
+func (x *UpdateSupplierRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerRequest) ProtoMessage() {}
+func (*UpdateSupplierRequest) ProtoMessage() {}
 
-func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,92 +5172,89 @@ func (x *GetCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetCustomerRequest) GetPhone() string {
+func (x *UpdateSupplierRequest) GetId() int32 {
 	if x != nil {
-		return x.Phone
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type ListCustomersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateSupplierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListCustomersRequest) Reset() {
-	*x = ListCustomersRequest{}
-	mi := &file_product_product_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateSupplierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
 }
 
-func (x *ListCustomersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateSupplierRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (*ListCustomersRequest) ProtoMessage() {}
-
-func (x *ListCustomersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *UpdateSupplierRequest) GetAddress() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Address
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListCustomersRequest.ProtoReflect.Descriptor instead.
-func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{14}
+func (x *UpdateSupplierRequest) GetTaxCode() string {
+	if x != nil {
+		return x.TaxCode
+	}
+	return ""
 }
 
-func (x *ListCustomersRequest) GetPage() int32 {
+func (x *UpdateSupplierRequest) GetNote() string {
 	if x != nil {
-		return x.Page
+		return x.Note
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCustomersRequest) GetLimit() int32 {
+func (x *UpdateSupplierRequest) GetInactive() bool {
 	if x != nil {
-		return x.Limit
+		return x.Inactive
 	}
-	return 0
+	return false
 }
 
-type ListCustomersResponse struct {
+type SupplierResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customers     []*Customer            `protobuf:"bytes,1,rep,name=customers,proto3" json:"customers,omitempty"`
+	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCustomersResponse) Reset() {
-	*x = ListCustomersResponse{}
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *SupplierResponse) Reset() {
+	*x = SupplierResponse{}
+	mi := &file_product_product_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCustomersResponse) String() string {
+func (x *SupplierResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCustomersResponse) ProtoMessage() {}
+func (*SupplierResponse) ProtoMessage() {}
 
-func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *SupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,44 +5265,42 @@ func (x *ListCustomersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCustomersResponse.ProtoReflect.Descriptor instead.
-func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SupplierResponse.ProtoReflect.Descriptor instead.
+func (*SupplierResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ListCustomersResponse) GetCustomers() []*Customer {
+func (x *SupplierResponse) GetSupplier() *Supplier {
 	if x != nil {
-		return x.Customers
+		return x.Supplier
 	}
 	return nil
 }
 
-type UpdateCustomerRequest struct {
+type ListSuppliersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerRequest) Reset() {
-	*x = UpdateCustomerRequest{}
-	mi := &file_product_product_proto_msgTypes[16]
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_product_product_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerRequest) String() string {
+func (x *ListSuppliersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerRequest) ProtoMessage() {}
+func (*ListSuppliersRequest) ProtoMessage() {}
 
-func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[16]
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,68 +5311,109 @@ func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdateCustomerRequest) GetId() int32 {
+func (x *ListSuppliersRequest) GetPage() int32 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-func (x *UpdateCustomerRequest) GetName() string {
+func (x *ListSuppliersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCustomerRequest) GetPhone() string {
+func (x *ListSuppliersRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.Phone
+		return x.ActiveOnly
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateCustomerRequest) GetEmail() string {
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_product_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[86]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateCustomerRequest) GetAddress() string {
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
 	if x != nil {
-		return x.Address
+		return x.Suppliers
 	}
-	return ""
+	return nil
 }
 
-type DeleteCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ListSuppliersResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreatePurchaseOrderRequest struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	SupplierId    int32                              `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Note          string                             `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	ExpectedAt    string                             `protobuf:"bytes,3,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"` // RFC3339
+	Lines         []*CreatePurchaseOrderRequest_Line `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomerRequest) Reset() {
-	*x = DeleteCustomerRequest{}
-	mi := &file_product_product_proto_msgTypes[17]
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_product_product_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerRequest) String() string {
+func (x *CreatePurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerRequest) ProtoMessage() {}
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
 
-func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[17]
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,40 +5424,64 @@ func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DeleteCustomerRequest) GetId() int32 {
+func (x *CreatePurchaseOrderRequest) GetSupplierId() int32 {
 	if x != nil {
-		return x.Id
+		return x.SupplierId
 	}
 	return 0
 }
 
-type DeleteCustomerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreatePurchaseOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
-func (x *DeleteCustomerResponse) Reset() {
-	*x = DeleteCustomerResponse{}
-	mi := &file_product_product_proto_msgTypes[18]
+func (x *CreatePurchaseOrderRequest) GetExpectedAt() string {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*CreatePurchaseOrderRequest_Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type CreatePurchaseOrderRequest_Line struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	GoldCostPerGram float64                `protobuf:"fixed64,3,opt,name=gold_cost_per_gram,json=goldCostPerGram,proto3" json:"gold_cost_per_gram,omitempty"`
+	LaborCost       float64                `protobuf:"fixed64,4,opt,name=labor_cost,json=laborCost,proto3" json:"labor_cost,omitempty"` // per piece
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest_Line) Reset() {
+	*x = CreatePurchaseOrderRequest_Line{}
+	mi := &file_product_product_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerResponse) String() string {
+func (x *CreatePurchaseOrderRequest_Line) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerResponse) ProtoMessage() {}
+func (*CreatePurchaseOrderRequest_Line) ProtoMessage() {}
 
-func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[18]
+func (x *CreatePurchaseOrderRequest_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,40 +5492,61 @@ func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreatePurchaseOrderRequest_Line.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest_Line) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DeleteCustomerResponse) GetSuccess() bool {
+func (x *CreatePurchaseOrderRequest_Line) GetProductId() int32 {
 	if x != nil {
-		return x.Success
+		return x.ProductId
 	}
-	return false
+	return 0
 }
 
-type CustomerResponse struct {
+func (x *CreatePurchaseOrderRequest_Line) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest_Line) GetGoldCostPerGram() float64 {
+	if x != nil {
+		return x.GoldCostPerGram
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest_Line) GetLaborCost() float64 {
+	if x != nil {
+		return x.LaborCost
+	}
+	return 0
+}
+
+type GetPurchaseOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CustomerResponse) Reset() {
-	*x = CustomerResponse{}
-	mi := &file_product_product_proto_msgTypes[19]
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_product_product_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CustomerResponse) String() string {
+func (x *GetPurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CustomerResponse) ProtoMessage() {}
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
 
-func (x *CustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[19]
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,43 +5557,43 @@ func (x *CustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CustomerResponse.ProtoReflect.Descriptor instead.
-func (*CustomerResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CustomerResponse) GetCustomer() *Customer {
+func (x *GetPurchaseOrderRequest) GetId() int32 {
 	if x != nil {
-		return x.Customer
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UploadFileRequest struct {
+type ListPurchaseOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileData      []byte                 `protobuf:"bytes,1,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	SupplierId    int32                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFileRequest) Reset() {
-	*x = UploadFileRequest{}
-	mi := &file_product_product_proto_msgTypes[20]
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_product_product_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFileRequest) String() string {
+func (x *ListPurchaseOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileRequest) ProtoMessage() {}
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
 
-func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[20]
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,65 +5604,62 @@ func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
-func (*UploadFileRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UploadFileRequest) GetFileData() []byte {
+func (x *ListPurchaseOrdersRequest) GetPage() int32 {
 	if x != nil {
-		return x.FileData
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *UploadFileRequest) GetFilename() string {
+func (x *ListPurchaseOrdersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Filename
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadFileRequest) GetContentType() string {
+func (x *ListPurchaseOrdersRequest) GetSupplierId() int32 {
 	if x != nil {
-		return x.ContentType
+		return x.SupplierId
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadFileRequest) GetFileSize() int64 {
+func (x *ListPurchaseOrdersRequest) GetStatus() string {
 	if x != nil {
-		return x.FileSize
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type UploadFileResponse struct {
+type ListPurchaseOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	FileUrl       string                 `protobuf:"bytes,5,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	Orders        []*PurchaseOrder       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFileResponse) Reset() {
-	*x = UploadFileResponse{}
-	mi := &file_product_product_proto_msgTypes[21]
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_product_product_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFileResponse) String() string {
+func (x *ListPurchaseOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileResponse) ProtoMessage() {}
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
 
-func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[21]
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,70 +5670,94 @@ func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileResponse.ProtoReflect.Descriptor instead.
-func (*UploadFileResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *UploadFileResponse) GetMessage() string {
+func (x *ListPurchaseOrdersResponse) GetOrders() []*PurchaseOrder {
 	if x != nil {
-		return x.Message
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadFileResponse) GetFileId() string {
+func (x *ListPurchaseOrdersResponse) GetPagination() *Pagination {
 	if x != nil {
-		return x.FileId
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadFileResponse) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
+type CancelPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFileResponse) GetFileSize() int64 {
+func (x *CancelPurchaseOrderRequest) Reset() {
+	*x = CancelPurchaseOrderRequest{}
+	mi := &file_product_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CancelPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[92]
 	if x != nil {
-		return x.FileSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UploadFileResponse) GetFileUrl() string {
+// Deprecated: Use CancelPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CancelPurchaseOrderRequest) GetId() int32 {
 	if x != nil {
-		return x.FileUrl
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type PurchaseProductRequest struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	CustomerId    string                            `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Products      []*PurchaseProductRequest_Product `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
-	OrderId       int32                             `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReceiveGoodsRequest struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	PurchaseOrderId int32                       `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	ExtraCost       float64                     `protobuf:"fixed64,2,opt,name=extra_cost,json=extraCost,proto3" json:"extra_cost,omitempty"` // spread over the received pieces
+	Note            string                      `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Lines           []*ReceiveGoodsRequest_Line `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PurchaseProductRequest) Reset() {
-	*x = PurchaseProductRequest{}
-	mi := &file_product_product_proto_msgTypes[22]
+func (x *ReceiveGoodsRequest) Reset() {
+	*x = ReceiveGoodsRequest{}
+	mi := &file_product_product_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseProductRequest) String() string {
+func (x *ReceiveGoodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseProductRequest) ProtoMessage() {}
+func (*ReceiveGoodsRequest) ProtoMessage() {}
 
-func (x *PurchaseProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[22]
+func (x *ReceiveGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,55 +5768,62 @@ func (x *PurchaseProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseProductRequest.ProtoReflect.Descriptor instead.
-func (*PurchaseProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReceiveGoodsRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *PurchaseProductRequest) GetCustomerId() string {
+func (x *ReceiveGoodsRequest) GetPurchaseOrderId() int32 {
 	if x != nil {
-		return x.CustomerId
+		return x.PurchaseOrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *PurchaseProductRequest) GetProducts() []*PurchaseProductRequest_Product {
+func (x *ReceiveGoodsRequest) GetExtraCost() float64 {
 	if x != nil {
-		return x.Products
+		return x.ExtraCost
 	}
-	return nil
+	return 0
 }
 
-func (x *PurchaseProductRequest) GetOrderId() int32 {
+func (x *ReceiveGoodsRequest) GetNote() string {
 	if x != nil {
-		return x.OrderId
+		return x.Note
 	}
-	return 0
+	return ""
 }
 
-type PurchaseProductRequest_Product struct {
+func (x *ReceiveGoodsRequest) GetLines() []*ReceiveGoodsRequest_Line {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReceiveGoodsRequest_Line struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LineId        int32                  `protobuf:"varint,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"` // purchase order line
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseProductRequest_Product) Reset() {
-	*x = PurchaseProductRequest_Product{}
-	mi := &file_product_product_proto_msgTypes[23]
+func (x *ReceiveGoodsRequest_Line) Reset() {
+	*x = ReceiveGoodsRequest_Line{}
+	mi := &file_product_product_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseProductRequest_Product) String() string {
+func (x *ReceiveGoodsRequest_Line) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseProductRequest_Product) ProtoMessage() {}
+func (*ReceiveGoodsRequest_Line) ProtoMessage() {}
 
-func (x *PurchaseProductRequest_Product) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[23]
+func (x *ReceiveGoodsRequest_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,48 +5834,47 @@ func (x *PurchaseProductRequest_Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseProductRequest_Product.ProtoReflect.Descriptor instead.
-func (*PurchaseProductRequest_Product) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReceiveGoodsRequest_Line.ProtoReflect.Descriptor instead.
+func (*ReceiveGoodsRequest_Line) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *PurchaseProductRequest_Product) GetProductId() int32 {
+func (x *ReceiveGoodsRequest_Line) GetLineId() int32 {
 	if x != nil {
-		return x.ProductId
+		return x.LineId
 	}
 	return 0
 }
 
-func (x *PurchaseProductRequest_Product) GetQuantity() int32 {
+func (x *ReceiveGoodsRequest_Line) GetQuantity() int32 {
 	if x != nil {
 		return x.Quantity
 	}
 	return 0
 }
 
-type PurchaseProductResponse struct {
+type PurchaseOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Customer      *Customer              `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Order         *PurchaseOrder         `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseProductResponse) Reset() {
-	*x = PurchaseProductResponse{}
-	mi := &file_product_product_proto_msgTypes[24]
+func (x *PurchaseOrderResponse) Reset() {
+	*x = PurchaseOrderResponse{}
+	mi := &file_product_product_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseProductResponse) String() string {
+func (x *PurchaseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseProductResponse) ProtoMessage() {}
+func (*PurchaseOrderResponse) ProtoMessage() {}
 
-func (x *PurchaseProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[24]
+func (x *PurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,21 +5885,14 @@ func (x *PurchaseProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseProductResponse.ProtoReflect.Descriptor instead.
-func (*PurchaseProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *PurchaseProductResponse) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+// Deprecated: Use PurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *PurchaseProductResponse) GetCustomer() *Customer {
+func (x *PurchaseOrderResponse) GetOrder() *PurchaseOrder {
 	if x != nil {
-		return x.Customer
+		return x.Order
 	}
 	return nil
 }
@@ -1458,7 +5905,7 @@ const file_product_product_proto_rawDesc = "" +
 	"\fDummyRequest\x12\x14\n" +
 	"\x05dummy\x18\x01 \x01(\x05R\x05dummy\"%\n" +
 	"\rDummyResponse\x12\x14\n" +
-	"\x05dummy\x18\x01 \x01(\x05R\x05dummy\"\xc8\x02\n" +
+	"\x05dummy\x18\x01 \x01(\x05R\x05dummy\"\x93\x04\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1f\n" +
@@ -1475,14 +5922,52 @@ const file_product_product_proto_rawDesc = "" +
 	"\x05image\x18\t \x01(\tR\x05image\x12\x1b\n" +
 	"\tgold_type\x18\n" +
 	" \x01(\x05R\bgoldType\x12\x14\n" +
-	"\x05stock\x18\v \x01(\x05R\x05stock\"#\n" +
+	"\x05stock\x18\v \x01(\x05R\x05stock\x12-\n" +
+	"\x06stones\x18\f \x03(\v2\x15.product.ProductStoneR\x06stones\x12(\n" +
+	"\rreorder_point\x18\r \x01(\x05H\x00R\freorderPoint\x88\x01\x01\x12.\n" +
+	"\x10reorder_quantity\x18\x0e \x01(\x05H\x01R\x0freorderQuantity\x88\x01\x01\x12\x19\n" +
+	"\balloy_id\x18\x0f \x01(\x05R\aalloyIdB\x10\n" +
+	"\x0e_reorder_pointB\x13\n" +
+	"\x11_reorder_quantity\"\x90\x01\n" +
+	"\x1bCreateProductVariantRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x05R\bparentId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\tR\x04size\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\x05R\x05stock\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xdd\x04\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"D\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"stone_type\x18\x03 \x01(\tR\tstoneType\x12\x1b\n" +
+	"\tmin_carat\x18\x04 \x01(\x01R\bminCarat\x12\x1b\n" +
+	"\tmax_carat\x18\x05 \x01(\x01R\bmaxCarat\x12\x1f\n" +
+	"\vstone_color\x18\x06 \x01(\tR\n" +
+	"stoneColor\x12#\n" +
+	"\rstone_clarity\x18\a \x01(\tR\fstoneClarity\x12'\n" +
+	"\x0fcertificate_lab\x18\b \x01(\tR\x0ecertificateLab\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\x05R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tgold_type\x18\n" +
+	" \x01(\x05R\bgoldType\x12\x1b\n" +
+	"\tmin_price\x18\v \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\f \x01(\x01R\bmaxPrice\x12\x1d\n" +
+	"\n" +
+	"min_weight\x18\r \x01(\x01R\tminWeight\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x0e \x01(\x01R\tmaxWeight\x12\x19\n" +
+	"\bin_stock\x18\x0f \x01(\bR\ainStock\x12\x16\n" +
+	"\x06search\x18\x10 \x01(\tR\x06search\x12(\n" +
+	"\x04sort\x18\x11 \x01(\x0e2\x14.product.ProductSortR\x04sort\x12\x16\n" +
+	"\x06status\x18\x12 \x01(\tR\x06status\x12)\n" +
+	"\x10include_archived\x18\x13 \x01(\bR\x0fincludeArchived\"y\n" +
 	"\x14ListProductsResponse\x12,\n" +
-	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\"\x8d\x03\n" +
+	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\"\xd9\x05\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1501,30 +5986,104 @@ const file_product_product_proto_rawDesc = "" +
 	" \x01(\x01R\fsellingPrice\x12'\n" +
 	"\x0fwarranty_period\x18\v \x01(\x05R\x0ewarrantyPeriod\x12\x14\n" +
 	"\x05image\x18\f \x01(\tR\x05image\x12\x14\n" +
-	"\x05stock\x18\r \x01(\x05R\x05stock\"&\n" +
+	"\x05stock\x18\r \x01(\x05R\x05stock\x12-\n" +
+	"\x06stones\x18\x0e \x03(\v2\x15.product.ProductStoneR\x06stones\x12%\n" +
+	"\x0ereplace_stones\x18\x0f \x01(\bR\rreplaceStones\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\tR\x06status\x12(\n" +
+	"\rreorder_point\x18\x11 \x01(\x05H\x00R\freorderPoint\x88\x01\x01\x12.\n" +
+	"\x10reorder_quantity\x18\x12 \x01(\x05H\x01R\x0freorderQuantity\x88\x01\x01\x12.\n" +
+	"\x13clear_reorder_point\x18\x13 \x01(\bR\x11clearReorderPoint\x12\x1e\n" +
+	"\balloy_id\x18\x14 \x01(\x05H\x02R\aalloyId\x88\x01\x01B\x10\n" +
+	"\x0e_reorder_pointB\x13\n" +
+	"\x11_reorder_quantityB\v\n" +
+	"\t_alloy_id\":\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04hard\x18\x02 \x01(\bR\x04hard\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"b\n" +
+	"\x15ImportProductsRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\xcb\x01\n" +
+	"\x16ImportProductsResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12/\n" +
+	"\x06errors\x18\x04 \x03(\v2\x17.product.ImportRowErrorR\x06errors\x12,\n" +
+	"\bproducts\x18\x05 \x03(\v2\x10.product.ProductR\bproducts\"P\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"e\n" +
+	"\x15ExportProductsRequest\x12+\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x13.product.FileFormatR\x06format\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\"u\n" +
+	"\x16ExportProductsResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_data\x18\x02 \x01(\fR\bfileData\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\x9f\x01\n" +
+	"\x15GenerateLabelsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\x05R\n" +
+	"productIds\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12,\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x14.product.LabelFormatR\x06format\x12\x16\n" +
+	"\x06copies\x18\x04 \x01(\x05R\x06copies\"u\n" +
+	"\x16GenerateLabelsResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_data\x18\x02 \x01(\fR\bfileData\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"=\n" +
 	"\x0fProductResponse\x12*\n" +
 	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"\x1e\n" +
 	"\x1cListProductCategoriesRequest\"Y\n" +
 	"\x1dListProductCategoriesResponse\x128\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x18.product.ProductCategoryR\n" +
-	"categories\"q\n" +
+	"categories\"\xb7\x01\n" +
+	"\x1cCreateProductCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x05R\bparentId\x12.\n" +
+	"\x13default_markup_rate\x18\x03 \x01(\x01R\x11defaultMarkupRate\x126\n" +
+	"\x17default_warranty_period\x18\x04 \x01(\x05R\x15defaultWarrantyPeriod\"+\n" +
+	"\x19GetProductCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc7\x01\n" +
+	"\x1cUpdateProductCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x05R\bparentId\x12.\n" +
+	"\x13default_markup_rate\x18\x04 \x01(\x01R\x11defaultMarkupRate\x126\n" +
+	"\x17default_warranty_period\x18\x05 \x01(\x05R\x15defaultWarrantyPeriod\".\n" +
+	"\x1cDeleteProductCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"9\n" +
+	"\x1dDeleteProductCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x17ProductCategoryResponse\x124\n" +
+	"\bcategory\x18\x01 \x01(\v2\x18.product.ProductCategoryR\bcategory\"q\n" +
 	"\x15CreateCustomerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12\x18\n" +
 	"\aaddress\x18\x04 \x01(\tR\aaddress\"*\n" +
 	"\x12GetCustomerRequest\x12\x14\n" +
-	"\x05phone\x18\x01 \x01(\tR\x05phone\"@\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"\xe0\x01\n" +
 	"\x14ListCustomersRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12.\n" +
+	"\x13preferred_gold_type\x18\x05 \x01(\x05R\x11preferredGoldType\x12\x1b\n" +
+	"\tring_size\x18\x06 \x01(\tR\bringSize\x12%\n" +
+	"\x0eoccasion_month\x18\a \x01(\x05R\roccasionMonth\"}\n" +
 	"\x15ListCustomersResponse\x12/\n" +
-	"\tcustomers\x18\x01 \x03(\v2\x11.product.CustomerR\tcustomers\"\x81\x01\n" +
+	"\tcustomers\x18\x01 \x03(\v2\x11.product.CustomerR\tcustomers\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\"\x81\x01\n" +
 	"\x15UpdateCustomerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1536,30 +6095,280 @@ const file_product_product_proto_rawDesc = "" +
 	"\x16DeleteCustomerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
 	"\x10CustomerResponse\x12-\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x11.product.CustomerR\bcustomer\"\x8c\x01\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x11.product.CustomerR\bcustomer\"\xbc\x01\n" +
+	"\x1fUpdateCustomerAttributesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bbirthday\x18\x02 \x01(\tR\bbirthday\x12 \n" +
+	"\vanniversary\x18\x03 \x01(\tR\vanniversary\x12\x1b\n" +
+	"\tring_size\x18\x04 \x01(\tR\bringSize\x12.\n" +
+	"\x13preferred_gold_type\x18\x05 \x01(\x05R\x11preferredGoldType\"<\n" +
+	"\x16SetCustomerTagsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"M\n" +
+	"\x16AddCustomerNoteRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x05R\n" +
+	"customerId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"A\n" +
+	"\x14CustomerNoteResponse\x12)\n" +
+	"\x04note\x18\x01 \x01(\v2\x15.product.CustomerNoteR\x04note\"e\n" +
+	"\x18ListCustomerNotesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x05R\n" +
+	"customerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"}\n" +
+	"\x19ListCustomerNotesResponse\x12+\n" +
+	"\x05notes\x18\x01 \x03(\v2\x15.product.CustomerNoteR\x05notes\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\"L\n" +
+	"\x19DeleteCustomerNoteRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x05R\n" +
+	"customerId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"6\n" +
+	"\x1aDeleteCustomerNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x19GetCustomerProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x8e\x04\n" +
+	"\x1aGetCustomerProfileResponse\x12-\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x11.product.CustomerR\bcustomer\x12%\n" +
+	"\x0elifetime_spend\x18\x02 \x01(\x01R\rlifetimeSpend\x12\x1f\n" +
+	"\vorder_count\x18\x03 \x01(\x05R\n" +
+	"orderCount\x12\x1f\n" +
+	"\vpiece_count\x18\x04 \x01(\x05R\n" +
+	"pieceCount\x12\x1d\n" +
+	"\n" +
+	"last_visit\x18\x05 \x01(\tR\tlastVisit\x12P\n" +
+	"\x14favourite_categories\x18\x06 \x03(\v2\x1d.product.CustomerCategoryStatR\x13favouriteCategories\x127\n" +
+	"\tpurchases\x18\a \x03(\v2\x19.product.CustomerPurchaseR\tpurchases\x123\n" +
+	"\n" +
+	"pagination\x18\b \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\x12%\n" +
+	"\x0eloyalty_points\x18\t \x01(\x05R\rloyaltyPoints\x12+\n" +
+	"\x11loyalty_available\x18\n" +
+	" \x01(\bR\x10loyaltyAvailable\x12%\n" +
+	"\x0eunpriced_count\x18\v \x01(\x05R\runpricedCount\"+\n" +
+	"\x19ExportCustomerDataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"&\n" +
+	"\x14EraseCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"*\n" +
+	"\x18GetPrivacyRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"K\n" +
+	"\x16PrivacyRequestResponse\x121\n" +
+	"\arequest\x18\x01 \x01(\v2\x17.product.PrivacyRequestR\arequest\"[\n" +
+	"\x15MergeCustomersRequest\x12\x1f\n" +
+	"\vsurvivor_id\x18\x01 \x01(\x05R\n" +
+	"survivorId\x12!\n" +
+	"\fduplicate_id\x18\x02 \x01(\x05R\vduplicateId\"w\n" +
+	"\x16MergeCustomersResponse\x12-\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x11.product.CustomerR\bcustomer\x12.\n" +
+	"\x13moved_order_records\x18\x02 \x01(\x03R\x11movedOrderRecords\"\x1d\n" +
+	"\x1bBackfillCustomerKeysRequest\"<\n" +
+	"\x1cBackfillCustomerKeysResponse\x12\x1c\n" +
+	"\tcustomers\x18\x01 \x01(\x03R\tcustomers\"\x98\x01\n" +
+	"\x14CreateSegmentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12+\n" +
+	"\x05rules\x18\x03 \x01(\v2\x15.product.SegmentRulesR\x05rules\x12\x1d\n" +
+	"\n" +
+	"voucher_id\x18\x04 \x01(\x05R\tvoucherId\"=\n" +
+	"\x0fSegmentResponse\x12*\n" +
+	"\asegment\x18\x01 \x01(\v2\x10.product.SegmentR\asegment\"Z\n" +
+	"\x15PreviewSegmentRequest\x12+\n" +
+	"\x05rules\x18\x01 \x01(\v2\x15.product.SegmentRulesR\x05rules\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"`\n" +
+	"\x16PreviewSegmentResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x120\n" +
+	"\amembers\x18\x02 \x03(\v2\x16.product.SegmentMemberR\amembers\"U\n" +
+	"\x19ListSegmentMembersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xaf\x01\n" +
+	"\x1aListSegmentMembersResponse\x12*\n" +
+	"\asegment\x18\x01 \x01(\v2\x10.product.SegmentR\asegment\x120\n" +
+	"\amembers\x18\x02 \x03(\v2\x16.product.SegmentMemberR\amembers\x123\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\"\xca\x01\n" +
 	"\x11UploadFileRequest\x12\x1b\n" +
 	"\tfile_data\x18\x01 \x01(\fR\bfileData\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1b\n" +
-	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\"\x9b\x01\n" +
+	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\x05R\tproductId\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\"\xef\x01\n" +
 	"\x12UploadFileResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x17\n" +
 	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1a\n" +
 	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1b\n" +
 	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\x12\x19\n" +
-	"\bfile_url\x18\x05 \x01(\tR\afileUrl\"\x99\x01\n" +
+	"\bfile_url\x18\x05 \x01(\tR\afileUrl\x12\x19\n" +
+	"\bimage_id\x18\x06 \x01(\x05R\aimageId\x127\n" +
+	"\n" +
+	"renditions\x18\a \x03(\v2\x17.product.ImageRenditionR\n" +
+	"renditions\"9\n" +
+	"\x18ListProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\"\x7f\n" +
+	"\x17SetProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1b\n" +
+	"\timage_ids\x18\x02 \x03(\x05R\bimageIds\x12(\n" +
+	"\x10primary_image_id\x18\x03 \x01(\x05R\x0eprimaryImageId\"F\n" +
+	"\x15ProductImagesResponse\x12-\n" +
+	"\x06images\x18\x01 \x03(\v2\x15.product.ProductImageR\x06images\"\x99\x01\n" +
 	"\x16PurchaseProductRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12C\n" +
 	"\bproducts\x18\x02 \x03(\v2'.product.PurchaseProductRequest_ProductR\bproducts\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\x05R\aorderId\"[\n" +
+	"\border_id\x18\x03 \x01(\x05R\aorderId\"\x82\x01\n" +
 	"\x1ePurchaseProductRequest_Product\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"v\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12%\n" +
+	"\x0eserial_numbers\x18\x03 \x03(\tR\rserialNumbers\"v\n" +
 	"\x17PurchaseProductResponse\x12,\n" +
 	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12-\n" +
-	"\bcustomer\x18\x02 \x01(\v2\x11.product.CustomerR\bcustomer2\xbc\v\n" +
+	"\bcustomer\x18\x02 \x01(\v2\x11.product.CustomerR\bcustomer\"\x87\x01\n" +
+	"\x1dRegisterProductSerialsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12G\n" +
+	"\aserials\x18\x02 \x03(\v2-.product.RegisterProductSerialsRequest_SerialR\aserials\"\xb7\x01\n" +
+	"$RegisterProductSerialsRequest_Serial\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12#\n" +
+	"\rstone_details\x18\x03 \x01(\tR\fstoneDetails\x12-\n" +
+	"\x12certificate_number\x18\x04 \x01(\tR\x11certificateNumber\":\n" +
+	"\x19ListProductSerialsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\"J\n" +
+	"\x16ProductSerialsResponse\x120\n" +
+	"\aserials\x18\x01 \x03(\v2\x16.product.ProductSerialR\aserials\">\n" +
+	"\x17GetSerialHistoryRequest\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\"\xe7\x01\n" +
+	"\x18GetSerialHistoryResponse\x12.\n" +
+	"\x06serial\x18\x01 \x01(\v2\x16.product.ProductSerialR\x06serial\x12*\n" +
+	"\aproduct\x18\x02 \x01(\v2\x10.product.ProductR\aproduct\x123\n" +
+	"\x06events\x18\x03 \x03(\v2\x1b.product.ProductSerialEventR\x06events\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x05R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x05 \x01(\tR\n" +
+	"customerId\"`\n" +
+	"\x1bOpenStocktakeSessionRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04noteJ\x04\b\x01\x10\x02R\x06branch\";\n" +
+	"\x1aGetStocktakeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\"d\n" +
+	"\rStocktakeScan\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\"k\n" +
+	"\x1cSubmitStocktakeCountsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\x12,\n" +
+	"\x05scans\x18\x02 \x03(\v2\x16.product.StocktakeScanR\x05scans\">\n" +
+	"\x1dSubmitStocktakeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\"?\n" +
+	"\x1eApproveStocktakeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\">\n" +
+	"\x1dRejectStocktakeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x05R\tsessionId\"}\n" +
+	"\x18StocktakeSessionResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.product.StocktakeSessionR\asession\x12,\n" +
+	"\x05lines\x18\x02 \x03(\v2\x16.product.StocktakeLineR\x05lines\"h\n" +
+	"\x1bListLowStockProductsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x05R\n" +
+	"categoryId\"\xa0\x01\n" +
+	"\x15CreateSupplierRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x19\n" +
+	"\btax_code\x18\x05 \x01(\tR\ataxCode\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"\xcc\x01\n" +
+	"\x15UpdateSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x19\n" +
+	"\btax_code\x18\x06 \x01(\tR\ataxCode\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1a\n" +
+	"\binactive\x18\b \x01(\bR\binactive\"A\n" +
+	"\x10SupplierResponse\x12-\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x11.product.SupplierR\bsupplier\"a\n" +
+	"\x14ListSuppliersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\"}\n" +
+	"\x15ListSuppliersResponse\x12/\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x11.product.SupplierR\tsuppliers\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\"\xb2\x01\n" +
+	"\x1aCreatePurchaseOrderRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x05R\n" +
+	"supplierId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1f\n" +
+	"\vexpected_at\x18\x03 \x01(\tR\n" +
+	"expectedAt\x12>\n" +
+	"\x05lines\x18\x04 \x03(\v2(.product.CreatePurchaseOrderRequest_LineR\x05lines\"\xa8\x01\n" +
+	"\x1fCreatePurchaseOrderRequest_Line\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12+\n" +
+	"\x12gold_cost_per_gram\x18\x03 \x01(\x01R\x0fgoldCostPerGram\x12\x1d\n" +
+	"\n" +
+	"labor_cost\x18\x04 \x01(\x01R\tlaborCost\")\n" +
+	"\x17GetPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"~\n" +
+	"\x19ListPurchaseOrdersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vsupplier_id\x18\x03 \x01(\x05R\n" +
+	"supplierId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\x81\x01\n" +
+	"\x1aListPurchaseOrdersResponse\x12.\n" +
+	"\x06orders\x18\x01 \x03(\v2\x16.product.PurchaseOrderR\x06orders\x123\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x13.product.PaginationR\n" +
+	"pagination\",\n" +
+	"\x1aCancelPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xad\x01\n" +
+	"\x13ReceiveGoodsRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x05R\x0fpurchaseOrderId\x12\x1d\n" +
+	"\n" +
+	"extra_cost\x18\x02 \x01(\x01R\textraCost\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x127\n" +
+	"\x05lines\x18\x04 \x03(\v2!.product.ReceiveGoodsRequest_LineR\x05lines\"O\n" +
+	"\x18ReceiveGoodsRequest_Line\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\x05R\x06lineId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"E\n" +
+	"\x15PurchaseOrderResponse\x12,\n" +
+	"\x05order\x18\x01 \x01(\v2\x16.product.PurchaseOrderR\x05order*\x98\x01\n" +
+	"\vProductSort\x12\x18\n" +
+	"\x14PRODUCT_SORT_DEFAULT\x10\x00\x12\x1a\n" +
+	"\x16PRODUCT_SORT_PRICE_ASC\x10\x01\x12\x1b\n" +
+	"\x17PRODUCT_SORT_PRICE_DESC\x10\x02\x12\x17\n" +
+	"\x13PRODUCT_SORT_NEWEST\x10\x03\x12\x1d\n" +
+	"\x19PRODUCT_SORT_BEST_SELLING\x10\x04*7\n" +
+	"\n" +
+	"FileFormat\x12\x13\n" +
+	"\x0fFILE_FORMAT_CSV\x10\x00\x12\x14\n" +
+	"\x10FILE_FORMAT_XLSX\x10\x01*9\n" +
+	"\vLabelFormat\x12\x14\n" +
+	"\x10LABEL_FORMAT_PDF\x10\x00\x12\x14\n" +
+	"\x10LABEL_FORMAT_ZPL\x10\x012\xb66\n" +
 	"\x0fProductCustomer\x12L\n" +
 	"\x05Dummy\x12\x15.product.DummyRequest\x1a\x16.product.DummyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/dummy\x12a\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x18.product.ProductResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/products\x12]\n" +
@@ -1567,17 +6376,60 @@ const file_product_product_proto_rawDesc = "" +
 	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x18.product.ProductResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/products/{id}\x12a\n" +
 	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/products\x12f\n" +
 	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x18.product.ProductResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/products/{id}\x12i\n" +
-	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/products/{id}\x12\x86\x01\n" +
-	"\x15ListProductCategories\x12%.product.ListProductCategoriesRequest\x1a&.product.ListProductCategoriesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/product-categories\x12e\n" +
+	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/products/{id}\x12p\n" +
+	"\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x18.product.ProductResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/products/{id}/restore\x12\x84\x01\n" +
+	"\x14CreateProductVariant\x12$.product.CreateProductVariantRequest\x1a\x18.product.ProductResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/products/{parent_id}/variants\x12S\n" +
+	"\x0eImportProducts\x12\x1e.product.ImportProductsRequest\x1a\x1f.product.ImportProductsResponse(\x01\x12n\n" +
+	"\x0eExportProducts\x12\x1e.product.ExportProductsRequest\x1a\x1f.product.ExportProductsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/products/export\x12q\n" +
+	"\x0eGenerateLabels\x12\x1e.product.GenerateLabelsRequest\x1a\x1f.product.GenerateLabelsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/products/labels\x12\x86\x01\n" +
+	"\x15ListProductCategories\x12%.product.ListProductCategoriesRequest\x1a&.product.ListProductCategoriesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/product-categories\x12\x83\x01\n" +
+	"\x15CreateProductCategory\x12%.product.CreateProductCategoryRequest\x1a .product.ProductCategoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/product-categories\x12\x7f\n" +
+	"\x12GetProductCategory\x12\".product.GetProductCategoryRequest\x1a .product.ProductCategoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/product-categories/{id}\x12\x88\x01\n" +
+	"\x15UpdateProductCategory\x12%.product.UpdateProductCategoryRequest\x1a .product.ProductCategoryResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/v1/product-categories/{id}\x12\x8b\x01\n" +
+	"\x15DeleteProductCategory\x12%.product.DeleteProductCategoryRequest\x1a&.product.DeleteProductCategoryResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/product-categories/{id}\x12e\n" +
 	"\x0eCreateCustomer\x12\x1e.product.CreateCustomerRequest\x1a\x19.product.CustomerResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/customers\x12d\n" +
 	"\vGetCustomer\x12\x1b.product.GetCustomerRequest\x1a\x19.product.CustomerResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/customers/{phone}\x12e\n" +
 	"\rListCustomers\x12\x1d.product.ListCustomersRequest\x1a\x1e.product.ListCustomersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/customers\x12j\n" +
 	"\x0eUpdateCustomer\x12\x1e.product.UpdateCustomerRequest\x1a\x19.product.CustomerResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/customers/{id}\x12m\n" +
-	"\x0eDeleteCustomer\x12\x1e.product.DeleteCustomerRequest\x1a\x1f.product.DeleteCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/customers/{id}\x12\\\n" +
+	"\x0eDeleteCustomer\x12\x1e.product.DeleteCustomerRequest\x1a\x1f.product.DeleteCustomerResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/customers/{id}\x12\x89\x01\n" +
+	"\x18UpdateCustomerAttributes\x12(.product.UpdateCustomerAttributesRequest\x1a\x19.product.CustomerResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/v1/customers/{id}/attributes\x12q\n" +
+	"\x0fSetCustomerTags\x12\x1f.product.SetCustomerTagsRequest\x1a\x19.product.CustomerResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/customers/{id}/tags\x12\x7f\n" +
+	"\x0fAddCustomerNote\x12\x1f.product.AddCustomerNoteRequest\x1a\x1d.product.CustomerNoteResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/customers/{customer_id}/notes\x12\x85\x01\n" +
+	"\x11ListCustomerNotes\x12!.product.ListCustomerNotesRequest\x1a\".product.ListCustomerNotesResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/customers/{customer_id}/notes\x12\x8d\x01\n" +
+	"\x12DeleteCustomerNote\x12\".product.DeleteCustomerNoteRequest\x1a#.product.DeleteCustomerNoteResponse\".\x82\xd3\xe4\x93\x02(*&/v1/customers/{customer_id}/notes/{id}\x12\x81\x01\n" +
+	"\x12GetCustomerProfile\x12\".product.GetCustomerProfileRequest\x1a#.product.GetCustomerProfileResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/customers/{id}/profile\x12\x7f\n" +
+	"\x12ExportCustomerData\x12\".product.ExportCustomerDataRequest\x1a\x1f.product.PrivacyRequestResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/customers/{id}/export\x12t\n" +
+	"\rEraseCustomer\x12\x1d.product.EraseCustomerRequest\x1a\x1f.product.PrivacyRequestResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/customers/{id}/erase\x12z\n" +
+	"\x11GetPrivacyRequest\x12!.product.GetPrivacyRequestRequest\x1a\x1f.product.PrivacyRequestResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/privacy-requests/{id}\x12\x7f\n" +
+	"\x0eMergeCustomers\x12\x1e.product.MergeCustomersRequest\x1a\x1f.product.MergeCustomersResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/customers/{survivor_id}/merge\x12\x8b\x01\n" +
+	"\x14BackfillCustomerKeys\x12$.product.BackfillCustomerKeysRequest\x1a%.product.BackfillCustomerKeysResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/customers/backfill-keys\x12a\n" +
+	"\rCreateSegment\x12\x1d.product.CreateSegmentRequest\x1a\x18.product.SegmentResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/segments\x12r\n" +
+	"\x0ePreviewSegment\x12\x1e.product.PreviewSegmentRequest\x1a\x1f.product.PreviewSegmentResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/segments/preview\x12\x80\x01\n" +
+	"\x12ListSegmentMembers\x12\".product.ListSegmentMembersRequest\x1a#.product.ListSegmentMembersResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/segments/{id}/members\x12\\\n" +
 	"\n" +
 	"UploadFile\x12\x1a.product.UploadFileRequest\x1a\x1b.product.UploadFileResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/v1/upload\x12m\n" +
-	"\x0fPurchaseProduct\x12\x1f.product.PurchaseProductRequest\x1a .product.PurchaseProductResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/purchaseB>Z<github.com/linhhuynhcoding/jss-microservices/rpc/gen/productb\x06proto3"
+	"/v1/upload\x12\x80\x01\n" +
+	"\x11ListProductImages\x12!.product.ListProductImagesRequest\x1a\x1e.product.ProductImagesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/products/{product_id}/images\x12\x81\x01\n" +
+	"\x10SetProductImages\x12 .product.SetProductImagesRequest\x1a\x1e.product.ProductImagesResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/products/{product_id}/images\x12m\n" +
+	"\x0fPurchaseProduct\x12\x1f.product.PurchaseProductRequest\x1a .product.PurchaseProductResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/purchase\x12\x8f\x01\n" +
+	"\x16RegisterProductSerials\x12&.product.RegisterProductSerialsRequest\x1a\x1f.product.ProductSerialsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/products/{product_id}/serials\x12\x84\x01\n" +
+	"\x12ListProductSerials\x12\".product.ListProductSerialsRequest\x1a\x1f.product.ProductSerialsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/products/{product_id}/serials\x12|\n" +
+	"\x10GetSerialHistory\x12 .product.GetSerialHistoryRequest\x1a!.product.GetSerialHistoryResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/serials/{serial_number}\x12z\n" +
+	"\x14OpenStocktakeSession\x12$.product.OpenStocktakeSessionRequest\x1a!.product.StocktakeSessionResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/stocktakes\x12\x82\x01\n" +
+	"\x13GetStocktakeSession\x12#.product.GetStocktakeSessionRequest\x1a!.product.StocktakeSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/stocktakes/{session_id}\x12\x90\x01\n" +
+	"\x15SubmitStocktakeCounts\x12%.product.SubmitStocktakeCountsRequest\x1a!.product.StocktakeSessionResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/stocktakes/{session_id}/counts\x12\x92\x01\n" +
+	"\x16SubmitStocktakeSession\x12&.product.SubmitStocktakeSessionRequest\x1a!.product.StocktakeSessionResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/stocktakes/{session_id}/submit\x12\x95\x01\n" +
+	"\x17ApproveStocktakeSession\x12'.product.ApproveStocktakeSessionRequest\x1a!.product.StocktakeSessionResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/stocktakes/{session_id}/approve\x12\x92\x01\n" +
+	"\x16RejectStocktakeSession\x12&.product.RejectStocktakeSessionRequest\x1a!.product.StocktakeSessionResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/stocktakes/{session_id}/reject\x12e\n" +
+	"\x0eCreateSupplier\x12\x1e.product.CreateSupplierRequest\x1a\x19.product.SupplierResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/suppliers\x12j\n" +
+	"\x0eUpdateSupplier\x12\x1e.product.UpdateSupplierRequest\x1a\x19.product.SupplierResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/suppliers/{id}\x12e\n" +
+	"\rListSuppliers\x12\x1d.product.ListSuppliersRequest\x1a\x1e.product.ListSuppliersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/suppliers\x12z\n" +
+	"\x13CreatePurchaseOrder\x12#.product.CreatePurchaseOrderRequest\x1a\x1e.product.PurchaseOrderResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/purchase-orders\x12v\n" +
+	"\x10GetPurchaseOrder\x12 .product.GetPurchaseOrderRequest\x1a\x1e.product.PurchaseOrderResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/purchase-orders/{id}\x12z\n" +
+	"\x12ListPurchaseOrders\x12\".product.ListPurchaseOrdersRequest\x1a#.product.ListPurchaseOrdersResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/purchase-orders\x12\x86\x01\n" +
+	"\x13CancelPurchaseOrder\x12#.product.CancelPurchaseOrderRequest\x1a\x1e.product.PurchaseOrderResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/purchase-orders/{id}/cancel\x12\x89\x01\n" +
+	"\fReceiveGoods\x12\x1c.product.ReceiveGoodsRequest\x1a\x1e.product.PurchaseOrderResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/purchase-orders/{purchase_order_id}/receipts\x12|\n" +
+	"\x14ListLowStockProducts\x12$.product.ListLowStockProductsRequest\x1a\x1d.product.ListProductsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/inventory/low-stockB>Z<github.com/linhhuynhcoding/jss-microservices/rpc/gen/productb\x06proto3"
 
 var (
 	file_product_product_proto_rawDescOnce sync.Once
@@ -1591,79 +6443,301 @@ func file_product_product_proto_rawDescGZIP() []byte {
 	return file_product_product_proto_rawDescData
 }
 
-var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_product_product_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_product_product_proto_goTypes = []any{
-	(*DummyRequest)(nil),                   // 0: product.DummyRequest
-	(*DummyResponse)(nil),                  // 1: product.DummyResponse
-	(*CreateProductRequest)(nil),           // 2: product.CreateProductRequest
-	(*GetProductRequest)(nil),              // 3: product.GetProductRequest
-	(*ListProductsRequest)(nil),            // 4: product.ListProductsRequest
-	(*ListProductsResponse)(nil),           // 5: product.ListProductsResponse
-	(*UpdateProductRequest)(nil),           // 6: product.UpdateProductRequest
-	(*DeleteProductRequest)(nil),           // 7: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),          // 8: product.DeleteProductResponse
-	(*ProductResponse)(nil),                // 9: product.ProductResponse
-	(*ListProductCategoriesRequest)(nil),   // 10: product.ListProductCategoriesRequest
-	(*ListProductCategoriesResponse)(nil),  // 11: product.ListProductCategoriesResponse
-	(*CreateCustomerRequest)(nil),          // 12: product.CreateCustomerRequest
-	(*GetCustomerRequest)(nil),             // 13: product.GetCustomerRequest
-	(*ListCustomersRequest)(nil),           // 14: product.ListCustomersRequest
-	(*ListCustomersResponse)(nil),          // 15: product.ListCustomersResponse
-	(*UpdateCustomerRequest)(nil),          // 16: product.UpdateCustomerRequest
-	(*DeleteCustomerRequest)(nil),          // 17: product.DeleteCustomerRequest
-	(*DeleteCustomerResponse)(nil),         // 18: product.DeleteCustomerResponse
-	(*CustomerResponse)(nil),               // 19: product.CustomerResponse
-	(*UploadFileRequest)(nil),              // 20: product.UploadFileRequest
-	(*UploadFileResponse)(nil),             // 21: product.UploadFileResponse
-	(*PurchaseProductRequest)(nil),         // 22: product.PurchaseProductRequest
-	(*PurchaseProductRequest_Product)(nil), // 23: product.PurchaseProductRequest_Product
-	(*PurchaseProductResponse)(nil),        // 24: product.PurchaseProductResponse
-	(*Product)(nil),                        // 25: product.Product
-	(*ProductCategory)(nil),                // 26: product.ProductCategory
-	(*Customer)(nil),                       // 27: product.Customer
+	(ProductSort)(0),                             // 0: product.ProductSort
+	(FileFormat)(0),                              // 1: product.FileFormat
+	(LabelFormat)(0),                             // 2: product.LabelFormat
+	(*DummyRequest)(nil),                         // 3: product.DummyRequest
+	(*DummyResponse)(nil),                        // 4: product.DummyResponse
+	(*CreateProductRequest)(nil),                 // 5: product.CreateProductRequest
+	(*CreateProductVariantRequest)(nil),          // 6: product.CreateProductVariantRequest
+	(*GetProductRequest)(nil),                    // 7: product.GetProductRequest
+	(*ListProductsRequest)(nil),                  // 8: product.ListProductsRequest
+	(*ListProductsResponse)(nil),                 // 9: product.ListProductsResponse
+	(*UpdateProductRequest)(nil),                 // 10: product.UpdateProductRequest
+	(*DeleteProductRequest)(nil),                 // 11: product.DeleteProductRequest
+	(*RestoreProductRequest)(nil),                // 12: product.RestoreProductRequest
+	(*DeleteProductResponse)(nil),                // 13: product.DeleteProductResponse
+	(*ImportProductsRequest)(nil),                // 14: product.ImportProductsRequest
+	(*ImportProductsResponse)(nil),               // 15: product.ImportProductsResponse
+	(*ImportRowError)(nil),                       // 16: product.ImportRowError
+	(*ExportProductsRequest)(nil),                // 17: product.ExportProductsRequest
+	(*ExportProductsResponse)(nil),               // 18: product.ExportProductsResponse
+	(*GenerateLabelsRequest)(nil),                // 19: product.GenerateLabelsRequest
+	(*GenerateLabelsResponse)(nil),               // 20: product.GenerateLabelsResponse
+	(*ProductResponse)(nil),                      // 21: product.ProductResponse
+	(*ListProductCategoriesRequest)(nil),         // 22: product.ListProductCategoriesRequest
+	(*ListProductCategoriesResponse)(nil),        // 23: product.ListProductCategoriesResponse
+	(*CreateProductCategoryRequest)(nil),         // 24: product.CreateProductCategoryRequest
+	(*GetProductCategoryRequest)(nil),            // 25: product.GetProductCategoryRequest
+	(*UpdateProductCategoryRequest)(nil),         // 26: product.UpdateProductCategoryRequest
+	(*DeleteProductCategoryRequest)(nil),         // 27: product.DeleteProductCategoryRequest
+	(*DeleteProductCategoryResponse)(nil),        // 28: product.DeleteProductCategoryResponse
+	(*ProductCategoryResponse)(nil),              // 29: product.ProductCategoryResponse
+	(*CreateCustomerRequest)(nil),                // 30: product.CreateCustomerRequest
+	(*GetCustomerRequest)(nil),                   // 31: product.GetCustomerRequest
+	(*ListCustomersRequest)(nil),                 // 32: product.ListCustomersRequest
+	(*ListCustomersResponse)(nil),                // 33: product.ListCustomersResponse
+	(*UpdateCustomerRequest)(nil),                // 34: product.UpdateCustomerRequest
+	(*DeleteCustomerRequest)(nil),                // 35: product.DeleteCustomerRequest
+	(*DeleteCustomerResponse)(nil),               // 36: product.DeleteCustomerResponse
+	(*CustomerResponse)(nil),                     // 37: product.CustomerResponse
+	(*UpdateCustomerAttributesRequest)(nil),      // 38: product.UpdateCustomerAttributesRequest
+	(*SetCustomerTagsRequest)(nil),               // 39: product.SetCustomerTagsRequest
+	(*AddCustomerNoteRequest)(nil),               // 40: product.AddCustomerNoteRequest
+	(*CustomerNoteResponse)(nil),                 // 41: product.CustomerNoteResponse
+	(*ListCustomerNotesRequest)(nil),             // 42: product.ListCustomerNotesRequest
+	(*ListCustomerNotesResponse)(nil),            // 43: product.ListCustomerNotesResponse
+	(*DeleteCustomerNoteRequest)(nil),            // 44: product.DeleteCustomerNoteRequest
+	(*DeleteCustomerNoteResponse)(nil),           // 45: product.DeleteCustomerNoteResponse
+	(*GetCustomerProfileRequest)(nil),            // 46: product.GetCustomerProfileRequest
+	(*GetCustomerProfileResponse)(nil),           // 47: product.GetCustomerProfileResponse
+	(*ExportCustomerDataRequest)(nil),            // 48: product.ExportCustomerDataRequest
+	(*EraseCustomerRequest)(nil),                 // 49: product.EraseCustomerRequest
+	(*GetPrivacyRequestRequest)(nil),             // 50: product.GetPrivacyRequestRequest
+	(*PrivacyRequestResponse)(nil),               // 51: product.PrivacyRequestResponse
+	(*MergeCustomersRequest)(nil),                // 52: product.MergeCustomersRequest
+	(*MergeCustomersResponse)(nil),               // 53: product.MergeCustomersResponse
+	(*BackfillCustomerKeysRequest)(nil),          // 54: product.BackfillCustomerKeysRequest
+	(*BackfillCustomerKeysResponse)(nil),         // 55: product.BackfillCustomerKeysResponse
+	(*CreateSegmentRequest)(nil),                 // 56: product.CreateSegmentRequest
+	(*SegmentResponse)(nil),                      // 57: product.SegmentResponse
+	(*PreviewSegmentRequest)(nil),                // 58: product.PreviewSegmentRequest
+	(*PreviewSegmentResponse)(nil),               // 59: product.PreviewSegmentResponse
+	(*ListSegmentMembersRequest)(nil),            // 60: product.ListSegmentMembersRequest
+	(*ListSegmentMembersResponse)(nil),           // 61: product.ListSegmentMembersResponse
+	(*UploadFileRequest)(nil),                    // 62: product.UploadFileRequest
+	(*UploadFileResponse)(nil),                   // 63: product.UploadFileResponse
+	(*ListProductImagesRequest)(nil),             // 64: product.ListProductImagesRequest
+	(*SetProductImagesRequest)(nil),              // 65: product.SetProductImagesRequest
+	(*ProductImagesResponse)(nil),                // 66: product.ProductImagesResponse
+	(*PurchaseProductRequest)(nil),               // 67: product.PurchaseProductRequest
+	(*PurchaseProductRequest_Product)(nil),       // 68: product.PurchaseProductRequest_Product
+	(*PurchaseProductResponse)(nil),              // 69: product.PurchaseProductResponse
+	(*RegisterProductSerialsRequest)(nil),        // 70: product.RegisterProductSerialsRequest
+	(*RegisterProductSerialsRequest_Serial)(nil), // 71: product.RegisterProductSerialsRequest_Serial
+	(*ListProductSerialsRequest)(nil),            // 72: product.ListProductSerialsRequest
+	(*ProductSerialsResponse)(nil),               // 73: product.ProductSerialsResponse
+	(*GetSerialHistoryRequest)(nil),              // 74: product.GetSerialHistoryRequest
+	(*GetSerialHistoryResponse)(nil),             // 75: product.GetSerialHistoryResponse
+	(*OpenStocktakeSessionRequest)(nil),          // 76: product.OpenStocktakeSessionRequest
+	(*GetStocktakeSessionRequest)(nil),           // 77: product.GetStocktakeSessionRequest
+	(*StocktakeScan)(nil),                        // 78: product.StocktakeScan
+	(*SubmitStocktakeCountsRequest)(nil),         // 79: product.SubmitStocktakeCountsRequest
+	(*SubmitStocktakeSessionRequest)(nil),        // 80: product.SubmitStocktakeSessionRequest
+	(*ApproveStocktakeSessionRequest)(nil),       // 81: product.ApproveStocktakeSessionRequest
+	(*RejectStocktakeSessionRequest)(nil),        // 82: product.RejectStocktakeSessionRequest
+	(*StocktakeSessionResponse)(nil),             // 83: product.StocktakeSessionResponse
+	(*ListLowStockProductsRequest)(nil),          // 84: product.ListLowStockProductsRequest
+	(*CreateSupplierRequest)(nil),                // 85: product.CreateSupplierRequest
+	(*UpdateSupplierRequest)(nil),                // 86: product.UpdateSupplierRequest
+	(*SupplierResponse)(nil),                     // 87: product.SupplierResponse
+	(*ListSuppliersRequest)(nil),                 // 88: product.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),                // 89: product.ListSuppliersResponse
+	(*CreatePurchaseOrderRequest)(nil),           // 90: product.CreatePurchaseOrderRequest
+	(*CreatePurchaseOrderRequest_Line)(nil),      // 91: product.CreatePurchaseOrderRequest_Line
+	(*GetPurchaseOrderRequest)(nil),              // 92: product.GetPurchaseOrderRequest
+	(*ListPurchaseOrdersRequest)(nil),            // 93: product.ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),           // 94: product.ListPurchaseOrdersResponse
+	(*CancelPurchaseOrderRequest)(nil),           // 95: product.CancelPurchaseOrderRequest
+	(*ReceiveGoodsRequest)(nil),                  // 96: product.ReceiveGoodsRequest
+	(*ReceiveGoodsRequest_Line)(nil),             // 97: product.ReceiveGoodsRequest_Line
+	(*PurchaseOrderResponse)(nil),                // 98: product.PurchaseOrderResponse
+	(*ProductStone)(nil),                         // 99: product.ProductStone
+	(*Product)(nil),                              // 100: product.Product
+	(*Pagination)(nil),                           // 101: product.Pagination
+	(*ProductCategory)(nil),                      // 102: product.ProductCategory
+	(*Customer)(nil),                             // 103: product.Customer
+	(*CustomerNote)(nil),                         // 104: product.CustomerNote
+	(*CustomerCategoryStat)(nil),                 // 105: product.CustomerCategoryStat
+	(*CustomerPurchase)(nil),                     // 106: product.CustomerPurchase
+	(*PrivacyRequest)(nil),                       // 107: product.PrivacyRequest
+	(*SegmentRules)(nil),                         // 108: product.SegmentRules
+	(*Segment)(nil),                              // 109: product.Segment
+	(*SegmentMember)(nil),                        // 110: product.SegmentMember
+	(*ImageRendition)(nil),                       // 111: product.ImageRendition
+	(*ProductImage)(nil),                         // 112: product.ProductImage
+	(*ProductSerial)(nil),                        // 113: product.ProductSerial
+	(*ProductSerialEvent)(nil),                   // 114: product.ProductSerialEvent
+	(*StocktakeSession)(nil),                     // 115: product.StocktakeSession
+	(*StocktakeLine)(nil),                        // 116: product.StocktakeLine
+	(*Supplier)(nil),                             // 117: product.Supplier
+	(*PurchaseOrder)(nil),                        // 118: product.PurchaseOrder
 }
 var file_product_product_proto_depIdxs = []int32{
-	25, // 0: product.ListProductsResponse.products:type_name -> product.Product
-	25, // 1: product.ProductResponse.product:type_name -> product.Product
-	26, // 2: product.ListProductCategoriesResponse.categories:type_name -> product.ProductCategory
-	27, // 3: product.ListCustomersResponse.customers:type_name -> product.Customer
-	27, // 4: product.CustomerResponse.customer:type_name -> product.Customer
-	23, // 5: product.PurchaseProductRequest.products:type_name -> product.PurchaseProductRequest_Product
-	25, // 6: product.PurchaseProductResponse.products:type_name -> product.Product
-	27, // 7: product.PurchaseProductResponse.customer:type_name -> product.Customer
-	0,  // 8: product.ProductCustomer.Dummy:input_type -> product.DummyRequest
-	2,  // 9: product.ProductCustomer.CreateProduct:input_type -> product.CreateProductRequest
-	3,  // 10: product.ProductCustomer.GetProduct:input_type -> product.GetProductRequest
-	4,  // 11: product.ProductCustomer.ListProducts:input_type -> product.ListProductsRequest
-	6,  // 12: product.ProductCustomer.UpdateProduct:input_type -> product.UpdateProductRequest
-	7,  // 13: product.ProductCustomer.DeleteProduct:input_type -> product.DeleteProductRequest
-	10, // 14: product.ProductCustomer.ListProductCategories:input_type -> product.ListProductCategoriesRequest
-	12, // 15: product.ProductCustomer.CreateCustomer:input_type -> product.CreateCustomerRequest
-	13, // 16: product.ProductCustomer.GetCustomer:input_type -> product.GetCustomerRequest
-	14, // 17: product.ProductCustomer.ListCustomers:input_type -> product.ListCustomersRequest
-	16, // 18: product.ProductCustomer.UpdateCustomer:input_type -> product.UpdateCustomerRequest
-	17, // 19: product.ProductCustomer.DeleteCustomer:input_type -> product.DeleteCustomerRequest
-	20, // 20: product.ProductCustomer.UploadFile:input_type -> product.UploadFileRequest
-	22, // 21: product.ProductCustomer.PurchaseProduct:input_type -> product.PurchaseProductRequest
-	1,  // 22: product.ProductCustomer.Dummy:output_type -> product.DummyResponse
-	9,  // 23: product.ProductCustomer.CreateProduct:output_type -> product.ProductResponse
-	9,  // 24: product.ProductCustomer.GetProduct:output_type -> product.ProductResponse
-	5,  // 25: product.ProductCustomer.ListProducts:output_type -> product.ListProductsResponse
-	9,  // 26: product.ProductCustomer.UpdateProduct:output_type -> product.ProductResponse
-	8,  // 27: product.ProductCustomer.DeleteProduct:output_type -> product.DeleteProductResponse
-	11, // 28: product.ProductCustomer.ListProductCategories:output_type -> product.ListProductCategoriesResponse
-	19, // 29: product.ProductCustomer.CreateCustomer:output_type -> product.CustomerResponse
-	19, // 30: product.ProductCustomer.GetCustomer:output_type -> product.CustomerResponse
-	15, // 31: product.ProductCustomer.ListCustomers:output_type -> product.ListCustomersResponse
-	19, // 32: product.ProductCustomer.UpdateCustomer:output_type -> product.CustomerResponse
-	18, // 33: product.ProductCustomer.DeleteCustomer:output_type -> product.DeleteCustomerResponse
-	21, // 34: product.ProductCustomer.UploadFile:output_type -> product.UploadFileResponse
-	24, // 35: product.ProductCustomer.PurchaseProduct:output_type -> product.PurchaseProductResponse
-	22, // [22:36] is the sub-list for method output_type
-	8,  // [8:22] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	99,  // 0: product.CreateProductRequest.stones:type_name -> product.ProductStone
+	0,   // 1: product.ListProductsRequest.sort:type_name -> product.ProductSort
+	100, // 2: product.ListProductsResponse.products:type_name -> product.Product
+	101, // 3: product.ListProductsResponse.pagination:type_name -> product.Pagination
+	99,  // 4: product.UpdateProductRequest.stones:type_name -> product.ProductStone
+	16,  // 5: product.ImportProductsResponse.errors:type_name -> product.ImportRowError
+	100, // 6: product.ImportProductsResponse.products:type_name -> product.Product
+	1,   // 7: product.ExportProductsRequest.format:type_name -> product.FileFormat
+	2,   // 8: product.GenerateLabelsRequest.format:type_name -> product.LabelFormat
+	100, // 9: product.ProductResponse.product:type_name -> product.Product
+	102, // 10: product.ListProductCategoriesResponse.categories:type_name -> product.ProductCategory
+	102, // 11: product.ProductCategoryResponse.category:type_name -> product.ProductCategory
+	103, // 12: product.ListCustomersResponse.customers:type_name -> product.Customer
+	101, // 13: product.ListCustomersResponse.pagination:type_name -> product.Pagination
+	103, // 14: product.CustomerResponse.customer:type_name -> product.Customer
+	104, // 15: product.CustomerNoteResponse.note:type_name -> product.CustomerNote
+	104, // 16: product.ListCustomerNotesResponse.notes:type_name -> product.CustomerNote
+	101, // 17: product.ListCustomerNotesResponse.pagination:type_name -> product.Pagination
+	103, // 18: product.GetCustomerProfileResponse.customer:type_name -> product.Customer
+	105, // 19: product.GetCustomerProfileResponse.favourite_categories:type_name -> product.CustomerCategoryStat
+	106, // 20: product.GetCustomerProfileResponse.purchases:type_name -> product.CustomerPurchase
+	101, // 21: product.GetCustomerProfileResponse.pagination:type_name -> product.Pagination
+	107, // 22: product.PrivacyRequestResponse.request:type_name -> product.PrivacyRequest
+	103, // 23: product.MergeCustomersResponse.customer:type_name -> product.Customer
+	108, // 24: product.CreateSegmentRequest.rules:type_name -> product.SegmentRules
+	109, // 25: product.SegmentResponse.segment:type_name -> product.Segment
+	108, // 26: product.PreviewSegmentRequest.rules:type_name -> product.SegmentRules
+	110, // 27: product.PreviewSegmentResponse.members:type_name -> product.SegmentMember
+	109, // 28: product.ListSegmentMembersResponse.segment:type_name -> product.Segment
+	110, // 29: product.ListSegmentMembersResponse.members:type_name -> product.SegmentMember
+	101, // 30: product.ListSegmentMembersResponse.pagination:type_name -> product.Pagination
+	111, // 31: product.UploadFileResponse.renditions:type_name -> product.ImageRendition
+	112, // 32: product.ProductImagesResponse.images:type_name -> product.ProductImage
+	68,  // 33: product.PurchaseProductRequest.products:type_name -> product.PurchaseProductRequest_Product
+	100, // 34: product.PurchaseProductResponse.products:type_name -> product.Product
+	103, // 35: product.PurchaseProductResponse.customer:type_name -> product.Customer
+	71,  // 36: product.RegisterProductSerialsRequest.serials:type_name -> product.RegisterProductSerialsRequest_Serial
+	113, // 37: product.ProductSerialsResponse.serials:type_name -> product.ProductSerial
+	113, // 38: product.GetSerialHistoryResponse.serial:type_name -> product.ProductSerial
+	100, // 39: product.GetSerialHistoryResponse.product:type_name -> product.Product
+	114, // 40: product.GetSerialHistoryResponse.events:type_name -> product.ProductSerialEvent
+	78,  // 41: product.SubmitStocktakeCountsRequest.scans:type_name -> product.StocktakeScan
+	115, // 42: product.StocktakeSessionResponse.session:type_name -> product.StocktakeSession
+	116, // 43: product.StocktakeSessionResponse.lines:type_name -> product.StocktakeLine
+	117, // 44: product.SupplierResponse.supplier:type_name -> product.Supplier
+	117, // 45: product.ListSuppliersResponse.suppliers:type_name -> product.Supplier
+	101, // 46: product.ListSuppliersResponse.pagination:type_name -> product.Pagination
+	91,  // 47: product.CreatePurchaseOrderRequest.lines:type_name -> product.CreatePurchaseOrderRequest_Line
+	118, // 48: product.ListPurchaseOrdersResponse.orders:type_name -> product.PurchaseOrder
+	101, // 49: product.ListPurchaseOrdersResponse.pagination:type_name -> product.Pagination
+	97,  // 50: product.ReceiveGoodsRequest.lines:type_name -> product.ReceiveGoodsRequest_Line
+	118, // 51: product.PurchaseOrderResponse.order:type_name -> product.PurchaseOrder
+	3,   // 52: product.ProductCustomer.Dummy:input_type -> product.DummyRequest
+	5,   // 53: product.ProductCustomer.CreateProduct:input_type -> product.CreateProductRequest
+	7,   // 54: product.ProductCustomer.GetProduct:input_type -> product.GetProductRequest
+	8,   // 55: product.ProductCustomer.ListProducts:input_type -> product.ListProductsRequest
+	10,  // 56: product.ProductCustomer.UpdateProduct:input_type -> product.UpdateProductRequest
+	11,  // 57: product.ProductCustomer.DeleteProduct:input_type -> product.DeleteProductRequest
+	12,  // 58: product.ProductCustomer.RestoreProduct:input_type -> product.RestoreProductRequest
+	6,   // 59: product.ProductCustomer.CreateProductVariant:input_type -> product.CreateProductVariantRequest
+	14,  // 60: product.ProductCustomer.ImportProducts:input_type -> product.ImportProductsRequest
+	17,  // 61: product.ProductCustomer.ExportProducts:input_type -> product.ExportProductsRequest
+	19,  // 62: product.ProductCustomer.GenerateLabels:input_type -> product.GenerateLabelsRequest
+	22,  // 63: product.ProductCustomer.ListProductCategories:input_type -> product.ListProductCategoriesRequest
+	24,  // 64: product.ProductCustomer.CreateProductCategory:input_type -> product.CreateProductCategoryRequest
+	25,  // 65: product.ProductCustomer.GetProductCategory:input_type -> product.GetProductCategoryRequest
+	26,  // 66: product.ProductCustomer.UpdateProductCategory:input_type -> product.UpdateProductCategoryRequest
+	27,  // 67: product.ProductCustomer.DeleteProductCategory:input_type -> product.DeleteProductCategoryRequest
+	30,  // 68: product.ProductCustomer.CreateCustomer:input_type -> product.CreateCustomerRequest
+	31,  // 69: product.ProductCustomer.GetCustomer:input_type -> product.GetCustomerRequest
+	32,  // 70: product.ProductCustomer.ListCustomers:input_type -> product.ListCustomersRequest
+	34,  // 71: product.ProductCustomer.UpdateCustomer:input_type -> product.UpdateCustomerRequest
+	35,  // 72: product.ProductCustomer.DeleteCustomer:input_type -> product.DeleteCustomerRequest
+	38,  // 73: product.ProductCustomer.UpdateCustomerAttributes:input_type -> product.UpdateCustomerAttributesRequest
+	39,  // 74: product.ProductCustomer.SetCustomerTags:input_type -> product.SetCustomerTagsRequest
+	40,  // 75: product.ProductCustomer.AddCustomerNote:input_type -> product.AddCustomerNoteRequest
+	42,  // 76: product.ProductCustomer.ListCustomerNotes:input_type -> product.ListCustomerNotesRequest
+	44,  // 77: product.ProductCustomer.DeleteCustomerNote:input_type -> product.DeleteCustomerNoteRequest
+	46,  // 78: product.ProductCustomer.GetCustomerProfile:input_type -> product.GetCustomerProfileRequest
+	48,  // 79: product.ProductCustomer.ExportCustomerData:input_type -> product.ExportCustomerDataRequest
+	49,  // 80: product.ProductCustomer.EraseCustomer:input_type -> product.EraseCustomerRequest
+	50,  // 81: product.ProductCustomer.GetPrivacyRequest:input_type -> product.GetPrivacyRequestRequest
+	52,  // 82: product.ProductCustomer.MergeCustomers:input_type -> product.MergeCustomersRequest
+	54,  // 83: product.ProductCustomer.BackfillCustomerKeys:input_type -> product.BackfillCustomerKeysRequest
+	56,  // 84: product.ProductCustomer.CreateSegment:input_type -> product.CreateSegmentRequest
+	58,  // 85: product.ProductCustomer.PreviewSegment:input_type -> product.PreviewSegmentRequest
+	60,  // 86: product.ProductCustomer.ListSegmentMembers:input_type -> product.ListSegmentMembersRequest
+	62,  // 87: product.ProductCustomer.UploadFile:input_type -> product.UploadFileRequest
+	64,  // 88: product.ProductCustomer.ListProductImages:input_type -> product.ListProductImagesRequest
+	65,  // 89: product.ProductCustomer.SetProductImages:input_type -> product.SetProductImagesRequest
+	67,  // 90: product.ProductCustomer.PurchaseProduct:input_type -> product.PurchaseProductRequest
+	70,  // 91: product.ProductCustomer.RegisterProductSerials:input_type -> product.RegisterProductSerialsRequest
+	72,  // 92: product.ProductCustomer.ListProductSerials:input_type -> product.ListProductSerialsRequest
+	74,  // 93: product.ProductCustomer.GetSerialHistory:input_type -> product.GetSerialHistoryRequest
+	76,  // 94: product.ProductCustomer.OpenStocktakeSession:input_type -> product.OpenStocktakeSessionRequest
+	77,  // 95: product.ProductCustomer.GetStocktakeSession:input_type -> product.GetStocktakeSessionRequest
+	79,  // 96: product.ProductCustomer.SubmitStocktakeCounts:input_type -> product.SubmitStocktakeCountsRequest
+	80,  // 97: product.ProductCustomer.SubmitStocktakeSession:input_type -> product.SubmitStocktakeSessionRequest
+	81,  // 98: product.ProductCustomer.ApproveStocktakeSession:input_type -> product.ApproveStocktakeSessionRequest
+	82,  // 99: product.ProductCustomer.RejectStocktakeSession:input_type -> product.RejectStocktakeSessionRequest
+	85,  // 100: product.ProductCustomer.CreateSupplier:input_type -> product.CreateSupplierRequest
+	86,  // 101: product.ProductCustomer.UpdateSupplier:input_type -> product.UpdateSupplierRequest
+	88,  // 102: product.ProductCustomer.ListSuppliers:input_type -> product.ListSuppliersRequest
+	90,  // 103: product.ProductCustomer.CreatePurchaseOrder:input_type -> product.CreatePurchaseOrderRequest
+	92,  // 104: product.ProductCustomer.GetPurchaseOrder:input_type -> product.GetPurchaseOrderRequest
+	93,  // 105: product.ProductCustomer.ListPurchaseOrders:input_type -> product.ListPurchaseOrdersRequest
+	95,  // 106: product.ProductCustomer.CancelPurchaseOrder:input_type -> product.CancelPurchaseOrderRequest
+	96,  // 107: product.ProductCustomer.ReceiveGoods:input_type -> product.ReceiveGoodsRequest
+	84,  // 108: product.ProductCustomer.ListLowStockProducts:input_type -> product.ListLowStockProductsRequest
+	4,   // 109: product.ProductCustomer.Dummy:output_type -> product.DummyResponse
+	21,  // 110: product.ProductCustomer.CreateProduct:output_type -> product.ProductResponse
+	21,  // 111: product.ProductCustomer.GetProduct:output_type -> product.ProductResponse
+	9,   // 112: product.ProductCustomer.ListProducts:output_type -> product.ListProductsResponse
+	21,  // 113: product.ProductCustomer.UpdateProduct:output_type -> product.ProductResponse
+	13,  // 114: product.ProductCustomer.DeleteProduct:output_type -> product.DeleteProductResponse
+	21,  // 115: product.ProductCustomer.RestoreProduct:output_type -> product.ProductResponse
+	21,  // 116: product.ProductCustomer.CreateProductVariant:output_type -> product.ProductResponse
+	15,  // 117: product.ProductCustomer.ImportProducts:output_type -> product.ImportProductsResponse
+	18,  // 118: product.ProductCustomer.ExportProducts:output_type -> product.ExportProductsResponse
+	20,  // 119: product.ProductCustomer.GenerateLabels:output_type -> product.GenerateLabelsResponse
+	23,  // 120: product.ProductCustomer.ListProductCategories:output_type -> product.ListProductCategoriesResponse
+	29,  // 121: product.ProductCustomer.CreateProductCategory:output_type -> product.ProductCategoryResponse
+	29,  // 122: product.ProductCustomer.GetProductCategory:output_type -> product.ProductCategoryResponse
+	29,  // 123: product.ProductCustomer.UpdateProductCategory:output_type -> product.ProductCategoryResponse
+	28,  // 124: product.ProductCustomer.DeleteProductCategory:output_type -> product.DeleteProductCategoryResponse
+	37,  // 125: product.ProductCustomer.CreateCustomer:output_type -> product.CustomerResponse
+	37,  // 126: product.ProductCustomer.GetCustomer:output_type -> product.CustomerResponse
+	33,  // 127: product.ProductCustomer.ListCustomers:output_type -> product.ListCustomersResponse
+	37,  // 128: product.ProductCustomer.UpdateCustomer:output_type -> product.CustomerResponse
+	36,  // 129: product.ProductCustomer.DeleteCustomer:output_type -> product.DeleteCustomerResponse
+	37,  // 130: product.ProductCustomer.UpdateCustomerAttributes:output_type -> product.CustomerResponse
+	37,  // 131: product.ProductCustomer.SetCustomerTags:output_type -> product.CustomerResponse
+	41,  // 132: product.ProductCustomer.AddCustomerNote:output_type -> product.CustomerNoteResponse
+	43,  // 133: product.ProductCustomer.ListCustomerNotes:output_type -> product.ListCustomerNotesResponse
+	45,  // 134: product.ProductCustomer.DeleteCustomerNote:output_type -> product.DeleteCustomerNoteResponse
+	47,  // 135: product.ProductCustomer.GetCustomerProfile:output_type -> product.GetCustomerProfileResponse
+	51,  // 136: product.ProductCustomer.ExportCustomerData:output_type -> product.PrivacyRequestResponse
+	51,  // 137: product.ProductCustomer.EraseCustomer:output_type -> product.PrivacyRequestResponse
+	51,  // 138: product.ProductCustomer.GetPrivacyRequest:output_type -> product.PrivacyRequestResponse
+	53,  // 139: product.ProductCustomer.MergeCustomers:output_type -> product.MergeCustomersResponse
+	55,  // 140: product.ProductCustomer.BackfillCustomerKeys:output_type -> product.BackfillCustomerKeysResponse
+	57,  // 141: product.ProductCustomer.CreateSegment:output_type -> product.SegmentResponse
+	59,  // 142: product.ProductCustomer.PreviewSegment:output_type -> product.PreviewSegmentResponse
+	61,  // 143: product.ProductCustomer.ListSegmentMembers:output_type -> product.ListSegmentMembersResponse
+	63,  // 144: product.ProductCustomer.UploadFile:output_type -> product.UploadFileResponse
+	66,  // 145: product.ProductCustomer.ListProductImages:output_type -> product.ProductImagesResponse
+	66,  // 146: product.ProductCustomer.SetProductImages:output_type -> product.ProductImagesResponse
+	69,  // 147: product.ProductCustomer.PurchaseProduct:output_type -> product.PurchaseProductResponse
+	73,  // 148: product.ProductCustomer.RegisterProductSerials:output_type -> product.ProductSerialsResponse
+	73,  // 149: product.ProductCustomer.ListProductSerials:output_type -> product.ProductSerialsResponse
+	75,  // 150: product.ProductCustomer.GetSerialHistory:output_type -> product.GetSerialHistoryResponse
+	83,  // 151: product.ProductCustomer.OpenStocktakeSession:output_type -> product.StocktakeSessionResponse
+	83,  // 152: product.ProductCustomer.GetStocktakeSession:output_type -> product.StocktakeSessionResponse
+	83,  // 153: product.ProductCustomer.SubmitStocktakeCounts:output_type -> product.StocktakeSessionResponse
+	83,  // 154: product.ProductCustomer.SubmitStocktakeSession:output_type -> product.StocktakeSessionResponse
+	83,  // 155: product.ProductCustomer.ApproveStocktakeSession:output_type -> product.StocktakeSessionResponse
+	83,  // 156: product.ProductCustomer.RejectStocktakeSession:output_type -> product.StocktakeSessionResponse
+	87,  // 157: product.ProductCustomer.CreateSupplier:output_type -> product.SupplierResponse
+	87,  // 158: product.ProductCustomer.UpdateSupplier:output_type -> product.SupplierResponse
+	89,  // 159: product.ProductCustomer.ListSuppliers:output_type -> product.ListSuppliersResponse
+	98,  // 160: product.ProductCustomer.CreatePurchaseOrder:output_type -> product.PurchaseOrderResponse
+	98,  // 161: product.ProductCustomer.GetPurchaseOrder:output_type -> product.PurchaseOrderResponse
+	94,  // 162: product.ProductCustomer.ListPurchaseOrders:output_type -> product.ListPurchaseOrdersResponse
+	98,  // 163: product.ProductCustomer.CancelPurchaseOrder:output_type -> product.PurchaseOrderResponse
+	98,  // 164: product.ProductCustomer.ReceiveGoods:output_type -> product.PurchaseOrderResponse
+	9,   // 165: product.ProductCustomer.ListLowStockProducts:output_type -> product.ListProductsResponse
+	109, // [109:166] is the sub-list for method output_type
+	52,  // [52:109] is the sub-list for method input_type
+	52,  // [52:52] is the sub-list for extension type_name
+	52,  // [52:52] is the sub-list for extension extendee
+	0,   // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1672,18 +6746,21 @@ func file_product_product_proto_init() {
 		return
 	}
 	file_product_common_proto_init()
+	file_product_product_proto_msgTypes[2].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_proto_rawDesc), len(file_product_product_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   25,
+			NumEnums:      3,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_product_product_proto_goTypes,
 		DependencyIndexes: file_product_product_proto_depIdxs,
+		EnumInfos:         file_product_product_proto_enumTypes,
 		MessageInfos:      file_product_product_proto_msgTypes,
 	}.Build()
 	File_product_product_proto = out.File
@@ -19,20 +19,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductCustomer_Dummy_FullMethodName                 = "/product.ProductCustomer/Dummy"
-	ProductCustomer_CreateProduct_FullMethodName         = "/product.ProductCustomer/CreateProduct"
-	ProductCustomer_GetProduct_FullMethodName            = "/product.ProductCustomer/GetProduct"
-	ProductCustomer_ListProducts_FullMethodName          = "/product.ProductCustomer/ListProducts"
-	ProductCustomer_UpdateProduct_FullMethodName         = "/product.ProductCustomer/UpdateProduct"
-	ProductCustomer_DeleteProduct_FullMethodName         = "/product.ProductCustomer/DeleteProduct"
-	ProductCustomer_ListProductCategories_FullMethodName = "/product.ProductCustomer/ListProductCategories"
-	ProductCustomer_CreateCustomer_FullMethodName        = "/product.ProductCustomer/CreateCustomer"
-	ProductCustomer_GetCustomer_FullMethodName           = "/product.ProductCustomer/GetCustomer"
-	ProductCustomer_ListCustomers_FullMethodName         = "/product.ProductCustomer/ListCustomers"
-	ProductCustomer_UpdateCustomer_FullMethodName        = "/product.ProductCustomer/UpdateCustomer"
-	ProductCustomer_DeleteCustomer_FullMethodName        = "/product.ProductCustomer/DeleteCustomer"
-	ProductCustomer_UploadFile_FullMethodName            = "/product.ProductCustomer/UploadFile"
-	ProductCustomer_PurchaseProduct_FullMethodName       = "/product.ProductCustomer/PurchaseProduct"
+	ProductCustomer_Dummy_FullMethodName                    = "/product.ProductCustomer/Dummy"
+	ProductCustomer_CreateProduct_FullMethodName            = "/product.ProductCustomer/CreateProduct"
+	ProductCustomer_GetProduct_FullMethodName               = "/product.ProductCustomer/GetProduct"
+	ProductCustomer_ListProducts_FullMethodName             = "/product.ProductCustomer/ListProducts"
+	ProductCustomer_UpdateProduct_FullMethodName            = "/product.ProductCustomer/UpdateProduct"
+	ProductCustomer_DeleteProduct_FullMethodName            = "/product.ProductCustomer/DeleteProduct"
+	ProductCustomer_RestoreProduct_FullMethodName           = "/product.ProductCustomer/RestoreProduct"
+	ProductCustomer_CreateProductVariant_FullMethodName     = "/product.ProductCustomer/CreateProductVariant"
+	ProductCustomer_ImportProducts_FullMethodName           = "/product.ProductCustomer/ImportProducts"
+	ProductCustomer_ExportProducts_FullMethodName           = "/product.ProductCustomer/ExportProducts"
+	ProductCustomer_GenerateLabels_FullMethodName           = "/product.ProductCustomer/GenerateLabels"
+	ProductCustomer_ListProductCategories_FullMethodName    = "/product.ProductCustomer/ListProductCategories"
+	ProductCustomer_CreateProductCategory_FullMethodName    = "/product.ProductCustomer/CreateProductCategory"
+	ProductCustomer_GetProductCategory_FullMethodName       = "/product.ProductCustomer/GetProductCategory"
+	ProductCustomer_UpdateProductCategory_FullMethodName    = "/product.ProductCustomer/UpdateProductCategory"
+	ProductCustomer_DeleteProductCategory_FullMethodName    = "/product.ProductCustomer/DeleteProductCategory"
+	ProductCustomer_CreateCustomer_FullMethodName           = "/product.ProductCustomer/CreateCustomer"
+	ProductCustomer_GetCustomer_FullMethodName              = "/product.ProductCustomer/GetCustomer"
+	ProductCustomer_ListCustomers_FullMethodName            = "/product.ProductCustomer/ListCustomers"
+	ProductCustomer_UpdateCustomer_FullMethodName           = "/product.ProductCustomer/UpdateCustomer"
+	ProductCustomer_DeleteCustomer_FullMethodName           = "/product.ProductCustomer/DeleteCustomer"
+	ProductCustomer_UpdateCustomerAttributes_FullMethodName = "/product.ProductCustomer/UpdateCustomerAttributes"
+	ProductCustomer_SetCustomerTags_FullMethodName          = "/product.ProductCustomer/SetCustomerTags"
+	ProductCustomer_AddCustomerNote_FullMethodName          = "/product.ProductCustomer/AddCustomerNote"
+	ProductCustomer_ListCustomerNotes_FullMethodName        = "/product.ProductCustomer/ListCustomerNotes"
+	ProductCustomer_DeleteCustomerNote_FullMethodName       = "/product.ProductCustomer/DeleteCustomerNote"
+	ProductCustomer_GetCustomerProfile_FullMethodName       = "/product.ProductCustomer/GetCustomerProfile"
+	ProductCustomer_ExportCustomerData_FullMethodName       = "/product.ProductCustomer/ExportCustomerData"
+	ProductCustomer_EraseCustomer_FullMethodName            = "/product.ProductCustomer/EraseCustomer"
+	ProductCustomer_GetPrivacyRequest_FullMethodName        = "/product.ProductCustomer/GetPrivacyRequest"
+	ProductCustomer_MergeCustomers_FullMethodName           = "/product.ProductCustomer/MergeCustomers"
+	ProductCustomer_BackfillCustomerKeys_FullMethodName     = "/product.ProductCustomer/BackfillCustomerKeys"
+	ProductCustomer_CreateSegment_FullMethodName            = "/product.ProductCustomer/CreateSegment"
+	ProductCustomer_PreviewSegment_FullMethodName           = "/product.ProductCustomer/PreviewSegment"
+	ProductCustomer_ListSegmentMembers_FullMethodName       = "/product.ProductCustomer/ListSegmentMembers"
+	ProductCustomer_UploadFile_FullMethodName               = "/product.ProductCustomer/UploadFile"
+	ProductCustomer_ListProductImages_FullMethodName        = "/product.ProductCustomer/ListProductImages"
+	ProductCustomer_SetProductImages_FullMethodName         = "/product.ProductCustomer/SetProductImages"
+	ProductCustomer_PurchaseProduct_FullMethodName          = "/product.ProductCustomer/PurchaseProduct"
+	ProductCustomer_RegisterProductSerials_FullMethodName   = "/product.ProductCustomer/RegisterProductSerials"
+	ProductCustomer_ListProductSerials_FullMethodName       = "/product.ProductCustomer/ListProductSerials"
+	ProductCustomer_GetSerialHistory_FullMethodName         = "/product.ProductCustomer/GetSerialHistory"
+	ProductCustomer_OpenStocktakeSession_FullMethodName     = "/product.ProductCustomer/OpenStocktakeSession"
+	ProductCustomer_GetStocktakeSession_FullMethodName      = "/product.ProductCustomer/GetStocktakeSession"
+	ProductCustomer_SubmitStocktakeCounts_FullMethodName    = "/product.ProductCustomer/SubmitStocktakeCounts"
+	ProductCustomer_SubmitStocktakeSession_FullMethodName   = "/product.ProductCustomer/SubmitStocktakeSession"
+	ProductCustomer_ApproveStocktakeSession_FullMethodName  = "/product.ProductCustomer/ApproveStocktakeSession"
+	ProductCustomer_RejectStocktakeSession_FullMethodName   = "/product.ProductCustomer/RejectStocktakeSession"
+	ProductCustomer_CreateSupplier_FullMethodName           = "/product.ProductCustomer/CreateSupplier"
+	ProductCustomer_UpdateSupplier_FullMethodName           = "/product.ProductCustomer/UpdateSupplier"
+	ProductCustomer_ListSuppliers_FullMethodName            = "/product.ProductCustomer/ListSuppliers"
+	ProductCustomer_CreatePurchaseOrder_FullMethodName      = "/product.ProductCustomer/CreatePurchaseOrder"
+	ProductCustomer_GetPurchaseOrder_FullMethodName         = "/product.ProductCustomer/GetPurchaseOrder"
+	ProductCustomer_ListPurchaseOrders_FullMethodName       = "/product.ProductCustomer/ListPurchaseOrders"
+	ProductCustomer_CancelPurchaseOrder_FullMethodName      = "/product.ProductCustomer/CancelPurchaseOrder"
+	ProductCustomer_ReceiveGoods_FullMethodName             = "/product.ProductCustomer/ReceiveGoods"
+	ProductCustomer_ListLowStockProducts_FullMethodName     = "/product.ProductCustomer/ListLowStockProducts"
 )
 
 // ProductCustomerClient is the client API for ProductCustomer service.
@@ -45,17 +88,89 @@ type ProductCustomerClient interface {
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// DeleteProduct archives the product, a hard delete is refused once the
+	// product has history (orders, serials, stocktake counts)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// CreateProductVariant adds a size of an existing product, the variant shares
+	// the name, category, image and pricing of its parent
+	CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// ImportProducts creates products from a csv/xlsx file. The file is streamed
+	// in chunks, the HTTP gateway accepts a multipart upload on /v1/products/import
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (*ExportProductsResponse, error)
+	GenerateLabels(ctx context.Context, in *GenerateLabelsRequest, opts ...grpc.CallOption) (*GenerateLabelsResponse, error)
 	// ----- PRODUCT CATEGORIES -----
 	ListProductCategories(ctx context.Context, in *ListProductCategoriesRequest, opts ...grpc.CallOption) (*ListProductCategoriesResponse, error)
+	CreateProductCategory(ctx context.Context, in *CreateProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error)
+	GetProductCategory(ctx context.Context, in *GetProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error)
+	UpdateProductCategory(ctx context.Context, in *UpdateProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error)
+	// DeleteProductCategory fails while the category still has products or sub categories
+	DeleteProductCategory(ctx context.Context, in *DeleteProductCategoryRequest, opts ...grpc.CallOption) (*DeleteProductCategoryResponse, error)
 	// ----- CUSTOMER -----
 	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
 	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
 	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
 	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
 	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	UpdateCustomerAttributes(ctx context.Context, in *UpdateCustomerAttributesRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	SetCustomerTags(ctx context.Context, in *SetCustomerTagsRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
+	AddCustomerNote(ctx context.Context, in *AddCustomerNoteRequest, opts ...grpc.CallOption) (*CustomerNoteResponse, error)
+	ListCustomerNotes(ctx context.Context, in *ListCustomerNotesRequest, opts ...grpc.CallOption) (*ListCustomerNotesResponse, error)
+	DeleteCustomerNote(ctx context.Context, in *DeleteCustomerNoteRequest, opts ...grpc.CallOption) (*DeleteCustomerNoteResponse, error)
+	// GetCustomerProfile aggregates the purchase history and loyalty balance
+	// of a customer for the sales counter
+	GetCustomerProfile(ctx context.Context, in *GetCustomerProfileRequest, opts ...grpc.CallOption) (*GetCustomerProfileResponse, error)
+	// ExportCustomerData collects everything held about a customer across
+	// services, poll GetPrivacyRequest for the archive
+	ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error)
+	// EraseCustomer anonymizes the personal fields of a customer in every
+	// service, financial records stay keyed by the customer uuid. Erasing
+	// the customer again sends a failed request again.
+	EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error)
+	GetPrivacyRequest(ctx context.Context, in *GetPrivacyRequestRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error)
+	// MergeCustomers moves the orders, loyalty points and vouchers of a
+	// duplicate customer to the survivor and deletes the duplicate
+	MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error)
+	// BackfillCustomerKeys asks order-service and loyalty-service to re-key
+	// the records of every customer still keyed by phone on the customer
+	// uuid. It is safe to run more than once.
+	BackfillCustomerKeys(ctx context.Context, in *BackfillCustomerKeysRequest, opts ...grpc.CallOption) (*BackfillCustomerKeysResponse, error)
+	// ----- CUSTOMER SEGMENTS -----
+	// CreateSegment saves the rules and computes the members right away,
+	// members are recomputed every SEGMENT_REFRESH_INTERVAL
+	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*SegmentResponse, error)
+	// PreviewSegment evaluates rules without saving them
+	PreviewSegment(ctx context.Context, in *PreviewSegmentRequest, opts ...grpc.CallOption) (*PreviewSegmentResponse, error)
+	ListSegmentMembers(ctx context.Context, in *ListSegmentMembersRequest, opts ...grpc.CallOption) (*ListSegmentMembersResponse, error)
 	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
+	ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
+	SetProductImages(ctx context.Context, in *SetProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error)
 	PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error)
+	// ----- PRODUCT SERIALS -----
+	RegisterProductSerials(ctx context.Context, in *RegisterProductSerialsRequest, opts ...grpc.CallOption) (*ProductSerialsResponse, error)
+	ListProductSerials(ctx context.Context, in *ListProductSerialsRequest, opts ...grpc.CallOption) (*ProductSerialsResponse, error)
+	GetSerialHistory(ctx context.Context, in *GetSerialHistoryRequest, opts ...grpc.CallOption) (*GetSerialHistoryResponse, error)
+	// ----- STOCKTAKE -----
+	OpenStocktakeSession(ctx context.Context, in *OpenStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	GetStocktakeSession(ctx context.Context, in *GetStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	SubmitStocktakeCounts(ctx context.Context, in *SubmitStocktakeCountsRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	SubmitStocktakeSession(ctx context.Context, in *SubmitStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	ApproveStocktakeSession(ctx context.Context, in *ApproveStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	RejectStocktakeSession(ctx context.Context, in *RejectStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error)
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error)
+	UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	// CancelPurchaseOrder is only allowed before anything is received
+	CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	// ReceiveGoods books a (partial) delivery: the stock goes through the
+	// movement ledger and the landed cost updates the product pricing
+	ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	// ListLowStockProducts lists the active products at or below their reorder point
+	ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 }
 
 type productCustomerClient struct {
@@ -126,6 +241,59 @@ func (c *productCustomerClient) DeleteProduct(ctx context.Context, in *DeletePro
 	return out, nil
 }
 
+func (c *productCustomerClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CreateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductCustomer_ServiceDesc.Streams[0], ProductCustomer_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductsRequest, ImportProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductCustomer_ImportProductsClient = grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse]
+
+func (c *productCustomerClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (*ExportProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportProductsResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ExportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) GenerateLabels(ctx context.Context, in *GenerateLabelsRequest, opts ...grpc.CallOption) (*GenerateLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateLabelsResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GenerateLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productCustomerClient) ListProductCategories(ctx context.Context, in *ListProductCategoriesRequest, opts ...grpc.CallOption) (*ListProductCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductCategoriesResponse)
@@ -136,6 +304,46 @@ func (c *productCustomerClient) ListProductCategories(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *productCustomerClient) CreateProductCategory(ctx context.Context, in *CreateProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CreateProductCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) GetProductCategory(ctx context.Context, in *GetProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetProductCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) UpdateProductCategory(ctx context.Context, in *UpdateProductCategoryRequest, opts ...grpc.CallOption) (*ProductCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_UpdateProductCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) DeleteProductCategory(ctx context.Context, in *DeleteProductCategoryRequest, opts ...grpc.CallOption) (*DeleteProductCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_DeleteProductCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productCustomerClient) CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CustomerResponse)
@@ -186,6 +394,146 @@ func (c *productCustomerClient) DeleteCustomer(ctx context.Context, in *DeleteCu
 	return out, nil
 }
 
+func (c *productCustomerClient) UpdateCustomerAttributes(ctx context.Context, in *UpdateCustomerAttributesRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_UpdateCustomerAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) SetCustomerTags(ctx context.Context, in *SetCustomerTagsRequest, opts ...grpc.CallOption) (*CustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_SetCustomerTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) AddCustomerNote(ctx context.Context, in *AddCustomerNoteRequest, opts ...grpc.CallOption) (*CustomerNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerNoteResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_AddCustomerNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ListCustomerNotes(ctx context.Context, in *ListCustomerNotesRequest, opts ...grpc.CallOption) (*ListCustomerNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomerNotesResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListCustomerNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) DeleteCustomerNote(ctx context.Context, in *DeleteCustomerNoteRequest, opts ...grpc.CallOption) (*DeleteCustomerNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCustomerNoteResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_DeleteCustomerNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) GetCustomerProfile(ctx context.Context, in *GetCustomerProfileRequest, opts ...grpc.CallOption) (*GetCustomerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerProfileResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetCustomerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacyRequestResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ExportCustomerData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) EraseCustomer(ctx context.Context, in *EraseCustomerRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacyRequestResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_EraseCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) GetPrivacyRequest(ctx context.Context, in *GetPrivacyRequestRequest, opts ...grpc.CallOption) (*PrivacyRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacyRequestResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetPrivacyRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) MergeCustomers(ctx context.Context, in *MergeCustomersRequest, opts ...grpc.CallOption) (*MergeCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCustomersResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_MergeCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) BackfillCustomerKeys(ctx context.Context, in *BackfillCustomerKeysRequest, opts ...grpc.CallOption) (*BackfillCustomerKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackfillCustomerKeysResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_BackfillCustomerKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*SegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SegmentResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CreateSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) PreviewSegment(ctx context.Context, in *PreviewSegmentRequest, opts ...grpc.CallOption) (*PreviewSegmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewSegmentResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_PreviewSegment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ListSegmentMembers(ctx context.Context, in *ListSegmentMembersRequest, opts ...grpc.CallOption) (*ListSegmentMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSegmentMembersResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListSegmentMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productCustomerClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadFileResponse)
@@ -196,6 +544,26 @@ func (c *productCustomerClient) UploadFile(ctx context.Context, in *UploadFileRe
 	return out, nil
 }
 
+func (c *productCustomerClient) ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) SetProductImages(ctx context.Context, in *SetProductImagesRequest, opts ...grpc.CallOption) (*ProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_SetProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productCustomerClient) PurchaseProduct(ctx context.Context, in *PurchaseProductRequest, opts ...grpc.CallOption) (*PurchaseProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PurchaseProductResponse)
@@ -206,58 +574,337 @@ func (c *productCustomerClient) PurchaseProduct(ctx context.Context, in *Purchas
 	return out, nil
 }
 
-// ProductCustomerServer is the server API for ProductCustomer service.
-// All implementations must embed UnimplementedProductCustomerServer
-// for forward compatibility.
-type ProductCustomerServer interface {
-	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
-	// ----- PRODUCT -----
-	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
-	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
-	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
-	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
-	// ----- PRODUCT CATEGORIES -----
-	ListProductCategories(context.Context, *ListProductCategoriesRequest) (*ListProductCategoriesResponse, error)
-	// ----- CUSTOMER -----
-	CreateCustomer(context.Context, *CreateCustomerRequest) (*CustomerResponse, error)
-	GetCustomer(context.Context, *GetCustomerRequest) (*CustomerResponse, error)
-	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
-	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error)
-	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
-	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
-	PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error)
-	mustEmbedUnimplementedProductCustomerServer()
+func (c *productCustomerClient) RegisterProductSerials(ctx context.Context, in *RegisterProductSerialsRequest, opts ...grpc.CallOption) (*ProductSerialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSerialsResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_RegisterProductSerials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductCustomerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductCustomerServer struct{}
-
-func (UnimplementedProductCustomerServer) Dummy(context.Context, *DummyRequest) (*DummyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
-}
-func (UnimplementedProductCustomerServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+func (c *productCustomerClient) ListProductSerials(ctx context.Context, in *ListProductSerialsRequest, opts ...grpc.CallOption) (*ProductSerialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSerialsResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListProductSerials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductCustomerServer) GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+
+func (c *productCustomerClient) GetSerialHistory(ctx context.Context, in *GetSerialHistoryRequest, opts ...grpc.CallOption) (*GetSerialHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSerialHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetSerialHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductCustomerServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+
+func (c *productCustomerClient) OpenStocktakeSession(ctx context.Context, in *OpenStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_OpenStocktakeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductCustomerServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *productCustomerClient) GetStocktakeSession(ctx context.Context, in *GetStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetStocktakeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductCustomerServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+func (c *productCustomerClient) SubmitStocktakeCounts(ctx context.Context, in *SubmitStocktakeCountsRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_SubmitStocktakeCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductCustomerServer) ListProductCategories(context.Context, *ListProductCategoriesRequest) (*ListProductCategoriesResponse, error) {
+
+func (c *productCustomerClient) SubmitStocktakeSession(ctx context.Context, in *SubmitStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_SubmitStocktakeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ApproveStocktakeSession(ctx context.Context, in *ApproveStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ApproveStocktakeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) RejectStocktakeSession(ctx context.Context, in *RejectStocktakeSessionRequest, opts ...grpc.CallOption) (*StocktakeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeSessionResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_RejectStocktakeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_UpdateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_CancelPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ReceiveGoods(ctx context.Context, in *ReceiveGoodsRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ReceiveGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productCustomerClient) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, ProductCustomer_ListLowStockProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductCustomerServer is the server API for ProductCustomer service.
+// All implementations must embed UnimplementedProductCustomerServer
+// for forward compatibility.
+type ProductCustomerServer interface {
+	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
+	// ----- PRODUCT -----
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
+	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
+	// DeleteProduct archives the product, a hard delete is refused once the
+	// product has history (orders, serials, stocktake counts)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error)
+	// CreateProductVariant adds a size of an existing product, the variant shares
+	// the name, category, image and pricing of its parent
+	CreateProductVariant(context.Context, *CreateProductVariantRequest) (*ProductResponse, error)
+	// ImportProducts creates products from a csv/xlsx file. The file is streamed
+	// in chunks, the HTTP gateway accepts a multipart upload on /v1/products/import
+	ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error
+	ExportProducts(context.Context, *ExportProductsRequest) (*ExportProductsResponse, error)
+	GenerateLabels(context.Context, *GenerateLabelsRequest) (*GenerateLabelsResponse, error)
+	// ----- PRODUCT CATEGORIES -----
+	ListProductCategories(context.Context, *ListProductCategoriesRequest) (*ListProductCategoriesResponse, error)
+	CreateProductCategory(context.Context, *CreateProductCategoryRequest) (*ProductCategoryResponse, error)
+	GetProductCategory(context.Context, *GetProductCategoryRequest) (*ProductCategoryResponse, error)
+	UpdateProductCategory(context.Context, *UpdateProductCategoryRequest) (*ProductCategoryResponse, error)
+	// DeleteProductCategory fails while the category still has products or sub categories
+	DeleteProductCategory(context.Context, *DeleteProductCategoryRequest) (*DeleteProductCategoryResponse, error)
+	// ----- CUSTOMER -----
+	CreateCustomer(context.Context, *CreateCustomerRequest) (*CustomerResponse, error)
+	GetCustomer(context.Context, *GetCustomerRequest) (*CustomerResponse, error)
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error)
+	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	UpdateCustomerAttributes(context.Context, *UpdateCustomerAttributesRequest) (*CustomerResponse, error)
+	SetCustomerTags(context.Context, *SetCustomerTagsRequest) (*CustomerResponse, error)
+	AddCustomerNote(context.Context, *AddCustomerNoteRequest) (*CustomerNoteResponse, error)
+	ListCustomerNotes(context.Context, *ListCustomerNotesRequest) (*ListCustomerNotesResponse, error)
+	DeleteCustomerNote(context.Context, *DeleteCustomerNoteRequest) (*DeleteCustomerNoteResponse, error)
+	// GetCustomerProfile aggregates the purchase history and loyalty balance
+	// of a customer for the sales counter
+	GetCustomerProfile(context.Context, *GetCustomerProfileRequest) (*GetCustomerProfileResponse, error)
+	// ExportCustomerData collects everything held about a customer across
+	// services, poll GetPrivacyRequest for the archive
+	ExportCustomerData(context.Context, *ExportCustomerDataRequest) (*PrivacyRequestResponse, error)
+	// EraseCustomer anonymizes the personal fields of a customer in every
+	// service, financial records stay keyed by the customer uuid. Erasing
+	// the customer again sends a failed request again.
+	EraseCustomer(context.Context, *EraseCustomerRequest) (*PrivacyRequestResponse, error)
+	GetPrivacyRequest(context.Context, *GetPrivacyRequestRequest) (*PrivacyRequestResponse, error)
+	// MergeCustomers moves the orders, loyalty points and vouchers of a
+	// duplicate customer to the survivor and deletes the duplicate
+	MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error)
+	// BackfillCustomerKeys asks order-service and loyalty-service to re-key
+	// the records of every customer still keyed by phone on the customer
+	// uuid. It is safe to run more than once.
+	BackfillCustomerKeys(context.Context, *BackfillCustomerKeysRequest) (*BackfillCustomerKeysResponse, error)
+	// ----- CUSTOMER SEGMENTS -----
+	// CreateSegment saves the rules and computes the members right away,
+	// members are recomputed every SEGMENT_REFRESH_INTERVAL
+	CreateSegment(context.Context, *CreateSegmentRequest) (*SegmentResponse, error)
+	// PreviewSegment evaluates rules without saving them
+	PreviewSegment(context.Context, *PreviewSegmentRequest) (*PreviewSegmentResponse, error)
+	ListSegmentMembers(context.Context, *ListSegmentMembersRequest) (*ListSegmentMembersResponse, error)
+	UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error)
+	ListProductImages(context.Context, *ListProductImagesRequest) (*ProductImagesResponse, error)
+	SetProductImages(context.Context, *SetProductImagesRequest) (*ProductImagesResponse, error)
+	PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error)
+	// ----- PRODUCT SERIALS -----
+	RegisterProductSerials(context.Context, *RegisterProductSerialsRequest) (*ProductSerialsResponse, error)
+	ListProductSerials(context.Context, *ListProductSerialsRequest) (*ProductSerialsResponse, error)
+	GetSerialHistory(context.Context, *GetSerialHistoryRequest) (*GetSerialHistoryResponse, error)
+	// ----- STOCKTAKE -----
+	OpenStocktakeSession(context.Context, *OpenStocktakeSessionRequest) (*StocktakeSessionResponse, error)
+	GetStocktakeSession(context.Context, *GetStocktakeSessionRequest) (*StocktakeSessionResponse, error)
+	SubmitStocktakeCounts(context.Context, *SubmitStocktakeCountsRequest) (*StocktakeSessionResponse, error)
+	SubmitStocktakeSession(context.Context, *SubmitStocktakeSessionRequest) (*StocktakeSessionResponse, error)
+	ApproveStocktakeSession(context.Context, *ApproveStocktakeSessionRequest) (*StocktakeSessionResponse, error)
+	RejectStocktakeSession(context.Context, *RejectStocktakeSessionRequest) (*StocktakeSessionResponse, error)
+	CreateSupplier(context.Context, *CreateSupplierRequest) (*SupplierResponse, error)
+	UpdateSupplier(context.Context, *UpdateSupplierRequest) (*SupplierResponse, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	// CancelPurchaseOrder is only allowed before anything is received
+	CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	// ReceiveGoods books a (partial) delivery: the stock goes through the
+	// movement ledger and the landed cost updates the product pricing
+	ReceiveGoods(context.Context, *ReceiveGoodsRequest) (*PurchaseOrderResponse, error)
+	// ListLowStockProducts lists the active products at or below their reorder point
+	ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListProductsResponse, error)
+	mustEmbedUnimplementedProductCustomerServer()
+}
+
+// UnimplementedProductCustomerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductCustomerServer struct{}
+
+func (UnimplementedProductCustomerServer) Dummy(context.Context, *DummyRequest) (*DummyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
+}
+func (UnimplementedProductCustomerServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductCustomerServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) CreateProductVariant(context.Context, *CreateProductVariantRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductVariant not implemented")
+}
+func (UnimplementedProductCustomerServer) ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductCustomerServer) ExportProducts(context.Context, *ExportProductsRequest) (*ExportProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductCustomerServer) GenerateLabels(context.Context, *GenerateLabelsRequest) (*GenerateLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateLabels not implemented")
+}
+func (UnimplementedProductCustomerServer) ListProductCategories(context.Context, *ListProductCategoriesRequest) (*ListProductCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProductCategories not implemented")
 }
+func (UnimplementedProductCustomerServer) CreateProductCategory(context.Context, *CreateProductCategoryRequest) (*ProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductCategory not implemented")
+}
+func (UnimplementedProductCustomerServer) GetProductCategory(context.Context, *GetProductCategoryRequest) (*ProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductCategory not implemented")
+}
+func (UnimplementedProductCustomerServer) UpdateProductCategory(context.Context, *UpdateProductCategoryRequest) (*ProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductCategory not implemented")
+}
+func (UnimplementedProductCustomerServer) DeleteProductCategory(context.Context, *DeleteProductCategoryRequest) (*DeleteProductCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductCategory not implemented")
+}
 func (UnimplementedProductCustomerServer) CreateCustomer(context.Context, *CreateCustomerRequest) (*CustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomer not implemented")
 }
@@ -267,287 +914,1152 @@ func (UnimplementedProductCustomerServer) GetCustomer(context.Context, *GetCusto
 func (UnimplementedProductCustomerServer) ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCustomers not implemented")
 }
-func (UnimplementedProductCustomerServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+func (UnimplementedProductCustomerServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+func (UnimplementedProductCustomerServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
+}
+func (UnimplementedProductCustomerServer) UpdateCustomerAttributes(context.Context, *UpdateCustomerAttributesRequest) (*CustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomerAttributes not implemented")
+}
+func (UnimplementedProductCustomerServer) SetCustomerTags(context.Context, *SetCustomerTagsRequest) (*CustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCustomerTags not implemented")
+}
+func (UnimplementedProductCustomerServer) AddCustomerNote(context.Context, *AddCustomerNoteRequest) (*CustomerNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCustomerNote not implemented")
+}
+func (UnimplementedProductCustomerServer) ListCustomerNotes(context.Context, *ListCustomerNotesRequest) (*ListCustomerNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomerNotes not implemented")
+}
+func (UnimplementedProductCustomerServer) DeleteCustomerNote(context.Context, *DeleteCustomerNoteRequest) (*DeleteCustomerNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomerNote not implemented")
+}
+func (UnimplementedProductCustomerServer) GetCustomerProfile(context.Context, *GetCustomerProfileRequest) (*GetCustomerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerProfile not implemented")
+}
+func (UnimplementedProductCustomerServer) ExportCustomerData(context.Context, *ExportCustomerDataRequest) (*PrivacyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCustomerData not implemented")
+}
+func (UnimplementedProductCustomerServer) EraseCustomer(context.Context, *EraseCustomerRequest) (*PrivacyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseCustomer not implemented")
+}
+func (UnimplementedProductCustomerServer) GetPrivacyRequest(context.Context, *GetPrivacyRequestRequest) (*PrivacyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacyRequest not implemented")
+}
+func (UnimplementedProductCustomerServer) MergeCustomers(context.Context, *MergeCustomersRequest) (*MergeCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCustomers not implemented")
+}
+func (UnimplementedProductCustomerServer) BackfillCustomerKeys(context.Context, *BackfillCustomerKeysRequest) (*BackfillCustomerKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCustomerKeys not implemented")
+}
+func (UnimplementedProductCustomerServer) CreateSegment(context.Context, *CreateSegmentRequest) (*SegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+}
+func (UnimplementedProductCustomerServer) PreviewSegment(context.Context, *PreviewSegmentRequest) (*PreviewSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSegment not implemented")
+}
+func (UnimplementedProductCustomerServer) ListSegmentMembers(context.Context, *ListSegmentMembersRequest) (*ListSegmentMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegmentMembers not implemented")
+}
+func (UnimplementedProductCustomerServer) UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedProductCustomerServer) ListProductImages(context.Context, *ListProductImagesRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductImages not implemented")
+}
+func (UnimplementedProductCustomerServer) SetProductImages(context.Context, *SetProductImagesRequest) (*ProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductImages not implemented")
+}
+func (UnimplementedProductCustomerServer) PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseProduct not implemented")
+}
+func (UnimplementedProductCustomerServer) RegisterProductSerials(context.Context, *RegisterProductSerialsRequest) (*ProductSerialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProductSerials not implemented")
+}
+func (UnimplementedProductCustomerServer) ListProductSerials(context.Context, *ListProductSerialsRequest) (*ProductSerialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductSerials not implemented")
+}
+func (UnimplementedProductCustomerServer) GetSerialHistory(context.Context, *GetSerialHistoryRequest) (*GetSerialHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSerialHistory not implemented")
+}
+func (UnimplementedProductCustomerServer) OpenStocktakeSession(context.Context, *OpenStocktakeSessionRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenStocktakeSession not implemented")
+}
+func (UnimplementedProductCustomerServer) GetStocktakeSession(context.Context, *GetStocktakeSessionRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStocktakeSession not implemented")
+}
+func (UnimplementedProductCustomerServer) SubmitStocktakeCounts(context.Context, *SubmitStocktakeCountsRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStocktakeCounts not implemented")
+}
+func (UnimplementedProductCustomerServer) SubmitStocktakeSession(context.Context, *SubmitStocktakeSessionRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStocktakeSession not implemented")
+}
+func (UnimplementedProductCustomerServer) ApproveStocktakeSession(context.Context, *ApproveStocktakeSessionRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStocktakeSession not implemented")
+}
+func (UnimplementedProductCustomerServer) RejectStocktakeSession(context.Context, *RejectStocktakeSessionRequest) (*StocktakeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStocktakeSession not implemented")
+}
+func (UnimplementedProductCustomerServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*SupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedProductCustomerServer) UpdateSupplier(context.Context, *UpdateSupplierRequest) (*SupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupplier not implemented")
+}
+func (UnimplementedProductCustomerServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedProductCustomerServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedProductCustomerServer) GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedProductCustomerServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedProductCustomerServer) CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPurchaseOrder not implemented")
+}
+func (UnimplementedProductCustomerServer) ReceiveGoods(context.Context, *ReceiveGoodsRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveGoods not implemented")
+}
+func (UnimplementedProductCustomerServer) ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockProducts not implemented")
+}
+func (UnimplementedProductCustomerServer) mustEmbedUnimplementedProductCustomerServer() {}
+func (UnimplementedProductCustomerServer) testEmbeddedByValue()                         {}
+
+// UnsafeProductCustomerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductCustomerServer will
+// result in compilation errors.
+type UnsafeProductCustomerServer interface {
+	mustEmbedUnimplementedProductCustomerServer()
+}
+
+func RegisterProductCustomerServer(s grpc.ServiceRegistrar, srv ProductCustomerServer) {
+	// If the following call pancis, it indicates UnimplementedProductCustomerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductCustomer_ServiceDesc, srv)
+}
+
+func _ProductCustomer_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DummyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).Dummy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_Dummy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).Dummy(ctx, req.(*DummyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_CreateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).CreateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_CreateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).CreateProductVariant(ctx, req.(*CreateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductCustomerServer).ImportProducts(&grpc.GenericServerStream[ImportProductsRequest, ImportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductCustomer_ImportProductsServer = grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]
+
+func _ProductCustomer_ExportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ExportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ExportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ExportProducts(ctx, req.(*ExportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GenerateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GenerateLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GenerateLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GenerateLabels(ctx, req.(*GenerateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListProductCategories(ctx, req.(*ListProductCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_CreateProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).CreateProductCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_CreateProductCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).CreateProductCategory(ctx, req.(*CreateProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GetProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetProductCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetProductCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetProductCategory(ctx, req.(*GetProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_UpdateProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).UpdateProductCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_UpdateProductCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).UpdateProductCategory(ctx, req.(*UpdateProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_DeleteProductCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).DeleteProductCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_DeleteProductCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).DeleteProductCategory(ctx, req.(*DeleteProductCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).CreateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_CreateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_UpdateCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).DeleteCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_DeleteCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_UpdateCustomerAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).UpdateCustomerAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_UpdateCustomerAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).UpdateCustomerAttributes(ctx, req.(*UpdateCustomerAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_SetCustomerTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCustomerTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).SetCustomerTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_SetCustomerTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).SetCustomerTags(ctx, req.(*SetCustomerTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_AddCustomerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCustomerNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).AddCustomerNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_AddCustomerNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).AddCustomerNote(ctx, req.(*AddCustomerNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListCustomerNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomerNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListCustomerNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListCustomerNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListCustomerNotes(ctx, req.(*ListCustomerNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_DeleteCustomerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomerNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).DeleteCustomerNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_DeleteCustomerNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).DeleteCustomerNote(ctx, req.(*DeleteCustomerNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GetCustomerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetCustomerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetCustomerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetCustomerProfile(ctx, req.(*GetCustomerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ExportCustomerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCustomerDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ExportCustomerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ExportCustomerData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ExportCustomerData(ctx, req.(*ExportCustomerDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_EraseCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).EraseCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_EraseCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).EraseCustomer(ctx, req.(*EraseCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_GetPrivacyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacyRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetPrivacyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetPrivacyRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetPrivacyRequest(ctx, req.(*GetPrivacyRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_MergeCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).MergeCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_MergeCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).MergeCustomers(ctx, req.(*MergeCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_BackfillCustomerKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCustomerKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).BackfillCustomerKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_BackfillCustomerKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).BackfillCustomerKeys(ctx, req.(*BackfillCustomerKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_CreateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_PreviewSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).PreviewSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_PreviewSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).PreviewSegment(ctx, req.(*PreviewSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListSegmentMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSegmentMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListSegmentMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListSegmentMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListSegmentMembers(ctx, req.(*ListSegmentMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).UploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_UploadFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).UploadFile(ctx, req.(*UploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_ListProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListProductImages(ctx, req.(*ListProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductCustomer_SetProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).SetProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_SetProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).SetProductImages(ctx, req.(*SetProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductCustomerServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
+
+func _ProductCustomer_PurchaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).PurchaseProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_PurchaseProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).PurchaseProduct(ctx, req.(*PurchaseProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductCustomerServer) UploadFile(context.Context, *UploadFileRequest) (*UploadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+
+func _ProductCustomer_RegisterProductSerials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterProductSerialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).RegisterProductSerials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_RegisterProductSerials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).RegisterProductSerials(ctx, req.(*RegisterProductSerialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductCustomerServer) PurchaseProduct(context.Context, *PurchaseProductRequest) (*PurchaseProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseProduct not implemented")
+
+func _ProductCustomer_ListProductSerials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductSerialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).ListProductSerials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_ListProductSerials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).ListProductSerials(ctx, req.(*ListProductSerialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductCustomerServer) mustEmbedUnimplementedProductCustomerServer() {}
-func (UnimplementedProductCustomerServer) testEmbeddedByValue()                         {}
 
-// UnsafeProductCustomerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductCustomerServer will
-// result in compilation errors.
-type UnsafeProductCustomerServer interface {
-	mustEmbedUnimplementedProductCustomerServer()
+func _ProductCustomer_GetSerialHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSerialHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).GetSerialHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_GetSerialHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).GetSerialHistory(ctx, req.(*GetSerialHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductCustomerServer(s grpc.ServiceRegistrar, srv ProductCustomerServer) {
-	// If the following call pancis, it indicates UnimplementedProductCustomerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductCustomer_OpenStocktakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenStocktakeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductCustomer_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductCustomerServer).OpenStocktakeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductCustomer_OpenStocktakeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductCustomerServer).OpenStocktakeSession(ctx, req.(*OpenStocktakeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DummyRequest)
+func _ProductCustomer_GetStocktakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStocktakeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).Dummy(ctx, in)
+		return srv.(ProductCustomerServer).GetStocktakeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_Dummy_FullMethodName,
+		FullMethod: ProductCustomer_GetStocktakeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).Dummy(ctx, req.(*DummyRequest))
+		return srv.(ProductCustomerServer).GetStocktakeSession(ctx, req.(*GetStocktakeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductCustomer_SubmitStocktakeCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitStocktakeCountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).CreateProduct(ctx, in)
+		return srv.(ProductCustomerServer).SubmitStocktakeCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_CreateProduct_FullMethodName,
+		FullMethod: ProductCustomer_SubmitStocktakeCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductCustomerServer).SubmitStocktakeCounts(ctx, req.(*SubmitStocktakeCountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ProductCustomer_SubmitStocktakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitStocktakeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).GetProduct(ctx, in)
+		return srv.(ProductCustomerServer).SubmitStocktakeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_GetProduct_FullMethodName,
+		FullMethod: ProductCustomer_SubmitStocktakeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductCustomerServer).SubmitStocktakeSession(ctx, req.(*SubmitStocktakeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductCustomer_ApproveStocktakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStocktakeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).ListProducts(ctx, in)
+		return srv.(ProductCustomerServer).ApproveStocktakeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_ListProducts_FullMethodName,
+		FullMethod: ProductCustomer_ApproveStocktakeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductCustomerServer).ApproveStocktakeSession(ctx, req.(*ApproveStocktakeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductCustomer_RejectStocktakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectStocktakeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).UpdateProduct(ctx, in)
+		return srv.(ProductCustomerServer).RejectStocktakeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_UpdateProduct_FullMethodName,
+		FullMethod: ProductCustomer_RejectStocktakeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductCustomerServer).RejectStocktakeSession(ctx, req.(*RejectStocktakeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _ProductCustomer_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSupplierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).DeleteProduct(ctx, in)
+		return srv.(ProductCustomerServer).CreateSupplier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_DeleteProduct_FullMethodName,
+		FullMethod: ProductCustomer_CreateSupplier_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(ProductCustomerServer).CreateSupplier(ctx, req.(*CreateSupplierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_ListProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductCategoriesRequest)
+func _ProductCustomer_UpdateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSupplierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).ListProductCategories(ctx, in)
+		return srv.(ProductCustomerServer).UpdateSupplier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_ListProductCategories_FullMethodName,
+		FullMethod: ProductCustomer_UpdateSupplier_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).ListProductCategories(ctx, req.(*ListProductCategoriesRequest))
+		return srv.(ProductCustomerServer).UpdateSupplier(ctx, req.(*UpdateSupplierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_CreateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCustomerRequest)
+func _ProductCustomer_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).CreateCustomer(ctx, in)
+		return srv.(ProductCustomerServer).ListSuppliers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_CreateCustomer_FullMethodName,
+		FullMethod: ProductCustomer_ListSuppliers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).CreateCustomer(ctx, req.(*CreateCustomerRequest))
+		return srv.(ProductCustomerServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_GetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCustomerRequest)
+func _ProductCustomer_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).GetCustomer(ctx, in)
+		return srv.(ProductCustomerServer).CreatePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_GetCustomer_FullMethodName,
+		FullMethod: ProductCustomer_CreatePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).GetCustomer(ctx, req.(*GetCustomerRequest))
+		return srv.(ProductCustomerServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCustomersRequest)
+func _ProductCustomer_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).ListCustomers(ctx, in)
+		return srv.(ProductCustomerServer).GetPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_ListCustomers_FullMethodName,
+		FullMethod: ProductCustomer_GetPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+		return srv.(ProductCustomerServer).GetPurchaseOrder(ctx, req.(*GetPurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCustomerRequest)
+func _ProductCustomer_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).UpdateCustomer(ctx, in)
+		return srv.(ProductCustomerServer).ListPurchaseOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_UpdateCustomer_FullMethodName,
+		FullMethod: ProductCustomer_ListPurchaseOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+		return srv.(ProductCustomerServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_DeleteCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCustomerRequest)
+func _ProductCustomer_CancelPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).DeleteCustomer(ctx, in)
+		return srv.(ProductCustomerServer).CancelPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_DeleteCustomer_FullMethodName,
+		FullMethod: ProductCustomer_CancelPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).DeleteCustomer(ctx, req.(*DeleteCustomerRequest))
+		return srv.(ProductCustomerServer).CancelPurchaseOrder(ctx, req.(*CancelPurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadFileRequest)
+func _ProductCustomer_ReceiveGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveGoodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).UploadFile(ctx, in)
+		return srv.(ProductCustomerServer).ReceiveGoods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_UploadFile_FullMethodName,
+		FullMethod: ProductCustomer_ReceiveGoods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).UploadFile(ctx, req.(*UploadFileRequest))
+		return srv.(ProductCustomerServer).ReceiveGoods(ctx, req.(*ReceiveGoodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductCustomer_PurchaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseProductRequest)
+func _ProductCustomer_ListLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductCustomerServer).PurchaseProduct(ctx, in)
+		return srv.(ProductCustomerServer).ListLowStockProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductCustomer_PurchaseProduct_FullMethodName,
+		FullMethod: ProductCustomer_ListLowStockProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductCustomerServer).PurchaseProduct(ctx, req.(*PurchaseProductRequest))
+		return srv.(ProductCustomerServer).ListLowStockProducts(ctx, req.(*ListLowStockProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -583,10 +2095,42 @@ var ProductCustomer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _ProductCustomer_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductCustomer_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "CreateProductVariant",
+			Handler:    _ProductCustomer_CreateProductVariant_Handler,
+		},
+		{
+			MethodName: "ExportProducts",
+			Handler:    _ProductCustomer_ExportProducts_Handler,
+		},
+		{
+			MethodName: "GenerateLabels",
+			Handler:    _ProductCustomer_GenerateLabels_Handler,
+		},
 		{
 			MethodName: "ListProductCategories",
 			Handler:    _ProductCustomer_ListProductCategories_Handler,
 		},
+		{
+			MethodName: "CreateProductCategory",
+			Handler:    _ProductCustomer_CreateProductCategory_Handler,
+		},
+		{
+			MethodName: "GetProductCategory",
+			Handler:    _ProductCustomer_GetProductCategory_Handler,
+		},
+		{
+			MethodName: "UpdateProductCategory",
+			Handler:    _ProductCustomer_UpdateProductCategory_Handler,
+		},
+		{
+			MethodName: "DeleteProductCategory",
+			Handler:    _ProductCustomer_DeleteProductCategory_Handler,
+		},
 		{
 			MethodName: "CreateCustomer",
 			Handler:    _ProductCustomer_CreateCustomer_Handler,
@@ -607,15 +2151,157 @@ var ProductCustomer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCustomer",
 			Handler:    _ProductCustomer_DeleteCustomer_Handler,
 		},
+		{
+			MethodName: "UpdateCustomerAttributes",
+			Handler:    _ProductCustomer_UpdateCustomerAttributes_Handler,
+		},
+		{
+			MethodName: "SetCustomerTags",
+			Handler:    _ProductCustomer_SetCustomerTags_Handler,
+		},
+		{
+			MethodName: "AddCustomerNote",
+			Handler:    _ProductCustomer_AddCustomerNote_Handler,
+		},
+		{
+			MethodName: "ListCustomerNotes",
+			Handler:    _ProductCustomer_ListCustomerNotes_Handler,
+		},
+		{
+			MethodName: "DeleteCustomerNote",
+			Handler:    _ProductCustomer_DeleteCustomerNote_Handler,
+		},
+		{
+			MethodName: "GetCustomerProfile",
+			Handler:    _ProductCustomer_GetCustomerProfile_Handler,
+		},
+		{
+			MethodName: "ExportCustomerData",
+			Handler:    _ProductCustomer_ExportCustomerData_Handler,
+		},
+		{
+			MethodName: "EraseCustomer",
+			Handler:    _ProductCustomer_EraseCustomer_Handler,
+		},
+		{
+			MethodName: "GetPrivacyRequest",
+			Handler:    _ProductCustomer_GetPrivacyRequest_Handler,
+		},
+		{
+			MethodName: "MergeCustomers",
+			Handler:    _ProductCustomer_MergeCustomers_Handler,
+		},
+		{
+			MethodName: "BackfillCustomerKeys",
+			Handler:    _ProductCustomer_BackfillCustomerKeys_Handler,
+		},
+		{
+			MethodName: "CreateSegment",
+			Handler:    _ProductCustomer_CreateSegment_Handler,
+		},
+		{
+			MethodName: "PreviewSegment",
+			Handler:    _ProductCustomer_PreviewSegment_Handler,
+		},
+		{
+			MethodName: "ListSegmentMembers",
+			Handler:    _ProductCustomer_ListSegmentMembers_Handler,
+		},
 		{
 			MethodName: "UploadFile",
 			Handler:    _ProductCustomer_UploadFile_Handler,
 		},
+		{
+			MethodName: "ListProductImages",
+			Handler:    _ProductCustomer_ListProductImages_Handler,
+		},
+		{
+			MethodName: "SetProductImages",
+			Handler:    _ProductCustomer_SetProductImages_Handler,
+		},
 		{
 			MethodName: "PurchaseProduct",
 			Handler:    _ProductCustomer_PurchaseProduct_Handler,
 		},
+		{
+			MethodName: "RegisterProductSerials",
+			Handler:    _ProductCustomer_RegisterProductSerials_Handler,
+		},
+		{
+			MethodName: "ListProductSerials",
+			Handler:    _ProductCustomer_ListProductSerials_Handler,
+		},
+		{
+			MethodName: "GetSerialHistory",
+			Handler:    _ProductCustomer_GetSerialHistory_Handler,
+		},
+		{
+			MethodName: "OpenStocktakeSession",
+			Handler:    _ProductCustomer_OpenStocktakeSession_Handler,
+		},
+		{
+			MethodName: "GetStocktakeSession",
+			Handler:    _ProductCustomer_GetStocktakeSession_Handler,
+		},
+		{
+			MethodName: "SubmitStocktakeCounts",
+			Handler:    _ProductCustomer_SubmitStocktakeCounts_Handler,
+		},
+		{
+			MethodName: "SubmitStocktakeSession",
+			Handler:    _ProductCustomer_SubmitStocktakeSession_Handler,
+		},
+		{
+			MethodName: "ApproveStocktakeSession",
+			Handler:    _ProductCustomer_ApproveStocktakeSession_Handler,
+		},
+		{
+			MethodName: "RejectStocktakeSession",
+			Handler:    _ProductCustomer_RejectStocktakeSession_Handler,
+		},
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _ProductCustomer_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "UpdateSupplier",
+			Handler:    _ProductCustomer_UpdateSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _ProductCustomer_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _ProductCustomer_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _ProductCustomer_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _ProductCustomer_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "CancelPurchaseOrder",
+			Handler:    _ProductCustomer_CancelPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceiveGoods",
+			Handler:    _ProductCustomer_ReceiveGoods_Handler,
+		},
+		{
+			MethodName: "ListLowStockProducts",
+			Handler:    _ProductCustomer_ListLowStockProducts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductCustomer_ImportProducts_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/product.proto",
 }